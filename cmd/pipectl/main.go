@@ -25,6 +25,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/event"
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/piped"
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/planpreview"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/trigger"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
 
@@ -42,6 +43,7 @@ func main() {
 		piped.NewCommand(),
 		encrypt.NewCommand(),
 		appconfig.NewCommand(),
+		trigger.NewCommand(),
 	)
 
 	if err := app.Run(); err != nil {
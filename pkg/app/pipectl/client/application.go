@@ -30,7 +30,8 @@ import (
 func SyncApplication(
 	ctx context.Context,
 	cli apiservice.Client,
-	appID string,
+	appID, targetCommitHash, externalSource, description string,
+	strategy model.SyncStrategy,
 	checkInterval, timeout time.Duration,
 	logger *zap.Logger,
 ) (string, error) {
@@ -38,7 +39,11 @@ func SyncApplication(
 	defer cancel()
 
 	req := &apiservice.SyncApplicationRequest{
-		ApplicationId: appID,
+		ApplicationId:    appID,
+		TargetCommitHash: targetCommitHash,
+		SyncStrategy:     strategy,
+		ExternalSource:   externalSource,
+		Description:      description,
 	}
 	resp, err := cli.SyncApplication(ctx, req)
 	if err != nil {
@@ -37,6 +37,7 @@ type add struct {
 	repoID         string
 	appDir         string
 	configFileName string
+	configFilePath string
 }
 
 func newAddCommand(root *command) *cobra.Command {
@@ -58,6 +59,7 @@ func newAddCommand(root *command) *cobra.Command {
 	cmd.Flags().StringVar(&c.repoID, "repo-id", c.repoID, "The repository ID. One the registered repositories in the piped configuration.")
 	cmd.Flags().StringVar(&c.appDir, "app-dir", c.appDir, "The relative path from the root of repository to the application directory.")
 	cmd.Flags().StringVar(&c.configFileName, "config-file-name", c.configFileName, "The configuration file name")
+	cmd.Flags().StringVar(&c.configFilePath, "config-file-path", c.configFilePath, "The relative path from the root of repository to the configuration file. Overrides the one in the application directory if specified.")
 	cmd.Flags().StringVar(&c.description, "description", c.description, "The description of the application.")
 
 	cmd.MarkFlagRequired("app-name")
@@ -91,6 +93,7 @@ func (c *add) run(ctx context.Context, input cli.Input) error {
 			},
 			Path:           c.appDir,
 			ConfigFilename: c.configFileName,
+			ConfigFilePath: c.configFilePath,
 		},
 		Kind:          model.ApplicationKind(appKind),
 		CloudProvider: c.cloudProvider,
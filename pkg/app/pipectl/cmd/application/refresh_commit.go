@@ -0,0 +1,67 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type refreshCommit struct {
+	root *command
+
+	appID string
+}
+
+func newRefreshCommitCommand(root *command) *cobra.Command {
+	c := &refreshCommit{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "refresh-commit",
+		Short: "Let piped re-fetch the last triggered commit of an application instead of using the cached one.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.MarkFlagRequired("app-id")
+
+	return cmd
+}
+
+func (c *refreshCommit) run(ctx context.Context, input cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.RefreshLastTriggeredCommitRequest{
+		ApplicationId: c.appID,
+	}
+
+	resp, err := cli.RefreshLastTriggeredCommit(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to refresh the last triggered commit: %w", err)
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully requested to refresh the last triggered commit by command %s", resp.CommandId))
+	return nil
+}
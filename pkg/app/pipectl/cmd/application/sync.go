@@ -30,15 +30,20 @@ import (
 type sync struct {
 	root *command
 
-	appID         string
-	statuses      []string
-	checkInterval time.Duration
-	timeout       time.Duration
+	appID          string
+	commitHash     string
+	externalSource string
+	description    string
+	strategy       string
+	statuses       []string
+	checkInterval  time.Duration
+	timeout        time.Duration
 }
 
 func newSyncCommand(root *command) *cobra.Command {
 	c := &sync{
 		root:          root,
+		strategy:      model.SyncStrategy_AUTO.String(),
 		checkInterval: 15 * time.Second,
 		timeout:       5 * time.Minute,
 	}
@@ -49,6 +54,10 @@ func newSyncCommand(root *command) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.Flags().StringVar(&c.commitHash, "commit-hash", c.commitHash, "The hash of the commit to deploy. Empty means the head commit of the configured branch.")
+	cmd.Flags().StringVar(&c.externalSource, "external-source", c.externalSource, "The source outside of Git requesting the sync, e.g. an image registry. If specified, the head commit is deployed even though it has no change.")
+	cmd.Flags().StringVar(&c.description, "description", c.description, "The note about the sync shown as the description of the triggered deployment. Empty means the body of the commit message is used.")
+	cmd.Flags().StringVar(&c.strategy, "sync-strategy", c.strategy, fmt.Sprintf("The strategy to sync the application with. AUTO lets piped determine it. (%s)", strings.Join(model.SyncStrategyStrings(), "|")))
 	cmd.Flags().StringSliceVar(&c.statuses, "wait-status", c.statuses, fmt.Sprintf("The list of waiting statuses. Empty means returning immediately after triggered. (%s)", strings.Join(model.DeploymentStatusStrings(), "|")))
 	cmd.Flags().DurationVar(&c.checkInterval, "check-interval", c.checkInterval, "The interval of checking the requested command.")
 	cmd.Flags().DurationVar(&c.timeout, "timeout", c.timeout, "Maximum execution time.")
@@ -63,6 +72,13 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 	if err != nil {
 		return fmt.Errorf("invalid deployment status: %w", err)
 	}
+	strategy, ok := model.SyncStrategy_value[strings.ToUpper(c.strategy)]
+	if !ok {
+		return fmt.Errorf("invalid sync strategy %s", c.strategy)
+	}
+	if c.externalSource != "" && c.commitHash != "" {
+		return fmt.Errorf("--commit-hash and --external-source cannot be specified together")
+	}
 
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
@@ -70,7 +86,7 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 	}
 	defer cli.Close()
 
-	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.checkInterval, c.timeout, input.Logger)
+	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.commitHash, c.externalSource, c.description, model.SyncStrategy(strategy), c.checkInterval, c.timeout, input.Logger)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,63 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type pause struct {
+	root *command
+
+	stdout io.Writer
+}
+
+func newPauseCommand(root *command) *cobra.Command {
+	c := &pause{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Pause triggering new deployments by new commits and configuration drift in all pipeds of the project.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	return cmd
+}
+
+func (c *pause) run(ctx context.Context, _ cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	if _, err := cli.PauseTriggering(ctx, &apiservice.PauseTriggeringRequest{}); err != nil {
+		fmt.Fprintf(c.stdout, "Failed to pause triggering (%v)\n", err)
+		return err
+	}
+
+	fmt.Fprintf(c.stdout, "Successfully paused triggering\n")
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -26,12 +27,19 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
@@ -58,6 +66,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/statsreporter"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/cli"
@@ -142,6 +151,22 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	// Register all metrics.
 	registry := registerMetrics(cfg.PipedID, cfg.ProjectID, p.launcherVersion)
 
+	// Start exporting the traces if configured.
+	if t := cfg.Tracing; t != nil {
+		shutdown, err := initTracing(ctx, t, cfg.PipedID)
+		if err != nil {
+			input.Logger.Error("failed to initialize tracing", zap.Error(err))
+			return err
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), p.gracePeriod)
+			defer cancel()
+			if err := shutdown(ctx); err != nil {
+				input.Logger.Error("failed to flush the remaining traces", zap.Error(err))
+			}
+		}()
+	}
+
 	// Configure SSH config if needed.
 	if cfg.Git.ShouldConfigureSSHConfig() {
 		if err := git.AddSSHConfig(cfg.Git); err != nil {
@@ -200,22 +225,26 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	})
 
 	// Start running admin server.
+	// The components started after it add their health checks to healthChecks.
+	healthChecks := &healthCheckSet{}
+	adminServer := admin.NewAdmin(p.adminPort, p.gracePeriod, input.Logger)
 	{
-		var (
-			ver   = []byte(version.Get().Version)
-			admin = admin.NewAdmin(p.adminPort, p.gracePeriod, input.Logger)
-		)
+		ver := []byte(version.Get().Version)
 
-		admin.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		adminServer.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 			w.Write(ver)
 		})
-		admin.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		adminServer.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+			if err := healthChecks.Check(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
 			w.Write([]byte("ok"))
 		})
-		admin.Handle("/metrics", input.PrometheusMetricsHandlerFor(registry))
+		adminServer.Handle("/metrics", input.PrometheusMetricsHandlerFor(registry))
 
 		group.Go(func() error {
-			return admin.Run(ctx)
+			return adminServer.Run(ctx)
 		})
 	}
 
@@ -233,6 +262,13 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		git.WithUserName(cfg.Git.Username),
 		git.WithEmail(cfg.Git.Email),
 		git.WithLogger(input.Logger),
+		git.WithSignatureVerificationKeys(cfg.Git.GPGHome, cfg.Git.SSHAllowedSignersFile),
+	}
+	for _, repo := range cfg.Repositories {
+		if f := repo.SSHKeyFile; f != "" {
+			// Configure git client to use the deploy key of the repository instead of the shared one.
+			gitOptions = append(gitOptions, git.WithSSHKeyForRepo(repo.Remote, f))
+		}
 	}
 	for _, repo := range cfg.GitHelmChartRepositories() {
 		if f := repo.SSHKeyFile; f != "" {
@@ -324,6 +360,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	}
 
 	// Start running application application drift detector.
+	var driftDetector driftdetector.Detector
 	{
 		d, err := driftdetector.NewDetector(
 			applicationLister,
@@ -339,6 +376,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			input.Logger.Error("failed to initialize application drift detector", zap.Error(err))
 			return err
 		}
+		driftDetector = d
 
 		group.Go(func() error {
 			return d.Run(ctx)
@@ -377,6 +415,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			applicationLister,
 			commandLister,
 			notifier,
+			driftDetector,
 			cfg,
 			p.gracePeriod,
 			input.Logger,
@@ -386,6 +425,72 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			return err
 		}
 		lastTriggeredCommitGetter = tr.GetLastTriggeredCommitGetter()
+		healthChecks.Add(tr.CheckHealth)
+
+		if w := cfg.TriggerWebhook; w != nil {
+			secret, err := w.LoadSecret()
+			if err != nil {
+				input.Logger.Error("failed to load the secret of trigger webhook", zap.Error(err))
+				return err
+			}
+			adminServer.Handle("/trigger/webhook", tr.NewWebhookHandler(secret))
+		}
+
+		adminServer.HandleFunc("/trigger/deferred", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(tr.GetDeploymentBudgetStatus()); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		adminServer.HandleFunc("/trigger/candidates", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(tr.GetCandidates()); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		adminServer.HandleFunc("/trigger/suppressed", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(tr.GetSuppressions()); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		adminServer.HandleFunc("/trigger/rollout", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(tr.GetHeldRollouts()); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		adminServer.HandleFunc("/trigger/rollout/promote", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			promoted := tr.PromoteRollouts(r.URL.Query().Get("repoId"))
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(promoted); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		adminServer.HandleFunc("/trigger/decisions", func(w http.ResponseWriter, r *http.Request) {
+			var (
+				getter = tr.GetLastDecisionGetter()
+				v      interface{}
+			)
+			if appID := r.URL.Query().Get("appId"); appID != "" {
+				d, ok := getter.Get(appID)
+				if !ok {
+					http.Error(w, "no decision was made for the application", http.StatusNotFound)
+					return
+				}
+				v = d
+			} else {
+				v = getter.List()
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(v); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
 
 		group.Go(func() error {
 			return tr.Run(ctx)
@@ -724,6 +829,38 @@ func (p *piped) getConfigDataFromSecretManager(ctx context.Context) ([]byte, err
 	return resp.Payload.Data, nil
 }
 
+// initTracing registers the tracer provider exporting the traces to the configured collector
+// and the propagator passing the trace context to the control-plane.
+// The returned function flushes the remaining traces and must be called while shutting down.
+func initTracing(ctx context.Context, cfg *config.PipedTracing, pipedID string) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	ratio := cfg.SamplingRatio
+	if ratio == 0 {
+		ratio = 1
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String("piped"),
+		semconv.ServiceInstanceIDKey.String(pipedID),
+		semconv.ServiceVersionKey.String(version.Get().Version),
+	)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp.Shutdown, nil
+}
+
 func registerMetrics(pipedID, projectID, launcherVersion string) *prometheus.Registry {
 	r := prometheus.NewRegistry()
 	wrapped := prometheus.WrapRegistererWith(
@@ -742,6 +879,31 @@ func registerMetrics(pipedID, projectID, launcherVersion string) *prometheus.Reg
 	k8scloudprovidermetrics.Register(wrapped)
 	k8slivestatestoremetrics.Register(wrapped)
 	planpreviewmetrics.Register(wrapped)
+	triggermetrics.Register(wrapped)
 
 	return r
 }
+
+// healthCheckSet is a set of the health checks of the running components.
+type healthCheckSet struct {
+	mu     sync.RWMutex
+	checks []func() error
+}
+
+func (s *healthCheckSet) Add(check func() error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks = append(s.checks, check)
+}
+
+// Check returns the error of the first unhealthy component.
+func (s *healthCheckSet) Check() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, check := range s.checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ package driftdetector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -57,8 +58,15 @@ type secretDecrypter interface {
 	Decrypt(string) (string, error)
 }
 
+// ErrUnsupported is returned when comparing the manifests of an application
+// whose cloud provider does not support it.
+var ErrUnsupported = errors.New("comparing with the live state is not supported by the cloud provider")
+
 type Detector interface {
 	Run(ctx context.Context) error
+	// IsSynced renders the manifests of the given application at the given commit
+	// and reports whether they are identical to its live state.
+	IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error)
 }
 
 type detector struct {
@@ -74,6 +82,10 @@ type providerDetector interface {
 	ProviderName() string
 }
 
+type syncChecker interface {
+	IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error)
+}
+
 func NewDetector(
 	appLister applicationLister,
 	gitClient gitClient,
@@ -162,6 +174,19 @@ func (d *detector) Run(ctx context.Context) error {
 	return nil
 }
 
+func (d *detector) IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error) {
+	for _, detector := range d.detectors {
+		if detector.ProviderName() != app.CloudProvider {
+			continue
+		}
+		if c, ok := detector.(syncChecker); ok {
+			return c.IsSynced(ctx, app, repo, commit)
+		}
+		break
+	}
+	return false, ErrUnsupported
+}
+
 func (d *detector) ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error {
 	d.mu.RLock()
 	curState, ok := d.syncStates[appID]
@@ -53,6 +53,7 @@ type reporter interface {
 type Detector interface {
 	Run(ctx context.Context) error
 	ProviderName() string
+	IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error)
 }
 
 type detector struct {
@@ -176,10 +177,31 @@ func (d *detector) check(ctx context.Context) error {
 }
 
 func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
+	result, err := d.diff(ctx, app, repo, headCommit)
+	if err != nil {
+		return err
+	}
+
+	state := makeSyncState(result, headCommit.Hash)
+
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+}
+
+// IsSynced renders the manifests of the given application at the given commit
+// and reports whether they are identical to its live manifests.
+func (d *detector) IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error) {
+	result, err := d.diff(ctx, app, repo, commit)
+	if err != nil {
+		return false, err
+	}
+	return result.NoChange(), nil
+}
+
+func (d *detector) diff(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) (*provider.DiffListResult, error) {
 	watchingResourceKinds := d.stateGetter.GetWatchingResourceKinds()
 	headManifests, err := d.loadHeadManifests(ctx, app, repo, headCommit, watchingResourceKinds)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	headManifests = filterIgnoringManifests(headManifests)
 	d.logger.Info(fmt.Sprintf("application %s has %d manifests at commit %s", app.Id, len(headManifests), headCommit.Hash))
@@ -188,7 +210,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	liveManifests = filterIgnoringManifests(liveManifests)
 	d.logger.Info(fmt.Sprintf("application %s has %d live manifests", app.Id, len(liveManifests)))
 
-	result, err := provider.DiffList(
+	return provider.DiffList(
 		headManifests,
 		liveManifests,
 		d.logger,
@@ -196,13 +218,6 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		diff.WithIgnoreAddingMapKeys(),
 		diff.WithCompareNumberAndNumericString(),
 	)
-	if err != nil {
-		return err
-	}
-
-	state := makeSyncState(result, headCommit.Hash)
-
-	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
 }
 
 func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit, watchingResourceKinds []provider.APIVersionKind) ([]provider.Manifest, error) {
@@ -342,8 +357,13 @@ func makeSyncState(r *provider.DiffListResult, commit string) model.ApplicationS
 		}
 	}
 
-	total := len(r.Adds) + len(r.Deletes) + len(r.Changes)
-	shortReason := fmt.Sprintf("There are %d manifests not synced (%d adds, %d deletes, %d changes)", total, len(r.Adds), len(r.Deletes), len(r.Changes))
+	// The manifests in Git are compared with the live ones,
+	// so the added ones are the live resources not defined in Git.
+	shortReason := model.MakeOutOfSyncShortReason(model.OutOfSyncResources{
+		Extra:   len(r.Adds),
+		Missing: len(r.Deletes),
+		Changed: len(r.Changes),
+	})
 	if len(commit) >= 7 {
 		commit = commit[:7]
 	}
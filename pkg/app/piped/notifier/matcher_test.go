@@ -187,6 +187,34 @@ func TestMatch(t *testing.T) {
 				}: true,
 			},
 		},
+		{
+			name: "filter batched deployments by the group label",
+			config: config.NotificationRoute{
+				Labels: map[string]string{
+					"team": "pipecd",
+				},
+			},
+			matchings: map[model.NotificationEvent]bool{
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentsTriggered{
+						GroupLabel: "team",
+						GroupValue: "pipecd",
+					},
+				}: true,
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentsTriggered{
+						GroupLabel: "team",
+						GroupValue: "not-pipecd",
+					},
+				}: false,
+				{
+					Type:     model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentsTriggered{},
+				}: false,
+			},
+		},
 	}
 
 	for _, tc := range testcases {
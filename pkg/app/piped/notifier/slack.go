@@ -150,6 +150,12 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 			{"Mention To", accounts, true},
 			{"Started At", makeSlackDate(d.CreatedAt), true},
 		}
+		if n, ok := d.Metadata[model.MetadataKeyPullRequestNumber]; ok {
+			fields = append(fields, slackField{"Pull Request", "#" + n, true})
+		}
+		if c, ok := d.Metadata[model.MetadataKeyRevertedCommit]; ok {
+			fields = append(fields, slackField{"Reverted Commit", truncateText(c, 40), true})
+		}
 	}
 	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash, msg string) {
 		link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
@@ -181,8 +187,33 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED:
 		md := event.Metadata.(*model.NotificationEventDeploymentTriggered)
 		title = fmt.Sprintf("Triggered a new deployment for %q", md.Deployment.ApplicationName)
+		if _, ok := md.Deployment.Metadata[model.MetadataKeyRevertedCommit]; ok {
+			title = fmt.Sprintf("Triggered a new deployment reverting a change for %q", md.Deployment.ApplicationName)
+		}
+		text = md.ChangesSummary
 		generateDeploymentEventData(md.Deployment, getAccountsAsString(md.MentionedAccounts))
 
+	case model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED:
+		md := event.Metadata.(*model.NotificationEventDeploymentsTriggered)
+		title = fmt.Sprintf("Triggered %d new deployments for the commit %s", len(md.Deployments), truncateText(md.CommitHash, 8))
+		apps := make([]string, 0, len(md.Deployments))
+		for _, d := range md.Deployments {
+			apps = append(apps, makeSlackLink(d.ApplicationName, fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)))
+		}
+		text = strings.Join(apps, "\n")
+		if len(md.Deployments) > 0 {
+			projectID := md.Deployments[0].ProjectId
+			link = fmt.Sprintf("%s/deployments?project=%s", webURL, projectID)
+			fields = []slackField{
+				{"Project", truncateText(projectID, 8), true},
+				{"Commit", truncateText(md.CommitHash, 8), true},
+			}
+		}
+		if md.GroupLabel != "" {
+			fields = append(fields, slackField{"Group", fmt.Sprintf("%s=%s", md.GroupLabel, md.GroupValue), true})
+		}
+		fields = append(fields, slackField{"Mention To", getAccountsAsString(md.MentionedAccounts), true})
+
 	case model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED:
 		md := event.Metadata.(*model.NotificationEventDeploymentPlanned)
 		title = fmt.Sprintf("Deployment for %q was planned", md.Deployment.ApplicationName)
@@ -226,6 +257,13 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		text = md.Reason
 		generateDeploymentEventDataForTriggerFailed(md.Application, md.CommitHash, md.CommitMessage)
 
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_SKIPPED_INVALID_CONFIG:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggerSkippedInvalidConfig)
+		title = fmt.Sprintf("Skipped triggering a new deployment for %s due to its invalid configuration", md.Application.Name)
+		text = fmt.Sprintf("Failed to load %s: %s", md.ConfigPath, md.Reason)
+		color = slackWarnColor
+		generateDeploymentEventDataForTriggerFailed(md.Application, md.CommitHash, md.CommitHash)
+
 	case model.NotificationEventType_EVENT_PIPED_STARTED:
 		md := event.Metadata.(*model.NotificationEventPipedStarted)
 		title = "A piped has been started"
@@ -236,6 +274,47 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		title = "A piped has been stopped"
 		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId)
 
+	case model.NotificationEventType_EVENT_GIT_REPO_PULL_FAILED:
+		md := event.Metadata.(*model.NotificationEventGitRepoPullFailed)
+		title = fmt.Sprintf("Failed to pull the Git repository %s %d times in a row", md.RepoId, md.Failures)
+		text = md.Reason
+		color = slackErrorColor
+		link = fmt.Sprintf("%s/settings/piped?project=%s", webURL, md.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(md.ProjectId, 8), true},
+			{"Piped", md.PipedId, true},
+			{"Repository", md.RepoId, true},
+			{"Branch", md.Branch, true},
+		}
+
+	case model.NotificationEventType_EVENT_GIT_COMMIT_SIGNATURE_UNVERIFIED:
+		md := event.Metadata.(*model.NotificationEventGitCommitSignatureUnverified)
+		title = fmt.Sprintf("Suppressed triggering the deployments in the Git repository %s due to the unverified commit signature", md.RepoId)
+		text = md.Reason
+		color = slackErrorColor
+		link = fmt.Sprintf("%s/settings/piped?project=%s", webURL, md.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(md.ProjectId, 8), true},
+			{"Piped", md.PipedId, true},
+			{"Repository", md.RepoId, true},
+			{"Branch", md.Branch, true},
+			{"Commit", md.CommitHash, true},
+			{"Author", md.CommitAuthor, true},
+		}
+
+	case model.NotificationEventType_EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED:
+		md := event.Metadata.(*model.NotificationEventGitRepoApplicationLimitExceeded)
+		title = fmt.Sprintf("Refused to trigger the deployments in the Git repository %s having too many applications", md.RepoId)
+		text = fmt.Sprintf("The repository has %d applications exceeding the limit of %d. Check whether the applications were registered by mistake.", md.Applications, md.Limit)
+		color = slackErrorColor
+		link = fmt.Sprintf("%s/applications?project=%s", webURL, md.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(md.ProjectId, 8), true},
+			{"Piped", md.PipedId, true},
+			{"Repository", md.RepoId, true},
+			{"Applications", fmt.Sprintf("%d", md.Applications), true},
+		}
+
 	// TODO: Support application type of notification event.
 	default:
 		return slackMessage{}, false
@@ -49,6 +49,7 @@ var (
 
 type lastTriggeredCommitGetter interface {
 	Get(ctx context.Context, applicationID string) (string, error)
+	Refresh(ctx context.Context, applicationID string) (string, error)
 }
 
 type Builder interface {
@@ -296,13 +297,14 @@ func (b *builder) cloneHeadCommit(ctx context.Context, headBranch, headCommit st
 }
 
 func (b *builder) findTriggerApps(ctx context.Context, repo git.Repo, apps []*model.Application, headCommit string) (triggerApps []*model.Application, failedResults []*model.ApplicationPlanPreviewResult, err error) {
-	d := trigger.NewOnCommitDeterminer(repo, headCommit, b.commitGetter, b.logger)
+	d := trigger.NewOnCommitDeterminer(repo, headCommit, false, false, b.commitGetter, b.logger)
 	determine := func(app *model.Application) (bool, error) {
 		appCfg, err := loadApplicationConfiguration(repo.GetPath(), app)
 		if err != nil {
 			return false, err
 		}
-		return d.ShouldTrigger(ctx, app, appCfg)
+		shouldTrigger, _, err := d.ShouldTrigger(ctx, app, appCfg)
+		return shouldTrigger, err
 	}
 
 	for _, app := range apps {
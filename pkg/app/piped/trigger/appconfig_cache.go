@@ -0,0 +1,102 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// applicationConfigCache keeps the application config most recently loaded for each application
+// to avoid reading and parsing the same file again until the commit changes.
+// Only one commit is kept for each application, so the entry is replaced when the commit changes.
+type applicationConfigCache struct {
+	mu      sync.Mutex
+	configs map[string]cachedApplicationConfig
+}
+
+type cachedApplicationConfig struct {
+	commit string
+	// The location of the config file registered with the application when it was loaded.
+	// The entry is not used once the application was changed to use another file.
+	source string
+	spec   *config.GenericApplicationSpec
+	file   applicationConfigFile
+}
+
+func newApplicationConfigCache() *applicationConfigCache {
+	return &applicationConfigCache{
+		configs: make(map[string]cachedApplicationConfig),
+	}
+}
+
+// Get returns the config of the given application loaded at the given commit.
+// The returned spec is shared with the other callers so it must not be modified.
+func (c *applicationConfigCache) Get(app *model.Application, commit string) (*config.GenericApplicationSpec, applicationConfigFile, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cc, ok := c.configs[app.Id]
+	if !ok || cc.commit != commit || cc.source != applicationConfigSource(app) {
+		return nil, applicationConfigFile{}, false
+	}
+	return cc.spec, cc.file, true
+}
+
+// Put records the config of the given application loaded at the given commit.
+func (c *applicationConfigCache) Put(app *model.Application, commit string, spec *config.GenericApplicationSpec, file applicationConfigFile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configs[app.Id] = cachedApplicationConfig{
+		commit: commit,
+		source: applicationConfigSource(app),
+		spec:   spec,
+		file:   file,
+	}
+}
+
+// Delete removes the config of the given application.
+func (c *applicationConfigCache) Delete(appID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.configs, appID)
+}
+
+func applicationConfigSource(app *model.Application) string {
+	return strings.Join([]string{
+		app.Kind.String(),
+		app.EnvId,
+		app.GitPath.GetApplicationConfigFilePath(),
+	}, "/")
+}
+
+// loadApplicationConfiguration loads the config of the given application at the given commit
+// from the cache, or from the file in the given repository if it was not loaded at that commit yet.
+// The errors are not cached to retry loading at the next check.
+func (t *Trigger) loadApplicationConfiguration(repoPath string, app *model.Application, commit string) (*config.GenericApplicationSpec, applicationConfigFile, error) {
+	if spec, file, ok := t.appConfigs.Get(app, commit); ok {
+		return spec, file, nil
+	}
+	spec, file, err := loadApplicationConfiguration(repoPath, app, t.config.AppConfigFallbackFilenames)
+	if err != nil {
+		t.appConfigs.Delete(app.Id)
+		return nil, applicationConfigFile{}, err
+	}
+	t.appConfigs.Put(app, commit, spec, file)
+	return spec, file, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestLoadApplicationConfigurationCached(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: `
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	writeConfig := func(filename, name string) {
+		content := []byte(appCfg + name + "\n")
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", filename), content, 0600))
+	}
+	writeConfig("app.pipecd.yaml", "app-1")
+	writeConfig("new.pipecd.yaml", "new")
+
+	var (
+		app = &model.Application{
+			Id:   "app-1",
+			Kind: model.ApplicationKind_KUBERNETES,
+			GitPath: &model.ApplicationGitPath{
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		tr = &Trigger{
+			config:     &config.PipedSpec{},
+			appConfigs: newApplicationConfigCache(),
+		}
+	)
+
+	spec, file, err := tr.loadApplicationConfiguration(repoPath, app, "commit-1")
+	require.NoError(t, err)
+	assert.Equal(t, "app-1", spec.Name)
+	assert.Equal(t, "app.pipecd.yaml", file.filename)
+
+	// The file is not read again at the same commit.
+	writeConfig("app.pipecd.yaml", "app-2")
+	cached, _, err := tr.loadApplicationConfiguration(repoPath, app, "commit-1")
+	require.NoError(t, err)
+	assert.Same(t, spec, cached)
+
+	// The file is read again once the commit changed.
+	spec, _, err = tr.loadApplicationConfiguration(repoPath, app, "commit-2")
+	require.NoError(t, err)
+	assert.Equal(t, "app-2", spec.Name)
+
+	// The file is read again once the application was changed to use another file.
+	changed := &model.Application{
+		Id:   "app-1",
+		Kind: model.ApplicationKind_KUBERNETES,
+		GitPath: &model.ApplicationGitPath{
+			Path:           "app",
+			ConfigFilename: "new.pipecd.yaml",
+		},
+	}
+	spec, file, err = tr.loadApplicationConfiguration(repoPath, changed, "commit-2")
+	require.NoError(t, err)
+	assert.Equal(t, "new", spec.Name)
+	assert.Equal(t, "new.pipecd.yaml", file.filename)
+
+	// The errors are not cached.
+	require.NoError(t, os.Remove(filepath.Join(repoPath, "app", "new.pipecd.yaml")))
+	_, _, err = tr.loadApplicationConfiguration(repoPath, changed, "commit-3")
+	require.Error(t, err)
+	writeConfig("new.pipecd.yaml", "new")
+	spec, _, err = tr.loadApplicationConfiguration(repoPath, changed, "commit-3")
+	require.NoError(t, err)
+	assert.Equal(t, "new", spec.Name)
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// branchApplicationStore keeps the branch applications registered or unregistered by this piped
+// until the application lister catches up with the changes.
+type branchApplicationStore struct {
+	mu sync.Mutex
+	// The IDs of the registered applications not listed yet keyed by their template and branch.
+	registered map[branchApplicationKey]string
+	// The IDs of the unregistered applications still listed.
+	unregistered map[string]struct{}
+}
+
+type branchApplicationKey struct {
+	templateID string
+	branch     string
+}
+
+func newBranchApplicationStore() *branchApplicationStore {
+	return &branchApplicationStore{
+		registered:   make(map[branchApplicationKey]string),
+		unregistered: make(map[string]struct{}),
+	}
+}
+
+// Sync forgets the changes already reflected in the given listed applications.
+func (s *branchApplicationStore) Sync(apps []*model.Application) {
+	listed := make(map[string]struct{}, len(apps))
+	for _, app := range apps {
+		listed[app.Id] = struct{}{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, id := range s.registered {
+		if _, ok := listed[id]; ok {
+			delete(s.registered, k)
+		}
+	}
+	for id := range s.unregistered {
+		if _, ok := listed[id]; !ok {
+			delete(s.unregistered, id)
+		}
+	}
+}
+
+// Branches returns the IDs of the applications of the given template keyed by their branch,
+// including the ones registered but not listed yet and excluding the unregistered ones.
+func (s *branchApplicationStore) Branches(templateID string, apps []*model.Application) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	branches := make(map[string]string)
+	for _, app := range apps {
+		if app.Labels[model.BranchApplicationTemplateLabelKey] != templateID {
+			continue
+		}
+		if _, ok := s.unregistered[app.Id]; ok {
+			continue
+		}
+		branches[app.Labels[model.BranchApplicationBranchLabelKey]] = app.Id
+	}
+	for k, id := range s.registered {
+		if k.templateID == templateID {
+			branches[k.branch] = id
+		}
+	}
+	return branches
+}
+
+func (s *branchApplicationStore) Registered(templateID, branch, appID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.registered[branchApplicationKey{templateID: templateID, branch: branch}] = appID
+}
+
+func (s *branchApplicationStore) Unregistered(appID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unregistered[appID] = struct{}{}
+}
+
+// IsUnregistered reports whether the given application was unregistered but is still listed.
+func (s *branchApplicationStore) IsUnregistered(appID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.unregistered[appID]
+	return ok
+}
+
+// syncBranchApplications registers an application for each new branch matching the pattern
+// of the configured branch applications in the given repositories
+// and unregisters the ones whose branch was deleted.
+// The registered applications are deployed by their first commit candidate
+// once they were listed by the application lister.
+func (t *Trigger) syncBranchApplications(ctx context.Context, repos map[string]struct{}) {
+	if len(t.config.BranchApplications) == 0 {
+		return
+	}
+
+	apps := t.applicationLister.List()
+	t.branchApps.Sync(apps)
+	for _, b := range t.config.BranchApplications {
+		template, ok := t.applicationLister.Get(b.TemplateApplicationID)
+		if !ok {
+			t.logger.Warn(fmt.Sprintf("template application %s of branch applications was not found", b.TemplateApplicationID))
+			continue
+		}
+		if _, ok := repos[template.GitPath.Repo.Id]; !ok {
+			continue
+		}
+		if err := t.syncBranchApplicationsOf(ctx, template, b, apps); err != nil {
+			t.logger.Error(fmt.Sprintf("failed to sync the branch applications of template application %s", template.Name),
+				zap.String("app-id", template.Id),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (t *Trigger) syncBranchApplicationsOf(ctx context.Context, template *model.Application, b config.PipedBranchApplication, apps []*model.Application) error {
+	r, ok := t.config.GetRepository(template.GitPath.Repo.Id)
+	if !ok {
+		return fmt.Errorf("the repository %s was not registered in Piped configuration", template.GitPath.Repo.Id)
+	}
+	pattern, err := regexp.Compile(b.BranchPattern)
+	if err != nil {
+		return err
+	}
+	remoteBranches, err := t.gitClient.ListRemoteBranches(ctx, r.Remote)
+	if err != nil {
+		return fmt.Errorf("failed to list the branches of git repository %s: %w", r.RepoID, err)
+	}
+
+	var (
+		existing = t.branchApps.Branches(template.Id, apps)
+		branches = make(map[string]struct{}, len(remoteBranches))
+	)
+	for _, branch := range remoteBranches {
+		// The branch deployed by the template itself must not be registered again.
+		if branch == t.gitRepoKeyOf(template).branch || !pattern.MatchString(branch) {
+			continue
+		}
+		branches[branch] = struct{}{}
+		if _, ok := existing[branch]; ok {
+			continue
+		}
+		resp, err := t.apiClient.RegisterBranchApplication(ctx, &pipedservice.RegisterBranchApplicationRequest{
+			TemplateApplicationId: template.Id,
+			Branch:                branch,
+		})
+		if err != nil {
+			t.logger.Error(fmt.Sprintf("failed to register the application of branch %s", branch), zap.String("template-app-id", template.Id), zap.Error(err))
+			continue
+		}
+		t.branchApps.Registered(template.Id, branch, resp.ApplicationId)
+		t.logger.Info(fmt.Sprintf("registered application %s for the new branch %s", resp.ApplicationId, branch), zap.String("template-app-id", template.Id))
+	}
+
+	for branch, appID := range existing {
+		if _, ok := branches[branch]; ok {
+			continue
+		}
+		if _, err := t.apiClient.UnregisterBranchApplication(ctx, &pipedservice.UnregisterBranchApplicationRequest{
+			ApplicationId: appID,
+		}); err != nil {
+			t.logger.Error(fmt.Sprintf("failed to unregister the application of the deleted branch %s", branch), zap.String("app-id", appID), zap.Error(err))
+			continue
+		}
+		t.branchApps.Unregistered(appID)
+		t.removeGitRepo(gitRepoKey{repoID: r.RepoID, branch: branch})
+		t.logger.Info(fmt.Sprintf("unregistered application %s since its branch %s was deleted", appID, branch), zap.String("template-app-id", template.Id))
+	}
+	return nil
+}
+
+// removeGitRepo removes the local data of the given repository branch no longer used.
+func (t *Trigger) removeGitRepo(key gitRepoKey) {
+	mu := t.gitRepoLock(key)
+	mu.Lock()
+	defer mu.Unlock()
+
+	t.gitReposMu.Lock()
+	repo, ok := t.gitRepos[key]
+	delete(t.gitRepos, key)
+	delete(t.sparseDirs, key)
+	t.gitReposMu.Unlock()
+	t.headCommits.Invalidate(key.repoID, key.branch)
+	if !ok {
+		return
+	}
+	if err := repo.Clean(); err != nil {
+		t.logger.Warn(fmt.Sprintf("failed to clean the local data of branch %s of git repository %s", key.branch, key.repoID), zap.Error(err))
+	}
+}
+
+// filterUnregisteredBranchApps removes the candidates of the branch applications
+// unregistered but still listed since their branches no longer exist.
+func (t *Trigger) filterUnregisteredBranchApps(cs []candidate) []candidate {
+	filtered := cs[:0]
+	for _, c := range cs {
+		if t.branchApps.IsUnregistered(c.application.Id) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	return filtered
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBranchApplicationStore(t *testing.T) {
+	t.Parallel()
+
+	apps := []*model.Application{
+		{Id: "app-1"},
+		{
+			Id: "app-1-feature-a",
+			Labels: map[string]string{
+				model.BranchApplicationTemplateLabelKey: "app-1",
+				model.BranchApplicationBranchLabelKey:   "feature/a",
+			},
+		},
+	}
+	s := newBranchApplicationStore()
+	s.Registered("app-1", "feature/b", "app-1-feature-b")
+	s.Unregistered("app-1-feature-a")
+	assert.Equal(t, map[string]string{"feature/b": "app-1-feature-b"}, s.Branches("app-1", apps))
+	assert.True(t, s.IsUnregistered("app-1-feature-a"))
+
+	// The changes are forgotten once they were reflected in the listed applications.
+	apps = []*model.Application{
+		{Id: "app-1"},
+		{
+			Id: "app-1-feature-b",
+			Labels: map[string]string{
+				model.BranchApplicationTemplateLabelKey: "app-1",
+				model.BranchApplicationBranchLabelKey:   "feature/b",
+			},
+		},
+	}
+	s.Sync(apps)
+	assert.Empty(t, s.registered)
+	assert.False(t, s.IsUnregistered("app-1-feature-a"))
+	assert.Equal(t, map[string]string{"feature/b": "app-1-feature-b"}, s.Branches("app-1", apps))
+	assert.Empty(t, s.Branches("app-2", apps))
+}
+
+func TestSyncBranchApplications(t *testing.T) {
+	t.Parallel()
+
+	const remote = "git@github.com:org/repo-1.git"
+	var (
+		template = &model.Application{
+			Id:   "app-1",
+			Name: "app-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "repo-1", Branch: "main"},
+				Path: "app-1",
+			},
+		}
+		oldApp = &model.Application{
+			Id:   "app-1-feature/old",
+			Name: "app-1-feature-old",
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "repo-1", Branch: "feature/old"},
+				Path: "app-1",
+			},
+			Labels: map[string]string{
+				model.BranchApplicationTemplateLabelKey: "app-1",
+				model.BranchApplicationBranchLabelKey:   "feature/old",
+			},
+		}
+		apiClient = &recordingAPIClient{}
+		gitClient = &fakeGitClient{
+			branches: map[string][]string{
+				remote: {"feature/new", "feature/old-2", "fix/bug", "main"},
+			},
+		}
+	)
+	cfg := &config.PipedSpec{
+		Repositories: []config.PipedRepository{{RepoID: "repo-1", Remote: remote, Branch: "main"}},
+		BranchApplications: []config.PipedBranchApplication{
+			{TemplateApplicationID: "app-1", BranchPattern: "^feature/"},
+		},
+	}
+	tr, err := NewTrigger(apiClient, gitClient, &fakeApplicationLister{apps: []*model.Application{template, oldApp}}, nil, nil, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tr.syncBranchApplications(ctx, makeRepoSet([]string{"repo-1"}))
+	assert.Equal(t, map[string]string{
+		"app-1-feature/new":   "feature/new",
+		"app-1-feature/old-2": "feature/old-2",
+	}, apiClient.branchApps)
+	assert.Equal(t, []string{"app-1-feature/old"}, apiClient.unregistered)
+
+	// The candidates of the unregistered application are no longer checked.
+	cs := tr.filterUnregisteredBranchApps([]candidate{{application: template}, {application: oldApp}})
+	assert.Equal(t, []candidate{{application: template}}, cs)
+
+	// Nothing is registered or unregistered again before the application lister catches up.
+	apiClient.branchApps = nil
+	tr.syncBranchApplications(ctx, makeRepoSet([]string{"repo-1"}))
+	assert.Empty(t, apiClient.branchApps)
+	assert.Equal(t, []string{"app-1-feature/old"}, apiClient.unregistered)
+
+	// The repositories not being checked are ignored.
+	gitClient.branches[remote] = []string{"main"}
+	tr.syncBranchApplications(ctx, makeRepoSet([]string{"repo-2"}))
+	assert.Equal(t, []string{"app-1-feature/old"}, apiClient.unregistered)
+}
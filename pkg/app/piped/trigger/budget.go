@@ -0,0 +1,159 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DeferredCandidate represents an application which should be triggered
+// but was deferred because the deployment budget was exhausted.
+type DeferredCandidate struct {
+	ApplicationID   string    `json:"applicationId"`
+	ApplicationName string    `json:"applicationName"`
+	Kind            string    `json:"kind"`
+	DeferredAt      time.Time `json:"deferredAt"`
+}
+
+// DeploymentBudgetStatus represents the current consumption of the deployment budget.
+type DeploymentBudgetStatus struct {
+	// Zero means no limit.
+	Limit    int                 `json:"limit"`
+	Window   string              `json:"window"`
+	Consumed int                 `json:"consumed"`
+	Deferred []DeferredCandidate `json:"deferred"`
+}
+
+// deploymentBudget limits the number of deployments can be triggered
+// across all applications within a rolling window.
+// An unlimited budget is used when limit is zero.
+type deploymentBudget struct {
+	limit  int
+	window time.Duration
+
+	mu       sync.Mutex
+	consumed []time.Time
+	deferred map[string]DeferredCandidate
+}
+
+func newDeploymentBudget(limit int, window time.Duration) *deploymentBudget {
+	return &deploymentBudget{
+		limit:    limit,
+		window:   window,
+		deferred: make(map[string]DeferredCandidate),
+	}
+}
+
+// TryConsume consumes one deployment from the budget for the given candidate.
+// False is returned and the candidate is recorded as deferred when the budget was exhausted.
+func (b *deploymentBudget) TryConsume(c candidate, now time.Time) bool {
+	if b.limit <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire(now)
+	app := c.application
+	if len(b.consumed) >= b.limit {
+		if _, ok := b.deferred[app.Id]; !ok {
+			b.deferred[app.Id] = DeferredCandidate{
+				ApplicationID:   app.Id,
+				ApplicationName: app.Name,
+				Kind:            c.kind.String(),
+				DeferredAt:      now,
+			}
+		}
+		return false
+	}
+
+	b.consumed = append(b.consumed, now)
+	delete(b.deferred, app.Id)
+	return true
+}
+
+// Forget removes the given application from the deferred list
+// since it no longer needs to be triggered.
+func (b *deploymentBudget) Forget(appID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.deferred, appID)
+}
+
+// Consumed returns the number of deployments triggered within the current window.
+func (b *deploymentBudget) Consumed(now time.Time) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expire(now)
+	return len(b.consumed)
+}
+
+// Status returns the current consumption of the budget.
+func (b *deploymentBudget) Status(now time.Time) DeploymentBudgetStatus {
+	return DeploymentBudgetStatus{
+		Limit:    b.limit,
+		Window:   b.window.String(),
+		Consumed: b.Consumed(now),
+		Deferred: b.DeferredCandidates(),
+	}
+}
+
+// DeferredCandidates returns the list of deferred candidates ordered by their deferred time.
+func (b *deploymentBudget) DeferredCandidates() []DeferredCandidate {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	list := make([]DeferredCandidate, 0, len(b.deferred))
+	for _, d := range b.deferred {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].DeferredAt.Before(list[j].DeferredAt)
+	})
+	return list
+}
+
+// Prioritize sorts the given candidates to let the ones deferred earlier be checked first,
+// so that the deferred candidates are drained fairly over the subsequent checks.
+func (b *deploymentBudget) Prioritize(cs []candidate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.deferred) == 0 {
+		return
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		di, iok := b.deferred[cs[i].application.Id]
+		dj, jok := b.deferred[cs[j].application.Id]
+		if iok != jok {
+			return iok
+		}
+		return iok && di.DeferredAt.Before(dj.DeferredAt)
+	})
+}
+
+func (b *deploymentBudget) expire(now time.Time) {
+	var (
+		threshold = now.Add(-b.window)
+		i         = 0
+	)
+	for i < len(b.consumed) && !b.consumed[i].After(threshold) {
+		i++
+	}
+	b.consumed = b.consumed[i:]
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func newBudgetCandidate(id string) candidate {
+	return candidate{
+		application: &model.Application{Id: id, Name: id},
+		kind:        model.TriggerKind_ON_COMMIT,
+	}
+}
+
+func TestDeploymentBudget(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		b   = newDeploymentBudget(2, time.Hour)
+	)
+
+	assert.True(t, b.TryConsume(newBudgetCandidate("app-1"), now))
+	assert.True(t, b.TryConsume(newBudgetCandidate("app-2"), now.Add(10*time.Minute)))
+	assert.False(t, b.TryConsume(newBudgetCandidate("app-3"), now.Add(20*time.Minute)))
+	assert.False(t, b.TryConsume(newBudgetCandidate("app-4"), now.Add(30*time.Minute)))
+	assert.Equal(t, 2, b.Consumed(now.Add(30*time.Minute)))
+
+	deferred := b.DeferredCandidates()
+	assert.Len(t, deferred, 2)
+	assert.Equal(t, "app-3", deferred[0].ApplicationID)
+	assert.Equal(t, now.Add(20*time.Minute), deferred[0].DeferredAt)
+
+	// The first consumption was expired.
+	assert.Equal(t, 1, b.Consumed(now.Add(time.Hour)))
+	assert.True(t, b.TryConsume(newBudgetCandidate("app-3"), now.Add(time.Hour)))
+	assert.Len(t, b.DeferredCandidates(), 1)
+
+	b.Forget("app-4")
+	assert.Len(t, b.DeferredCandidates(), 0)
+}
+
+func TestDeploymentBudgetUnlimited(t *testing.T) {
+	t.Parallel()
+
+	b := newDeploymentBudget(0, 0)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		assert.True(t, b.TryConsume(newBudgetCandidate("app"), now))
+	}
+	assert.Len(t, b.DeferredCandidates(), 0)
+}
+
+func TestDeploymentBudgetPrioritize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now = time.Now()
+		b   = newDeploymentBudget(1, time.Hour)
+	)
+	b.TryConsume(newBudgetCandidate("app-1"), now)
+	b.TryConsume(newBudgetCandidate("app-4"), now.Add(time.Minute))
+	b.TryConsume(newBudgetCandidate("app-3"), now.Add(2*time.Minute))
+
+	cs := []candidate{
+		newBudgetCandidate("app-1"),
+		newBudgetCandidate("app-2"),
+		newBudgetCandidate("app-3"),
+		newBudgetCandidate("app-4"),
+	}
+	b.Prioritize(cs)
+
+	ids := make([]string, 0, len(cs))
+	for _, c := range cs {
+		ids = append(ids, c.application.Id)
+	}
+	assert.Equal(t, []string{"app-4", "app-3", "app-1", "app-2"}, ids)
+}
@@ -16,44 +16,239 @@ package trigger
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// maxWarmBatchSize is the maximum number of applications queried by one batch request.
+const maxWarmBatchSize = 100
+
 type lastTriggeredCommitStore struct {
 	apiClient apiClient
+	// Optional lister to find the last handled commits reported by the pipeds.
+	appLister applicationLister
 	cache     cache.Cache
+	// Optional file to persist the last triggered commits.
+	file *lastTriggeredCommitFile
+	// Optional reporter to let the control-plane know the commits handled without triggering.
+	reporter *lastTriggeredCommitReporter
+	// Whether the control-plane does not support the batch request.
+	// This is accessed only from the trigger loop.
+	batchUnsupported bool
+}
+
+// sizedCache is implemented by the caches able to report the number of their items.
+type sizedCache interface {
+	Len() int
+}
+
+type lastTriggered struct {
+	commit string
+	// The time when the last deployment was triggered by this piped.
+	// Zero means no deployment has been triggered since piped started.
+	triggeredAt time.Time
 }
 
 func (s *lastTriggeredCommitStore) Get(ctx context.Context, applicationID string) (string, error) {
 	// Firstly, find from memory cache.
-	commit, err := s.cache.Get(applicationID)
-	if err == nil {
-		return commit.(string), nil
+	if v, err := s.cache.Get(applicationID); err == nil {
+		triggermetrics.LookedUpLastTriggeredCommit(triggermetrics.CacheResultHit)
+		return v.(lastTriggered).commit, nil
 	}
+	triggermetrics.LookedUpLastTriggeredCommit(triggermetrics.CacheResultMiss)
 
 	// No data in memorycache so we have to cost a RPC call to get from control-plane.
 	deploy, err := s.getLastTriggeredDeployment(ctx, applicationID)
 	switch {
 	case err == nil:
-		return deploy.Trigger.Commit.Hash, nil
+		return s.latestCommit(applicationID, deploy), nil
 
 	case status.Code(err) == codes.NotFound:
 		// It seems this application has not been deployed anytime.
-		return "", nil
+		return s.latestCommit(applicationID, nil), nil
 
 	default:
 		return "", err
 	}
 }
 
+// latestCommit returns the last handled commit of the given application reported to the control-plane
+// if it was handled after the given most recently triggered deployment, otherwise the commit of that deployment.
+// Empty is returned when neither of them was found.
+func (s *lastTriggeredCommitStore) latestCommit(applicationID string, deploy *model.ApplicationDeploymentReference) string {
+	var commit string
+	if deploy != nil {
+		commit = deploy.Trigger.Commit.Hash
+	}
+	if s.appLister == nil {
+		return commit
+	}
+	app, ok := s.appLister.Get(applicationID)
+	if !ok || app.LastHandledCommit == "" {
+		return commit
+	}
+	// The deployment is preferred when they have the same time
+	// since it is not known which one is newer.
+	if deploy != nil && app.LastHandledCommitAt <= deploy.StartedAt {
+		return commit
+	}
+	return app.LastHandledCommit
+}
+
+// Put updates the last handled commit of the given application
+// while keeping the time when its last deployment was triggered.
+// The commit is also reported to the control-plane asynchronously when the reporter is configured.
 func (s *lastTriggeredCommitStore) Put(applicationID, commit string) error {
-	return s.cache.Put(applicationID, commit)
+	updated, err := s.update(applicationID, commit)
+	if err != nil || !updated {
+		return err
+	}
+	if s.reporter != nil && commit != "" {
+		s.reporter.Add(applicationID, commit, time.Now())
+	}
+	return nil
+}
+
+// update updates the last handled commit of the given application
+// and reports whether it was changed.
+func (s *lastTriggeredCommitStore) update(applicationID, commit string) (bool, error) {
+	v := lastTriggered{commit: commit}
+	if prev, err := s.cache.Get(applicationID); err == nil {
+		// Nothing to update.
+		if prev.(lastTriggered).commit == commit {
+			return false, nil
+		}
+		v.triggeredAt = prev.(lastTriggered).triggeredAt
+	}
+	if err := s.put(applicationID, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PutTriggered updates the last handled commit of the given application
+// and the time when its deployment was triggered.
+func (s *lastTriggeredCommitStore) PutTriggered(applicationID, commit string, triggeredAt time.Time) error {
+	return s.put(applicationID, lastTriggered{
+		commit:      commit,
+		triggeredAt: triggeredAt,
+	})
+}
+
+func (s *lastTriggeredCommitStore) put(applicationID string, v lastTriggered) error {
+	if err := s.cache.Put(applicationID, v); err != nil {
+		return err
+	}
+	s.reportSize()
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Append(applicationID, v)
+}
+
+// Refresh replaces the cached commit of the given application with the newer one of
+// its most recent deployment re-fetched from the control-plane and its last handled commit,
+// while keeping the time when its last deployment was triggered.
+// The cached commit is kept as is when it failed to fetch.
+func (s *lastTriggeredCommitStore) Refresh(ctx context.Context, applicationID string) (string, error) {
+	var commit string
+	deploy, err := s.getLastTriggeredDeployment(ctx, applicationID)
+	switch {
+	case err == nil:
+		commit = s.latestCommit(applicationID, deploy)
+
+	case status.Code(err) == codes.NotFound:
+		// It seems this application has not been deployed anytime.
+		commit = s.latestCommit(applicationID, nil)
+
+	default:
+		return "", err
+	}
+
+	// The commit fetched from the control-plane does not need to be reported back.
+	if _, err := s.update(applicationID, commit); err != nil {
+		return "", err
+	}
+	return commit, nil
+}
+
+// Warm fetches the most recent deployments of the given applications not cached yet
+// by batch requests to fill the cache in bulk instead of one RPC call per application.
+// The number of the cached applications is returned.
+// Nothing is done when the control-plane does not support the batch request,
+// and the commits are fetched one by one on the cache misses as before.
+func (s *lastTriggeredCommitStore) Warm(ctx context.Context, applicationIDs []string) (int, error) {
+	if s.batchUnsupported {
+		return 0, nil
+	}
+
+	var (
+		misses = make([]string, 0, len(applicationIDs))
+		seen   = make(map[string]struct{}, len(applicationIDs))
+	)
+	for _, id := range applicationIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if _, err := s.cache.Get(id); err == nil {
+			continue
+		}
+		misses = append(misses, id)
+	}
+
+	warmed := 0
+	for len(misses) > 0 {
+		n := len(misses)
+		if n > maxWarmBatchSize {
+			n = maxWarmBatchSize
+		}
+		deploys, err := s.listLastTriggeredDeployments(ctx, misses[:n])
+		if status.Code(err) == codes.Unimplemented {
+			s.batchUnsupported = true
+			return warmed, nil
+		}
+		if err != nil {
+			return warmed, err
+		}
+		// The applications having no deployment are not cached
+		// to let them be fetched again as done by Get.
+		for id, d := range deploys {
+			if err := s.cache.Put(id, lastTriggered{commit: s.latestCommit(id, d)}); err != nil {
+				return warmed, err
+			}
+			warmed++
+		}
+		misses = misses[n:]
+		s.reportSize()
+	}
+	return warmed, nil
+}
+
+// reportSize reports the number of the commits kept in the cache if it can be counted.
+func (s *lastTriggeredCommitStore) reportSize() {
+	if c, ok := s.cache.(sizedCache); ok {
+		triggermetrics.SetLastTriggeredCommitCacheSize(c.Len())
+	}
+}
+
+// GetTriggeredAt returns the time when the last deployment of the given application
+// was triggered by this piped. False is returned if it was not found.
+func (s *lastTriggeredCommitStore) GetTriggeredAt(applicationID string) (time.Time, bool) {
+	v, err := s.cache.Get(applicationID)
+	if err != nil {
+		return time.Time{}, false
+	}
+	at := v.(lastTriggered).triggeredAt
+	return at, !at.IsZero()
 }
 
 func (s *lastTriggeredCommitStore) getLastTriggeredDeployment(ctx context.Context, applicationID string) (*model.ApplicationDeploymentReference, error) {
@@ -77,3 +272,52 @@ func (s *lastTriggeredCommitStore) getLastTriggeredDeployment(ctx context.Contex
 	}
 	return nil, err
 }
+
+func (s *lastTriggeredCommitStore) listLastTriggeredDeployments(ctx context.Context, applicationIDs []string) (map[string]*model.ApplicationDeploymentReference, error) {
+	var (
+		err   error
+		resp  *pipedservice.ListApplicationMostRecentDeploymentsResponse
+		retry = pipedservice.NewRetry(3)
+		req   = &pipedservice.ListApplicationMostRecentDeploymentsRequest{
+			ApplicationIds: applicationIDs,
+			Status:         model.DeploymentStatus_DEPLOYMENT_PENDING,
+		}
+	)
+
+	for retry.WaitNext(ctx) {
+		if resp, err = s.apiClient.ListApplicationMostRecentDeployments(ctx, req); err == nil {
+			return resp.Deployments, nil
+		}
+		if !pipedservice.Retriable(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// lastTriggeredTagStore keeps the tag of the most recent deployment of each application
+// triggered in the repositories using tags instead of the branch head.
+type lastTriggeredTagStore struct {
+	mu   sync.RWMutex
+	tags map[string]string
+}
+
+func newLastTriggeredTagStore() *lastTriggeredTagStore {
+	return &lastTriggeredTagStore{
+		tags: make(map[string]string),
+	}
+}
+
+// Get returns the last triggered tag of the given application.
+// Empty is returned if no deployment was triggered by tags since piped started.
+func (s *lastTriggeredTagStore) Get(applicationID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tags[applicationID]
+}
+
+func (s *lastTriggeredTagStore) Put(applicationID, tag string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tags[applicationID] = tag
+}
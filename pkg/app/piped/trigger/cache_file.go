@@ -0,0 +1,156 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// lastTriggeredCommitFile persists the last triggered commits into a local file
+// to let them survive the restarts of piped.
+// Every update is appended to the file as a JSON line,
+// and the file is compacted to keep only the latest record of each application while opening.
+type lastTriggeredCommitFile struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+type lastTriggeredCommitRecord struct {
+	ApplicationID string `json:"applicationId"`
+	Commit        string `json:"commit"`
+	TriggeredAt   int64  `json:"triggeredAt,omitempty"`
+}
+
+// openLastTriggeredCommitFile opens the file at the given path and returns the persisted records.
+// The file is created if it does not exist.
+func openLastTriggeredCommitFile(path string) (*lastTriggeredCommitFile, map[string]lastTriggered, error) {
+	records, err := loadLastTriggeredCommitRecords(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := compactLastTriggeredCommitFile(path, records); err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[string]lastTriggered, len(records))
+	for id, r := range records {
+		v := lastTriggered{commit: r.Commit}
+		if r.TriggeredAt > 0 {
+			v.triggeredAt = time.Unix(r.TriggeredAt, 0)
+		}
+		values[id] = v
+	}
+	return &lastTriggeredCommitFile{file: file}, values, nil
+}
+
+func loadLastTriggeredCommitRecords(path string) (map[string]lastTriggeredCommitRecord, error) {
+	records := make(map[string]lastTriggeredCommitRecord)
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var r lastTriggeredCommitRecord
+		// The last line may be broken when piped was stopped while writing it.
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			continue
+		}
+		records[r.ApplicationID] = r
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read last triggered commits from %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// compactLastTriggeredCommitFile rewrites the file with the given records only.
+// The records are written into a temporary file first and then it is renamed
+// to avoid losing the data when piped was stopped while writing.
+func compactLastTriggeredCommitFile(path string, records map[string]lastTriggeredCommitRecord) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+	for _, id := range ids {
+		if err := enc.Encode(records[id]); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (f *lastTriggeredCommitFile) Append(applicationID string, v lastTriggered) error {
+	r := lastTriggeredCommitRecord{
+		ApplicationID: applicationID,
+		Commit:        v.commit,
+	}
+	if !v.triggeredAt.IsZero() {
+		r.TriggeredAt = v.triggeredAt.Unix()
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err = f.file.Write(append(data, '\n'))
+	return err
+}
+
+func (f *lastTriggeredCommitFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
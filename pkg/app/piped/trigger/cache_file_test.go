@@ -0,0 +1,65 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastTriggeredCommitFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "data", "last-triggered-commits")
+
+	// The file is created at the first time.
+	f, values, err := openLastTriggeredCommitFile(path)
+	require.NoError(t, err)
+	assert.Len(t, values, 0)
+
+	triggeredAt := time.Unix(1600000000, 0)
+	require.NoError(t, f.Append("app-1", lastTriggered{commit: "commit-1"}))
+	require.NoError(t, f.Append("app-2", lastTriggered{commit: "commit-2", triggeredAt: triggeredAt}))
+	require.NoError(t, f.Append("app-1", lastTriggered{commit: "commit-3"}))
+	require.NoError(t, f.Close())
+
+	// Simulate a broken line written while stopping.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"applicationId":"app-1","com`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	f, values, err = openLastTriggeredCommitFile(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	expected := map[string]lastTriggered{
+		"app-1": {commit: "commit-3"},
+		"app-2": {commit: "commit-2", triggeredAt: triggeredAt},
+	}
+	assert.Equal(t, expected, values)
+
+	// The file was compacted to keep only the latest records.
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 2)
+}
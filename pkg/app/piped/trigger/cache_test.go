@@ -0,0 +1,112 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeBatchAPIClient struct {
+	fakeDependencyAPIClient
+	unimplemented bool
+	// The application IDs requested by each batch request.
+	requests [][]string
+}
+
+func (c *fakeBatchAPIClient) ListApplicationMostRecentDeployments(_ context.Context, req *pipedservice.ListApplicationMostRecentDeploymentsRequest, _ ...grpc.CallOption) (*pipedservice.ListApplicationMostRecentDeploymentsResponse, error) {
+	if c.unimplemented {
+		return nil, status.Error(codes.Unimplemented, "unimplemented")
+	}
+	c.requests = append(c.requests, req.ApplicationIds)
+	deployments := make(map[string]*model.ApplicationDeploymentReference)
+	for _, id := range req.ApplicationIds {
+		if d, ok := c.mostRecent[id][req.Status]; ok {
+			deployments[id] = d
+		}
+	}
+	return &pipedservice.ListApplicationMostRecentDeploymentsResponse{Deployments: deployments}, nil
+}
+
+func TestLastTriggeredCommitStoreWarm(t *testing.T) {
+	t.Parallel()
+
+	pending := model.DeploymentStatus_DEPLOYMENT_PENDING
+	newAPIClient := func(unimplemented bool) *fakeBatchAPIClient {
+		return &fakeBatchAPIClient{
+			fakeDependencyAPIClient: fakeDependencyAPIClient{
+				mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+					"app-1": {pending: newDeploymentReference("deployment-1", "commit-1")},
+					"app-2": {pending: newDeploymentReference("deployment-2", "commit-2")},
+				},
+			},
+			unimplemented: unimplemented,
+		}
+	}
+
+	t.Run("warm in bulk", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := memorycache.NewLRUCache(10)
+		require.NoError(t, err)
+		apiClient := newAPIClient(false)
+		s := &lastTriggeredCommitStore{apiClient: apiClient, cache: cache}
+		require.NoError(t, s.Put("app-2", "cached-commit"))
+
+		n, err := s.Warm(context.Background(), []string{"app-1", "app-2", "app-3", "app-1"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		// Only the applications missing in the cache are requested.
+		assert.Equal(t, [][]string{{"app-1", "app-3"}}, apiClient.requests)
+
+		v, err := cache.Get("app-1")
+		require.NoError(t, err)
+		assert.Equal(t, "commit-1", v.(lastTriggered).commit)
+		v, err = cache.Get("app-2")
+		require.NoError(t, err)
+		assert.Equal(t, "cached-commit", v.(lastTriggered).commit)
+		// The application having no deployment is not cached.
+		_, err = cache.Get("app-3")
+		assert.Error(t, err)
+	})
+
+	t.Run("fall back to per-application queries", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := memorycache.NewLRUCache(10)
+		require.NoError(t, err)
+		apiClient := newAPIClient(true)
+		s := &lastTriggeredCommitStore{apiClient: apiClient, cache: cache}
+
+		n, err := s.Warm(context.Background(), []string{"app-1"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.True(t, s.batchUnsupported)
+
+		commit, err := s.Get(context.Background(), "app-1")
+		require.NoError(t, err)
+		assert.Equal(t, "commit-1", commit)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// CandidateStatus represents a candidate found at the most recent check of its repository.
+type CandidateStatus struct {
+	ApplicationID   string    `json:"applicationId"`
+	ApplicationName string    `json:"applicationName"`
+	Kind            string    `json:"kind"`
+	RepoID          string    `json:"repoId"`
+	Branch          string    `json:"branch"`
+	HeadCommit      string    `json:"headCommit"`
+	CoolingDown     bool      `json:"coolingDown"`
+	CheckedAt       time.Time `json:"checkedAt"`
+}
+
+// candidateStatusStore keeps the candidates of each repository branch found at its most recent check.
+type candidateStatusStore struct {
+	mu       sync.RWMutex
+	statuses map[gitRepoKey][]CandidateStatus
+}
+
+func newCandidateStatusStore() *candidateStatusStore {
+	return &candidateStatusStore{
+		statuses: make(map[gitRepoKey][]CandidateStatus),
+	}
+}
+
+// Set replaces the candidates of the given repository branch.
+func (s *candidateStatusStore) Set(key gitRepoKey, statuses []CandidateStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[key] = statuses
+}
+
+// Delete removes the candidates of all branches of the given repository.
+func (s *candidateStatusStore) Delete(repoID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.statuses {
+		if key.repoID == repoID {
+			delete(s.statuses, key)
+		}
+	}
+}
+
+// List returns all candidates ordered by their repository, branch and application.
+func (s *candidateStatusStore) List() []CandidateStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := make([]CandidateStatus, 0)
+	for _, statuses := range s.statuses {
+		list = append(list, statuses...)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].RepoID != list[j].RepoID {
+			return list[i].RepoID < list[j].RepoID
+		}
+		if list[i].Branch != list[j].Branch {
+			return list[i].Branch < list[j].Branch
+		}
+		return list[i].ApplicationID < list[j].ApplicationID
+	})
+	return list
+}
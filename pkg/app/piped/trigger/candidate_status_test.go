@@ -0,0 +1,57 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidateStatusStore(t *testing.T) {
+	t.Parallel()
+
+	s := newCandidateStatusStore()
+	assert.Empty(t, s.List())
+
+	s.Set(gitRepoKey{repoID: "repo-2", branch: "main"}, []CandidateStatus{
+		{ApplicationID: "app-3", RepoID: "repo-2", Branch: "main"},
+	})
+	s.Set(gitRepoKey{repoID: "repo-1", branch: "main"}, []CandidateStatus{
+		{ApplicationID: "app-2", RepoID: "repo-1", Branch: "main"},
+		{ApplicationID: "app-1", RepoID: "repo-1", Branch: "main"},
+	})
+	s.Set(gitRepoKey{repoID: "repo-1", branch: "dev"}, []CandidateStatus{
+		{ApplicationID: "app-4", RepoID: "repo-1", Branch: "dev"},
+	})
+
+	appIDs := func(list []CandidateStatus) []string {
+		ids := make([]string, 0, len(list))
+		for _, s := range list {
+			ids = append(ids, s.ApplicationID)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"app-4", "app-1", "app-2", "app-3"}, appIDs(s.List()))
+
+	// The candidates found at the previous check are replaced.
+	s.Set(gitRepoKey{repoID: "repo-1", branch: "main"}, []CandidateStatus{
+		{ApplicationID: "app-1", RepoID: "repo-1", Branch: "main"},
+	})
+	assert.Equal(t, []string{"app-4", "app-1", "app-3"}, appIDs(s.List()))
+
+	s.Delete("repo-1")
+	assert.Equal(t, []string{"app-3"}, appIDs(s.List()))
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSummaryDirectories is the maximum number of top-level directories listed in the changes summary
+// to keep the notifications small even for the huge changes.
+const maxSummaryDirectories = 5
+
+// summarizeChangedFiles returns a short summary of the given changed files
+// containing their count and the top-level directories changed the most,
+// e.g. "12 files changed: apps/ (8), base/ (3), ./ (1)".
+// The files at the root of the repository are counted as "./".
+func summarizeChangedFiles(files []string) string {
+	if len(files) == 0 {
+		return ""
+	}
+
+	counts := make(map[string]int)
+	for _, f := range files {
+		dir := "./"
+		if i := strings.Index(f, "/"); i > 0 {
+			dir = f[:i+1]
+		}
+		counts[dir]++
+	}
+	dirs := make([]string, 0, len(counts))
+	for d := range counts {
+		dirs = append(dirs, d)
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		if counts[dirs[i]] != counts[dirs[j]] {
+			return counts[dirs[i]] > counts[dirs[j]]
+		}
+		return dirs[i] < dirs[j]
+	})
+
+	noun := "files"
+	if len(files) == 1 {
+		noun = "file"
+	}
+	n := len(dirs)
+	if n > maxSummaryDirectories {
+		n = maxSummaryDirectories
+	}
+	items := make([]string, 0, n+1)
+	for _, d := range dirs[:n] {
+		items = append(items, fmt.Sprintf("%s (%d)", d, counts[d]))
+	}
+	if rest := len(dirs) - n; rest > 0 {
+		items = append(items, fmt.Sprintf("and %d more directories", rest))
+	}
+	return fmt.Sprintf("%d %s changed: %s", len(files), noun, strings.Join(items, ", "))
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		files    []string
+		expected string
+	}{
+		{
+			name: "no changed file",
+		},
+		{
+			name:     "one file",
+			files:    []string{"apps/foo/deployment.yaml"},
+			expected: "1 file changed: apps/ (1)",
+		},
+		{
+			name: "sorted by count",
+			files: []string{
+				"README.md",
+				"base/service.yaml",
+				"apps/foo/deployment.yaml",
+				"apps/bar/deployment.yaml",
+				"base/kustomization.yaml",
+				"apps/baz/deployment.yaml",
+			},
+			expected: "6 files changed: apps/ (3), base/ (2), ./ (1)",
+		},
+		{
+			name:     "too many directories",
+			files:    []string{"a/1", "b/1", "c/1", "d/1", "e/1", "f/1", "g/1"},
+			expected: "7 files changed: a/ (1), b/ (1), c/ (1), d/ (1), e/ (1), and 2 more directories",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, summarizeChangedFiles(tc.files))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+)
+
+// lastTriggeredCommitReporter reports the commits handled without triggering a deployment
+// to the control-plane asynchronously, so that the other pipeds taking over the applications,
+// e.g. after a restart on another host, continue from them instead of checking the same changes again.
+//
+// The commits are saved as the last handled commit of each application,
+// apart from the reference to its most recently triggered deployment.
+type lastTriggeredCommitReporter struct {
+	apiClient  apiClient
+	interval   time.Duration
+	maxReports int
+	logger     *zap.Logger
+
+	mu      sync.Mutex
+	pending map[string]pendingCommitReport
+	// The applications waiting to be reported in the order they were added.
+	queue []string
+	// Whether the control-plane does not support the report.
+	unsupported bool
+}
+
+func newLastTriggeredCommitReporter(apiClient apiClient, interval time.Duration, maxReports int, logger *zap.Logger) *lastTriggeredCommitReporter {
+	return &lastTriggeredCommitReporter{
+		apiClient:  apiClient,
+		interval:   interval,
+		maxReports: maxReports,
+		logger:     logger.Named("last-triggered-commit-reporter"),
+		pending:    make(map[string]pendingCommitReport),
+	}
+}
+
+// Add queues the given commit handled at the given time to be reported
+// as the last handled commit of the given application.
+// The commit queued before for the same application is replaced.
+func (r *lastTriggeredCommitReporter) Add(applicationID, commit string, handledAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.unsupported {
+		return
+	}
+	if _, ok := r.pending[applicationID]; !ok {
+		r.queue = append(r.queue, applicationID)
+	}
+	r.pending[applicationID] = pendingCommitReport{
+		applicationID: applicationID,
+		commit:        commit,
+		handledAt:     handledAt,
+	}
+}
+
+// Len returns the number of the applications waiting to be reported.
+func (r *lastTriggeredCommitReporter) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.queue)
+}
+
+type pendingCommitReport struct {
+	applicationID string
+	commit        string
+	handledAt     time.Time
+}
+
+// take removes at most the given number of the applications from the queue in order.
+func (r *lastTriggeredCommitReporter) take(n int) []pendingCommitReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n > len(r.queue) {
+		n = len(r.queue)
+	}
+	taken := make([]pendingCommitReport, 0, n)
+	for _, id := range r.queue[:n] {
+		taken = append(taken, r.pending[id])
+		delete(r.pending, id)
+	}
+	r.queue = r.queue[n:]
+	return taken
+}
+
+// requeue adds the given report back to be reported in the next batch
+// unless a newer one was added for the same application in the meantime.
+func (r *lastTriggeredCommitReporter) requeue(p pendingCommitReport) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.pending[p.applicationID]; ok {
+		return
+	}
+	r.queue = append(r.queue, p.applicationID)
+	r.pending[p.applicationID] = p
+}
+
+// setUnsupported stops reporting since the control-plane does not support it.
+func (r *lastTriggeredCommitReporter) setUnsupported() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unsupported = true
+	r.pending = make(map[string]pendingCommitReport)
+	r.queue = nil
+}
+
+// Run reports the queued commits in batches at every interval until the given context is done.
+func (r *lastTriggeredCommitReporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if n := r.Len(); n > 0 {
+				r.logger.Info(fmt.Sprintf("stopped reporting with %d last triggered commits left unreported", n))
+			}
+			return
+		case <-ticker.C:
+			r.Flush(ctx)
+		}
+	}
+}
+
+// Flush reports at most the configured number of the queued commits
+// and returns the number of the reported ones.
+// The ones failed by the retriable errors are queued again to be reported in the next batch.
+func (r *lastTriggeredCommitReporter) Flush(ctx context.Context) int {
+	reported := 0
+	for _, p := range r.take(r.maxReports) {
+		_, err := r.apiClient.ReportApplicationLastHandledCommit(ctx, &pipedservice.ReportApplicationLastHandledCommitRequest{
+			ApplicationId: p.applicationID,
+			Commit:        p.commit,
+			HandledAt:     p.handledAt.Unix(),
+		})
+		if status.Code(err) == codes.Unimplemented {
+			r.logger.Info("stopped reporting the last triggered commits since the control-plane does not support it")
+			r.setUnsupported()
+			break
+		}
+		if err != nil {
+			r.logger.Error("failed to report the last triggered commit",
+				zap.String("app-id", p.applicationID),
+				zap.String("commit", p.commit),
+				zap.Error(err),
+			)
+			if pipedservice.Retriable(err) {
+				r.requeue(p)
+			}
+			continue
+		}
+		reported++
+	}
+	if reported > 0 {
+		r.logger.Info(fmt.Sprintf("reported %d last triggered commits to the control-plane", reported))
+	}
+	return reported
+}
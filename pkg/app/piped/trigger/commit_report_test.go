@@ -0,0 +1,192 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// failingReportAPIClient fails to report the last handled commits with the given code.
+type failingReportAPIClient struct {
+	*recordingAPIClient
+	code  codes.Code
+	calls int
+}
+
+func (c *failingReportAPIClient) ReportApplicationLastHandledCommit(_ context.Context, _ *pipedservice.ReportApplicationLastHandledCommitRequest, _ ...grpc.CallOption) (*pipedservice.ReportApplicationLastHandledCommitResponse, error) {
+	c.calls++
+	return nil, status.Error(c.code, "failed")
+}
+
+func TestLastTriggeredCommitStorePutReportsCommit(t *testing.T) {
+	t.Parallel()
+
+	ac := &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+		"app-1": newDeploymentReference("deployment-1", "commit-1"),
+	}}
+	cache, err := memorycache.NewLRUCache(10)
+	require.NoError(t, err)
+	s := &lastTriggeredCommitStore{
+		apiClient: ac,
+		cache:     cache,
+		reporter:  newLastTriggeredCommitReporter(ac, time.Minute, 10, zap.NewNop()),
+	}
+
+	// The commit fetched from the control-plane is not reported back.
+	commit, err := s.Refresh(context.Background(), "app-1")
+	require.NoError(t, err)
+	assert.Equal(t, "commit-1", commit)
+	assert.Equal(t, 0, s.reporter.Len())
+
+	require.NoError(t, s.Put("app-1", "commit-2"))
+	require.NoError(t, s.Put("app-1", "commit-3"))
+	// Nothing is changed by the same commit.
+	require.NoError(t, s.Put("app-1", "commit-3"))
+	assert.Equal(t, 1, s.reporter.Len())
+
+	assert.Equal(t, 1, s.reporter.Flush(context.Background()))
+	assert.Equal(t, 0, s.reporter.Len())
+	require.Contains(t, ac.lastHandled, "app-1")
+	assert.Equal(t, "commit-3", ac.lastHandled["app-1"].Commit)
+	assert.NotZero(t, ac.lastHandled["app-1"].HandledAt)
+	// The most recently triggered deployment is left as is.
+	assert.Equal(t, newDeploymentReference("deployment-1", "commit-1"), ac.mostRecent["app-1"])
+}
+
+func TestLastTriggeredCommitStoreGetLastHandledCommit(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id, commit string, handledAt int64) *model.Application {
+		return &model.Application{Id: id, LastHandledCommit: commit, LastHandledCommitAt: handledAt}
+	}
+	newRef := func(id, commit string, startedAt int64) *model.ApplicationDeploymentReference {
+		ref := newDeploymentReference(id, commit)
+		ref.StartedAt = startedAt
+		return ref
+	}
+	var (
+		ac = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+			"handled-after":  newRef("deployment-1", "commit-1", 100),
+			"handled-before": newRef("deployment-2", "commit-1", 100),
+			"handled-same":   newRef("deployment-3", "commit-1", 100),
+			"never-handled":  newRef("deployment-4", "commit-1", 100),
+		}}
+		lister = &fakeApplicationLister{apps: []*model.Application{
+			newApp("handled-after", "commit-2", 101),
+			newApp("handled-before", "commit-0", 99),
+			newApp("handled-same", "commit-2", 100),
+			newApp("never-deployed", "commit-2", 101),
+		}}
+	)
+	testcases := []struct {
+		appID    string
+		expected string
+	}{
+		{appID: "handled-after", expected: "commit-2"},
+		{appID: "handled-before", expected: "commit-1"},
+		{appID: "handled-same", expected: "commit-1"},
+		{appID: "never-handled", expected: "commit-1"},
+		{appID: "never-deployed", expected: "commit-2"},
+		{appID: "unknown", expected: ""},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.appID, func(t *testing.T) {
+			t.Parallel()
+			cache, err := memorycache.NewLRUCache(10)
+			require.NoError(t, err)
+			s := &lastTriggeredCommitStore{
+				apiClient: ac,
+				appLister: lister,
+				cache:     cache,
+			}
+			commit, err := s.Get(context.Background(), tc.appID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, commit)
+		})
+	}
+}
+
+func TestLastTriggeredCommitReporterFlush(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(100, 0)
+
+	t.Run("limit the reports per batch", func(t *testing.T) {
+		t.Parallel()
+		ac := &recordingAPIClient{}
+		r := newLastTriggeredCommitReporter(ac, time.Minute, 2, zap.NewNop())
+		r.Add("app-1", "commit-1", now)
+		r.Add("app-2", "commit-1", now)
+		r.Add("app-3", "commit-1", now)
+
+		assert.Equal(t, 2, r.Flush(context.Background()))
+		assert.Contains(t, ac.lastHandled, "app-1")
+		assert.Contains(t, ac.lastHandled, "app-2")
+		assert.NotContains(t, ac.lastHandled, "app-3")
+
+		assert.Equal(t, 1, r.Flush(context.Background()))
+		assert.Equal(t, &pipedservice.ReportApplicationLastHandledCommitRequest{
+			ApplicationId: "app-3",
+			Commit:        "commit-1",
+			HandledAt:     100,
+		}, ac.lastHandled["app-3"])
+		assert.Equal(t, 0, r.Flush(context.Background()))
+	})
+
+	t.Run("retriable failure", func(t *testing.T) {
+		t.Parallel()
+		ac := &failingReportAPIClient{recordingAPIClient: &recordingAPIClient{}, code: codes.Unavailable}
+		r := newLastTriggeredCommitReporter(ac, time.Minute, 10, zap.NewNop())
+		r.Add("app-1", "commit-1", now)
+
+		assert.Equal(t, 0, r.Flush(context.Background()))
+		assert.Equal(t, 1, r.Len())
+
+		// The newer commit added in the meantime is kept.
+		r.Add("app-1", "commit-2", now.Add(time.Second))
+		r.requeue(pendingCommitReport{applicationID: "app-1", commit: "commit-1", handledAt: now})
+		assert.Equal(t, []pendingCommitReport{{applicationID: "app-1", commit: "commit-2", handledAt: now.Add(time.Second)}}, r.take(10))
+	})
+
+	t.Run("unsupported by the control-plane", func(t *testing.T) {
+		t.Parallel()
+		ac := &failingReportAPIClient{recordingAPIClient: &recordingAPIClient{}, code: codes.Unimplemented}
+		r := newLastTriggeredCommitReporter(ac, time.Minute, 10, zap.NewNop())
+		r.Add("app-1", "commit-1", now)
+		r.Add("app-2", "commit-1", now)
+
+		// The rest are dropped without asking again.
+		assert.Equal(t, 0, r.Flush(context.Background()))
+		assert.Equal(t, 1, ac.calls)
+		assert.Equal(t, 0, r.Len())
+
+		r.Add("app-1", "commit-2", now)
+		assert.Equal(t, 0, r.Len())
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// conditionEvaluator evaluates the trigger condition configured for an application
+// against all candidates found for that application in the current check.
+//
+// A leaf of the condition is satisfied when the candidate of its kind exists
+// and the determiner of that kind decided to trigger:
+// - ON_COMMIT and ON_SCHEDULE are always candidates since every application is checked for them
+// - ON_OUT_OF_SYNC is a candidate while the application is at OUT_OF_SYNC state
+// - ON_COMMAND, ON_CHAIN and ON_EXTERNAL are candidates only when their command was received
+//
+// AND and OR are short-circuited from left to right,
+// so the determiners of the remaining leaves are not called once the result is decided.
+type conditionEvaluator struct {
+	determiners *determiners
+	app         *model.Application
+	appCfg      *config.GenericApplicationSpec
+	candidates  map[model.TriggerKind]candidate
+	results     map[model.TriggerKind]bool
+	satisfied   []candidate
+}
+
+func newConditionEvaluator(ds *determiners, app *model.Application, appCfg *config.GenericApplicationSpec, cs []candidate) *conditionEvaluator {
+	candidates := make(map[model.TriggerKind]candidate, len(cs))
+	for _, c := range cs {
+		candidates[c.kind] = c
+	}
+	for _, c := range cs {
+		for _, k := range c.mergedKinds {
+			if _, ok := candidates[k]; !ok {
+				candidates[k] = candidate{application: c.application, kind: k}
+			}
+		}
+	}
+	return &conditionEvaluator{
+		determiners: ds,
+		app:         app,
+		appCfg:      appCfg,
+		candidates:  candidates,
+		results:     make(map[model.TriggerKind]bool),
+	}
+}
+
+// Evaluate reports whether the given condition was satisfied.
+func (e *conditionEvaluator) Evaluate(ctx context.Context, cond config.TriggerCondition) (bool, error) {
+	switch {
+	case len(cond.And) != 0:
+		for _, c := range cond.And {
+			ok, err := e.Evaluate(ctx, c)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+
+	case len(cond.Or) != 0:
+		for _, c := range cond.Or {
+			ok, err := e.Evaluate(ctx, c)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	default:
+		kind, ok := cond.TriggerKind()
+		if !ok {
+			return false, fmt.Errorf("unknown trigger kind %q in trigger condition", cond.Kind)
+		}
+		return e.evaluateKind(ctx, kind)
+	}
+}
+
+func (e *conditionEvaluator) evaluateKind(ctx context.Context, kind model.TriggerKind) (bool, error) {
+	if result, ok := e.results[kind]; ok {
+		return result, nil
+	}
+
+	c, ok := e.candidates[kind]
+	if !ok {
+		switch {
+		case kind == model.TriggerKind_ON_COMMIT, kind == model.TriggerKind_ON_SCHEDULE:
+			c, ok = candidate{application: e.app, kind: kind}, true
+		case kind == model.TriggerKind_ON_OUT_OF_SYNC && e.app.IsOutOfSync():
+			c, ok = candidate{application: e.app, kind: kind}, true
+		}
+	}
+	if !ok {
+		e.results[kind] = false
+		return false, nil
+	}
+
+	result, reason, err := e.determiners.Determiner(kind).ShouldTrigger(ctx, e.app, e.appCfg)
+	if err != nil {
+		return false, err
+	}
+	e.results[kind] = result
+	if result {
+		c.reason = reason
+		c.shouldTrigger = true
+		e.satisfied = append(e.satisfied, c)
+	}
+	return result, nil
+}
+
+// Candidate returns the candidate should be used to build the deployment
+// after the condition was satisfied.
+// The candidate holding a command is preferred so that its command can be reported,
+// otherwise the first satisfied one in the evaluation order is used.
+// The reasons of all satisfied candidates are joined as its reason.
+func (e *conditionEvaluator) Candidate() (candidate, bool) {
+	if len(e.satisfied) == 0 {
+		return candidate{}, false
+	}
+
+	c := e.satisfied[0]
+	reasons := make([]string, 0, len(e.satisfied))
+	for _, s := range e.satisfied {
+		if s.HasCommand() && !c.HasCommand() {
+			c = s
+		}
+		reasons = append(reasons, s.reason)
+	}
+	c.reason = strings.Join(reasons, "; ")
+	return c, true
+}
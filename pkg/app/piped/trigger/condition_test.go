@@ -0,0 +1,154 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDeterminer struct {
+	result bool
+	reason string
+	calls  int
+}
+
+func (d *fakeDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, _ *config.GenericApplicationSpec) (bool, string, error) {
+	d.calls++
+	return d.result, d.reason, nil
+}
+
+func TestConditionEvaluator(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{
+		Id: "app-id",
+		SyncState: &model.ApplicationSyncState{
+			Status: model.ApplicationSyncStatus_SYNCED,
+		},
+	}
+	command := candidate{
+		application: app,
+		kind:        model.TriggerKind_ON_COMMAND,
+	}
+
+	testcases := []struct {
+		name              string
+		cond              config.TriggerCondition
+		candidates        []candidate
+		onCommit          bool
+		onCommand         bool
+		expected          bool
+		expectedKind      model.TriggerKind
+		expectedReason    string
+		expectedCommitRun int
+	}{
+		{
+			name: "and: all satisfied",
+			cond: config.TriggerCondition{
+				And: []config.TriggerCondition{{Kind: "ON_COMMIT"}, {Kind: "ON_COMMAND"}},
+			},
+			candidates:        []candidate{command},
+			onCommit:          true,
+			onCommand:         true,
+			expected:          true,
+			expectedKind:      model.TriggerKind_ON_COMMAND,
+			expectedReason:    "new commit; command",
+			expectedCommitRun: 1,
+		},
+		{
+			name: "and: command was not received",
+			cond: config.TriggerCondition{
+				And: []config.TriggerCondition{{Kind: "ON_COMMAND"}, {Kind: "ON_COMMIT"}},
+			},
+			onCommit:          true,
+			onCommand:         true,
+			expected:          false,
+			expectedCommitRun: 0,
+		},
+		{
+			name: "or: short-circuited by the first satisfied one",
+			cond: config.TriggerCondition{
+				Or: []config.TriggerCondition{{Kind: "ON_COMMAND"}, {Kind: "ON_COMMIT"}},
+			},
+			candidates:        []candidate{command},
+			onCommit:          true,
+			onCommand:         true,
+			expected:          true,
+			expectedKind:      model.TriggerKind_ON_COMMAND,
+			expectedReason:    "command",
+			expectedCommitRun: 0,
+		},
+		{
+			name: "or: out-of-sync is not a candidate while synced",
+			cond: config.TriggerCondition{
+				Or: []config.TriggerCondition{{Kind: "ON_OUT_OF_SYNC"}, {Kind: "ON_COMMIT"}},
+			},
+			onCommit:          true,
+			expected:          true,
+			expectedKind:      model.TriggerKind_ON_COMMIT,
+			expectedReason:    "new commit",
+			expectedCommitRun: 1,
+		},
+		{
+			name: "nested: same kind is determined only once",
+			cond: config.TriggerCondition{
+				And: []config.TriggerCondition{
+					{Kind: "ON_COMMIT"},
+					{Or: []config.TriggerCondition{{Kind: "ON_CHAIN"}, {Kind: "ON_COMMIT"}}},
+				},
+			},
+			onCommit:          true,
+			expected:          true,
+			expectedKind:      model.TriggerKind_ON_COMMIT,
+			expectedReason:    "new commit",
+			expectedCommitRun: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			onCommit := &fakeDeterminer{result: tc.onCommit, reason: "new commit"}
+			ds := &determiners{
+				onCommit:    onCommit,
+				onCommand:   &fakeDeterminer{result: tc.onCommand, reason: "command"},
+				onOutOfSync: &fakeDeterminer{result: true},
+				onChain:     &fakeDeterminer{result: true},
+			}
+			e := newConditionEvaluator(ds, app, &config.GenericApplicationSpec{}, tc.candidates)
+
+			got, err := e.Evaluate(context.Background(), tc.cond)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expectedCommitRun, onCommit.calls)
+
+			c, ok := e.Candidate()
+			assert.Equal(t, tc.expected, ok)
+			if ok {
+				assert.Equal(t, tc.expectedKind, c.kind)
+				assert.Equal(t, tc.expectedReason, c.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+
+	goyaml "github.com/goccy/go-yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
+)
+
+// changedContents returns the fields of the given contents whose value was changed between two commits
+// formatted as "<file>:<field>". The files not included in the changed files are skipped without being read.
+// The file or field missing at one of the commits is treated as having no value.
+func (d *OnCommitDeterminer) changedContents(ctx context.Context, appDir string, contents []config.OnCommitContent, from, to string, changedFiles []string) ([]string, error) {
+	changed := make(map[string]struct{}, len(changedFiles))
+	for _, f := range changedFiles {
+		changed[f] = struct{}{}
+	}
+
+	fields := make([]string, 0)
+	for _, c := range contents {
+		file := path.Join(appDir, c.File)
+		if _, ok := changed[file]; !ok {
+			continue
+		}
+		oldValue, err := d.readField(ctx, from, file, c.Field)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := d.readField(ctx, to, file, c.Field)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			fields = append(fields, fmt.Sprintf("%s:%s", file, c.Field))
+		}
+	}
+	return fields, nil
+}
+
+// readField returns the value of the given field in the given file at the given commit.
+// Nil is returned when the file or the field does not exist.
+func (d *OnCommitDeterminer) readField(ctx context.Context, commit, file, field string) (interface{}, error) {
+	data, err := d.repo.ReadFileAt(ctx, commit, file)
+	if errors.Is(err, git.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := yamlprocessor.NewProcessor(data)
+	if err != nil {
+		return nil, &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.contents: failed to parse %s at commit %s: %w", file, commit, err)}
+	}
+	value, err := p.GetValue(field)
+	if errors.Is(err, goyaml.ErrNotFoundNode) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.contents: failed to get %s in %s at commit %s: %w", field, file, commit, err)}
+	}
+	return value, nil
+}
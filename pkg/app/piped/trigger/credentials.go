@@ -0,0 +1,61 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// refreshCredentialsTimeout is how long to wait for the command refreshing the credentials of a repository.
+const refreshCredentialsTimeout = time.Minute
+
+// refreshCredentials runs the command configured to refresh the credentials of the given repository,
+// e.g. to issue a new short-lived token after the previous one expired.
+// It reports whether the credentials were refreshed to pull the repository again.
+func (t *Trigger) refreshCredentials(ctx context.Context, repoID string) bool {
+	r, ok := t.config.GetRepository(repoID)
+	if !ok || r.RefreshCredentialsCommand == "" {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, refreshCredentialsTimeout)
+	defer cancel()
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", r.RefreshCredentialsCommand)
+	cmd.Env = append(os.Environ(),
+		"PIPECD_REPOSITORY_ID="+r.RepoID,
+		"PIPECD_REPOSITORY_REMOTE="+r.Remote,
+	)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		t.logger.Error(fmt.Sprintf("failed to refresh the credentials of repo %s", repoID),
+			zap.String("output", strings.TrimSpace(out.String())),
+			zap.Error(err),
+		)
+		return false
+	}
+	t.logger.Info(fmt.Sprintf("refreshed the credentials of repo %s to pull it again", repoID))
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+// expiringTokenRepo is a Git repository rejecting the pulls until the token file was written.
+type expiringTokenRepo struct {
+	*fakeRepo
+	tokenFile string
+	pulls     int
+}
+
+func (r *expiringTokenRepo) Pull(_ context.Context, _ string) error {
+	r.pulls++
+	if _, err := os.Stat(r.tokenFile); err != nil {
+		return fmt.Errorf("%w: token expired", git.ErrAuthentication)
+	}
+	return nil
+}
+
+func TestUpdateRepoToLatestRefreshCredentials(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		command       string
+		expectedErr   bool
+		expectedPulls int
+	}{
+		{
+			name:          "not configured",
+			expectedErr:   true,
+			expectedPulls: 1,
+		},
+		{
+			name:          "refreshed",
+			command:       "touch $TOKEN_FILE",
+			expectedPulls: 2,
+		},
+		{
+			name:          "failed to refresh",
+			command:       "exit 1",
+			expectedErr:   true,
+			expectedPulls: 1,
+		},
+		{
+			name:          "still rejected after refreshing",
+			command:       "true",
+			expectedErr:   true,
+			expectedPulls: 2,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tokenFile := filepath.Join(t.TempDir(), "token")
+
+			repo := &expiringTokenRepo{
+				fakeRepo:  &fakeRepo{head: git.Commit{Hash: "commit-1"}},
+				tokenFile: tokenFile,
+			}
+			cfg := &config.PipedSpec{
+				Repositories: []config.PipedRepository{
+					{RepoID: "repo-1", Branch: "main", RefreshCredentialsCommand: strings.ReplaceAll(tc.command, "$TOKEN_FILE", tokenFile)},
+				},
+			}
+			tr, err := NewTrigger(nil, &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}, nil, nil, nil, nil, cfg, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			_, head, err := tr.updateRepoToLatest(context.Background(), gitRepoKey{repoID: "repo-1", branch: "main"})
+			if tc.expectedErr {
+				assert.ErrorIs(t, err, git.ErrAuthentication)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "commit-1", head.Hash)
+			}
+			assert.Equal(t, tc.expectedPulls, repo.pulls)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+// commitDebouncer keeps the head commit of each repository branch and when it was found changed
+// to defer triggering until no new commit was pushed for the quiet period.
+type commitDebouncer struct {
+	mu    sync.Mutex
+	heads map[gitRepoKey]debouncedHead
+}
+
+type debouncedHead struct {
+	hash      string
+	changedAt time.Time
+}
+
+func newCommitDebouncer() *commitDebouncer {
+	return &commitDebouncer{
+		heads: make(map[gitRepoKey]debouncedHead),
+	}
+}
+
+// Remaining records the given head commit of the given repository branch
+// and returns how long to wait until its quiet period ends.
+// The head commit observed for the first time is regarded as changed at the given time.
+func (d *commitDebouncer) Remaining(key gitRepoKey, hash string, period time.Duration, now time.Time) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	h, ok := d.heads[key]
+	if !ok || h.hash != hash {
+		h = debouncedHead{hash: hash, changedAt: now}
+		d.heads[key] = h
+	}
+	if elapsed := now.Sub(h.changedAt); elapsed < period {
+		return period - elapsed
+	}
+	return 0
+}
+
+// quietPeriodOf returns how long no new commit must be pushed to the given repository
+// before triggering the deployments. Zero means no quiet period.
+func (t *Trigger) quietPeriodOf(repoID string) time.Duration {
+	r, ok := t.config.GetRepository(repoID)
+	if !ok {
+		return 0
+	}
+	return r.QuietPeriod.Duration()
+}
+
+// debounceCandidates defers the candidates other than the commands
+// until the head commit of the given repository branch was left unchanged for its quiet period,
+// so that only the latest state of a burst of commits is deployed.
+func (t *Trigger) debounceCandidates(key gitRepoKey, headCommit git.Commit, cs []candidate, now time.Time) []candidate {
+	period := t.quietPeriodOf(key.repoID)
+	if period <= 0 {
+		return cs
+	}
+	remaining := t.commitDebouncer.Remaining(key, headCommit.Hash, period, now)
+	if remaining <= 0 {
+		return cs
+	}
+
+	reason := fmt.Sprintf("waiting %s more for the quiet period with no new commit since %s", remaining.Round(time.Second), headCommit.Hash)
+	kept := make([]candidate, 0, len(cs))
+	for _, c := range cs {
+		if c.HasCommand() {
+			kept = append(kept, c)
+			continue
+		}
+		t.auditDecision(c, key, headCommit.Hash, "", reason)
+	}
+	if deferred := len(cs) - len(kept); deferred > 0 {
+		t.logger.Info(fmt.Sprintf("deferred %d candidates in repo %s until the quiet period ends", deferred, key.repoID),
+			zap.String("branch", key.branch),
+			zap.String("commit", headCommit.Hash),
+			zap.Duration("remaining", remaining),
+		)
+	}
+	return kept
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestCommitDebouncerRemaining(t *testing.T) {
+	t.Parallel()
+
+	var (
+		d      = newCommitDebouncer()
+		key    = gitRepoKey{repoID: "repo-1", branch: "main"}
+		now    = time.Now()
+		period = 10 * time.Minute
+	)
+
+	assert.Equal(t, period, d.Remaining(key, "commit-1", period, now))
+	assert.Equal(t, 4*time.Minute, d.Remaining(key, "commit-1", period, now.Add(6*time.Minute)))
+
+	// A new commit starts the quiet period again.
+	assert.Equal(t, period, d.Remaining(key, "commit-2", period, now.Add(8*time.Minute)))
+	assert.Equal(t, time.Duration(0), d.Remaining(key, "commit-2", period, now.Add(18*time.Minute)))
+
+	// The other branches are tracked separately.
+	assert.Equal(t, period, d.Remaining(gitRepoKey{repoID: "repo-1", branch: "dev"}, "commit-2", period, now.Add(18*time.Minute)))
+}
+
+func TestCheckCandidatesWithQuietPeriod(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id string) *model.Application {
+		return newTestApplication(t, repoPath, id, testAppConfig)
+	}
+	var (
+		apps = []*model.Application{newApp("app-1"), newApp("app-2")}
+		cmd  = model.ReportableCommand{
+			Command: &model.Command{Id: "cmd-1"},
+			Report: func(_ context.Context, _ model.CommandStatus, _ map[string]string, _ []byte) error {
+				return nil
+			},
+		}
+		ac   = &recordingAPIClient{}
+		repo = &fakeRepo{
+			path:         repoPath,
+			head:         git.Commit{Hash: "commit-1"},
+			changedFiles: []string{"app-1/deployment.yaml"},
+		}
+		gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+		cfg = &config.PipedSpec{
+			ProjectID:              "project-1",
+			PipedID:                "piped-1",
+			Repositories:           []config.PipedRepository{{RepoID: "repo-1", Branch: "main", QuietPeriod: config.Duration(time.Hour)}},
+			MaxApplicationsPerRepo: 1000,
+		}
+		key = gitRepoKey{repoID: "repo-1", branch: "main"}
+		ctx = context.Background()
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: apps}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// Only the command bypasses the quiet period.
+	require.NoError(t, tr.checkCandidates(ctx, []candidate{
+		{application: apps[0], kind: model.TriggerKind_ON_COMMIT},
+		{application: apps[1], kind: model.TriggerKind_ON_COMMAND, command: cmd},
+	}))
+	require.Len(t, ac.Created(), 1)
+	assert.Equal(t, "app-2", ac.Created()[0].ApplicationId)
+
+	// The new commit is deployed once no commit was pushed for the quiet period.
+	tr.commitDebouncer.heads[key] = debouncedHead{hash: "commit-1", changedAt: time.Now().Add(-time.Hour)}
+	require.NoError(t, tr.checkCandidates(ctx, []candidate{
+		{application: apps[0], kind: model.TriggerKind_ON_COMMIT},
+	}))
+	require.Len(t, ac.Created(), 2)
+	assert.Equal(t, "app-1", ac.Created()[1].ApplicationId)
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Decision represents the most recent decision made for an application
+// on whether to trigger a new deployment for it or not.
+type Decision struct {
+	ApplicationID   string `json:"applicationId"`
+	ApplicationName string `json:"applicationName"`
+	// The kind of the candidate evaluated most recently.
+	Kind   string `json:"kind"`
+	Commit string `json:"commit"`
+	// Whether the determiners decided to trigger the candidate.
+	// The candidate may still not be triggered, e.g. while cooling down.
+	ShouldTrigger bool      `json:"shouldTrigger"`
+	Triggered     bool      `json:"triggered"`
+	DeploymentID  string    `json:"deploymentId,omitempty"`
+	Reason        string    `json:"reason"`
+	DecidedAt     time.Time `json:"decidedAt"`
+}
+
+// LastDecisionGetter returns the most recent decision made for each application
+// to let the users find out why their application was triggered or not.
+type LastDecisionGetter interface {
+	Get(applicationID string) (Decision, bool)
+	List() []Decision
+}
+
+// decisionStore keeps the most recent decision of each application.
+type decisionStore struct {
+	mu        sync.RWMutex
+	decisions map[string]Decision
+}
+
+func newDecisionStore() *decisionStore {
+	return &decisionStore{
+		decisions: make(map[string]Decision),
+	}
+}
+
+// Set replaces the decision of the given application.
+func (s *decisionStore) Set(d Decision) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.decisions[d.ApplicationID] = d
+}
+
+// Get returns the most recent decision of the given application.
+func (s *decisionStore) Get(applicationID string) (Decision, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.decisions[applicationID]
+	return d, ok
+}
+
+// List returns the most recent decisions of all applications ordered by their application ID.
+func (s *decisionStore) List() []Decision {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := make([]Decision, 0, len(s.decisions))
+	for _, d := range s.decisions {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ApplicationID < list[j].ApplicationID
+	})
+	return list
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecisionStore(t *testing.T) {
+	t.Parallel()
+
+	s := newDecisionStore()
+	assert.Empty(t, s.List())
+	_, ok := s.Get("app-1")
+	assert.False(t, ok)
+
+	s.Set(Decision{ApplicationID: "app-2", Reason: "the application is pinned"})
+	s.Set(Decision{ApplicationID: "app-1", Reason: "no new commit since the last triggered commit commit-1"})
+	s.Set(Decision{ApplicationID: "app-1", ShouldTrigger: true, Reason: "the application is cooling down from its last deployment"})
+
+	d, ok := s.Get("app-1")
+	require.True(t, ok)
+	assert.True(t, d.ShouldTrigger)
+	assert.Equal(t, "the application is cooling down from its last deployment", d.Reason)
+
+	list := s.List()
+	require.Len(t, list, 2)
+	assert.Equal(t, "app-1", list[0].ApplicationID)
+	assert.Equal(t, "app-2", list[1].ApplicationID)
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type dependencyState int
+
+const (
+	// All dependencies have successfully deployed the commit.
+	dependenciesSucceeded dependencyState = iota
+	// Some dependencies have not deployed the commit yet or are still deploying it.
+	dependenciesPending
+	// Some dependencies failed to deploy the commit.
+	dependenciesFailed
+)
+
+// dependencyGraph keeps the applications each application depends on
+// as specified in their latest loaded application configuration.
+// Since the configurations are loaded by the concurrent workers of the repositories,
+// the graph is shared across them and kept over the checks.
+type dependencyGraph struct {
+	mu   sync.RWMutex
+	deps map[string][]string
+}
+
+func newDependencyGraph() *dependencyGraph {
+	return &dependencyGraph{
+		deps: make(map[string][]string),
+	}
+}
+
+// Set updates the applications the given application depends on.
+func (g *dependencyGraph) Set(appID string, deps []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(deps) == 0 {
+		delete(g.deps, appID)
+		return
+	}
+	g.deps[appID] = deps
+}
+
+// FindCycle returns the path of a dependency cycle going through the given application,
+// e.g. [a, b, a]. Nil is returned if no cycle was found.
+func (g *dependencyGraph) FindCycle(appID string) []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var (
+		path    = []string{appID}
+		visited = map[string]struct{}{appID: {}}
+		visit   func(id string) bool
+	)
+	visit = func(id string) bool {
+		for _, dep := range g.deps[id] {
+			if dep == appID {
+				path = append(path, dep)
+				return true
+			}
+			if _, ok := visited[dep]; ok {
+				continue
+			}
+			visited[dep] = struct{}{}
+			path = append(path, dep)
+			if visit(dep) {
+				return true
+			}
+			path = path[:len(path)-1]
+		}
+		return false
+	}
+	if visit(appID) {
+		return path
+	}
+	return nil
+}
+
+// checkAppDependencies checks whether the given application can be triggered for the given commit
+// based on the applications it depends on. The dependencies are considered as failed
+// when they could not be resolved or they form a cycle, since waiting for them never ends.
+func (t *Trigger) checkAppDependencies(ctx context.Context, app *model.Application, names []string, commit string) (dependencyState, string, error) {
+	deps, err := t.resolveDependencies(names)
+	if err != nil {
+		t.dependencies.Set(app.Id, nil)
+		return dependenciesFailed, err.Error(), nil
+	}
+
+	ids := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		ids = append(ids, dep.Id)
+	}
+	t.dependencies.Set(app.Id, ids)
+	if len(deps) == 0 {
+		return dependenciesSucceeded, "", nil
+	}
+
+	if cycle := t.dependencies.FindCycle(app.Id); cycle != nil {
+		for i, id := range cycle {
+			if a, ok := t.applicationLister.Get(id); ok {
+				cycle[i] = a.Name
+			}
+		}
+		return dependenciesFailed, fmt.Sprintf("dependency cycle was detected: %s", strings.Join(cycle, " -> ")), nil
+	}
+
+	return t.checkDependencies(ctx, deps, commit)
+}
+
+// resolveDependencies returns the applications having the given names.
+// An error is returned when no application or more than one applications were found for a name.
+func (t *Trigger) resolveDependencies(names []string) ([]*model.Application, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	byName := make(map[string][]*model.Application)
+	for _, app := range t.applicationLister.List() {
+		byName[app.Name] = append(byName[app.Name], app)
+	}
+
+	deps := make([]*model.Application, 0, len(names))
+	for _, name := range names {
+		switch apps := byName[name]; len(apps) {
+		case 0:
+			return nil, fmt.Errorf("dependency %s was not found", name)
+		case 1:
+			deps = append(deps, apps[0])
+		default:
+			return nil, fmt.Errorf("dependency %s is ambiguous since %d applications have that name", name, len(apps))
+		}
+	}
+	return deps, nil
+}
+
+// checkDependencies checks whether all the given dependencies have successfully deployed the given commit.
+// The returned reason describes the first dependency which has not succeeded.
+func (t *Trigger) checkDependencies(ctx context.Context, deps []*model.Application, commit string) (dependencyState, string, error) {
+	for _, dep := range deps {
+		s, err := t.dependencyState(ctx, dep, commit)
+		if err != nil {
+			return dependenciesPending, "", fmt.Errorf("failed to check the deployment of dependency %s: %w", dep.Name, err)
+		}
+		switch s {
+		case dependenciesPending:
+			return s, fmt.Sprintf("waiting for dependency %s to successfully deploy commit %s", dep.Name, commit), nil
+		case dependenciesFailed:
+			return s, fmt.Sprintf("dependency %s failed to deploy commit %s", dep.Name, commit), nil
+		}
+	}
+	return dependenciesSucceeded, "", nil
+}
+
+func (t *Trigger) dependencyState(ctx context.Context, dep *model.Application, commit string) (dependencyState, error) {
+	succeeded, err := t.getMostRecentDeployment(ctx, dep.Id, model.DeploymentStatus_DEPLOYMENT_SUCCESS)
+	if err != nil {
+		return dependenciesPending, err
+	}
+	if succeeded != nil && succeeded.Trigger.Commit.Hash == commit {
+		return dependenciesSucceeded, nil
+	}
+
+	triggered, err := t.getMostRecentDeployment(ctx, dep.Id, model.DeploymentStatus_DEPLOYMENT_PENDING)
+	if err != nil {
+		return dependenciesPending, err
+	}
+	// The dependency has not been triggered for the commit yet.
+	if triggered == nil || triggered.Trigger.Commit.Hash != commit {
+		return dependenciesPending, nil
+	}
+
+	resp, err := t.apiClient.GetDeployment(ctx, &pipedservice.GetDeploymentRequest{
+		Id: triggered.DeploymentId,
+	})
+	if err != nil {
+		return dependenciesPending, err
+	}
+	switch s := resp.Deployment.Status; {
+	case s == model.DeploymentStatus_DEPLOYMENT_SUCCESS:
+		return dependenciesSucceeded, nil
+	case s.IsCompleted():
+		return dependenciesFailed, nil
+	default:
+		return dependenciesPending, nil
+	}
+}
+
+// getMostRecentDeployment returns the most recent deployment of the given application
+// for the given status. Nil is returned if the application has no such deployment.
+func (t *Trigger) getMostRecentDeployment(ctx context.Context, appID string, s model.DeploymentStatus) (*model.ApplicationDeploymentReference, error) {
+	resp, err := t.apiClient.GetApplicationMostRecentDeployment(ctx, &pipedservice.GetApplicationMostRecentDeploymentRequest{
+		ApplicationId: appID,
+		Status:        s,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Deployment, nil
+}
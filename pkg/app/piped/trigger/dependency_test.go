@@ -0,0 +1,225 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDependencyAPIClient struct {
+	apiClient
+	// The most recent deployments keyed by application ID and status.
+	mostRecent  map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference
+	deployments map[string]*model.Deployment
+}
+
+func (c *fakeDependencyAPIClient) GetApplicationMostRecentDeployment(_ context.Context, req *pipedservice.GetApplicationMostRecentDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.GetApplicationMostRecentDeploymentResponse, error) {
+	d, ok := c.mostRecent[req.ApplicationId][req.Status]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "deployment is not found")
+	}
+	return &pipedservice.GetApplicationMostRecentDeploymentResponse{Deployment: d}, nil
+}
+
+func (c *fakeDependencyAPIClient) GetDeployment(_ context.Context, req *pipedservice.GetDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.GetDeploymentResponse, error) {
+	d, ok := c.deployments[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "deployment is not found")
+	}
+	return &pipedservice.GetDeploymentResponse{Deployment: d}, nil
+}
+
+func newDeploymentReference(id, commit string) *model.ApplicationDeploymentReference {
+	return &model.ApplicationDeploymentReference{
+		DeploymentId: id,
+		Trigger: &model.DeploymentTrigger{
+			Commit: &model.Commit{Hash: commit},
+		},
+	}
+}
+
+func TestDependencyGraphFindCycle(t *testing.T) {
+	t.Parallel()
+
+	g := newDependencyGraph()
+	g.Set("a", []string{"b"})
+	g.Set("b", []string{"c", "d"})
+	g.Set("d", []string{"e"})
+
+	assert.Nil(t, g.FindCycle("a"))
+	assert.Nil(t, g.FindCycle("x"))
+
+	g.Set("e", []string{"b"})
+	assert.Nil(t, g.FindCycle("a"))
+	assert.Equal(t, []string{"b", "d", "e", "b"}, g.FindCycle("b"))
+	assert.Equal(t, []string{"e", "b", "d", "e"}, g.FindCycle("e"))
+
+	// The cycle is gone once a dependency was removed.
+	g.Set("e", nil)
+	assert.Nil(t, g.FindCycle("b"))
+}
+
+func TestCheckAppDependencies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		appA    = &model.Application{Id: "app-a", Name: "a"}
+		appB    = &model.Application{Id: "app-b", Name: "b"}
+		appC    = &model.Application{Id: "app-c", Name: "c"}
+		apps    = []*model.Application{appA, appB, appC, {Id: "app-dup-1", Name: "dup"}, {Id: "app-dup-2", Name: "dup"}}
+		success = model.DeploymentStatus_DEPLOYMENT_SUCCESS
+		pending = model.DeploymentStatus_DEPLOYMENT_PENDING
+	)
+
+	testcases := []struct {
+		name          string
+		dependsOn     []string
+		mostRecent    map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference
+		deployments   map[string]*model.Deployment
+		expected      dependencyState
+		expectedError bool
+	}{
+		{
+			name:     "no dependency",
+			expected: dependenciesSucceeded,
+		},
+		{
+			name:      "dependency was not found",
+			dependsOn: []string{"unknown"},
+			expected:  dependenciesFailed,
+		},
+		{
+			name:      "dependency is ambiguous",
+			dependsOn: []string{"dup"},
+			expected:  dependenciesFailed,
+		},
+		{
+			name:      "dependency has never been deployed",
+			dependsOn: []string{"a"},
+			expected:  dependenciesPending,
+		},
+		{
+			name:      "dependency has successfully deployed the commit",
+			dependsOn: []string{"a"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {success: newDeploymentReference("deployment-1", "commit")},
+			},
+			expected: dependenciesSucceeded,
+		},
+		{
+			name:      "dependency has deployed an older commit",
+			dependsOn: []string{"a"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {
+					success: newDeploymentReference("deployment-1", "old-commit"),
+					pending: newDeploymentReference("deployment-1", "old-commit"),
+				},
+			},
+			expected: dependenciesPending,
+		},
+		{
+			name:      "dependency is deploying the commit",
+			dependsOn: []string{"a"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {pending: newDeploymentReference("deployment-1", "commit")},
+			},
+			deployments: map[string]*model.Deployment{
+				"deployment-1": {Id: "deployment-1", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING},
+			},
+			expected: dependenciesPending,
+		},
+		{
+			name:      "dependency failed to deploy the commit",
+			dependsOn: []string{"a"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {pending: newDeploymentReference("deployment-1", "commit")},
+			},
+			deployments: map[string]*model.Deployment{
+				"deployment-1": {Id: "deployment-1", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+			},
+			expected: dependenciesFailed,
+		},
+		{
+			name:      "one of dependencies has not deployed the commit",
+			dependsOn: []string{"a", "b"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {success: newDeploymentReference("deployment-1", "commit")},
+			},
+			expected: dependenciesPending,
+		},
+		{
+			name:      "failed to get the deployment of dependency",
+			dependsOn: []string{"a"},
+			mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+				appA.Id: {pending: newDeploymentReference("deployment-1", "commit")},
+			},
+			expected:      dependenciesPending,
+			expectedError: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &Trigger{
+				apiClient: &fakeDependencyAPIClient{
+					mostRecent:  tc.mostRecent,
+					deployments: tc.deployments,
+				},
+				applicationLister: &fakeApplicationLister{apps: apps},
+				dependencies:      newDependencyGraph(),
+			}
+			state, reason, err := tr.checkAppDependencies(context.Background(), appC, tc.dependsOn, "commit")
+			assert.Equal(t, tc.expectedError, err != nil, err)
+			assert.Equal(t, tc.expected, state)
+			assert.Equal(t, state == dependenciesSucceeded || err != nil, reason == "")
+		})
+	}
+}
+
+func TestCheckAppDependenciesCycle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		appA = &model.Application{Id: "app-a", Name: "a"}
+		appB = &model.Application{Id: "app-b", Name: "b"}
+	)
+	tr := &Trigger{
+		apiClient:         &fakeDependencyAPIClient{},
+		applicationLister: &fakeApplicationLister{apps: []*model.Application{appA, appB}},
+		dependencies:      newDependencyGraph(),
+	}
+
+	// The cycle cannot be detected until the configuration of both applications was loaded.
+	state, _, err := tr.checkAppDependencies(context.Background(), appA, []string{"b"}, "commit")
+	require.NoError(t, err)
+	assert.Equal(t, dependenciesPending, state)
+
+	state, reason, err := tr.checkAppDependencies(context.Background(), appB, []string{"a"}, "commit")
+	require.NoError(t, err)
+	assert.Equal(t, dependenciesFailed, state)
+	assert.Equal(t, "dependency cycle was detected: b -> a -> b", reason)
+}
@@ -17,41 +17,181 @@ package trigger
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/backoff"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// The maximum length in bytes of the description saved to a deployment.
+const maxDeploymentDescriptionLength = 2048
+
 func (t *Trigger) triggerDeployment(
 	ctx context.Context,
 	deployment *model.Deployment,
-) error {
-	if _, err := t.apiClient.CreateDeployment(ctx, &pipedservice.CreateDeploymentRequest{
-		Deployment: deployment,
-	}); err != nil {
+	kind model.TriggerKind,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "triggerDeployment", trace.WithAttributes(
+		attribute.String("app.id", deployment.ApplicationId),
+		attribute.String("deployment.id", deployment.Id),
+		attribute.String("trigger.kind", kind.String()),
+	))
+	defer func() { endSpan(span, err) }()
+
+	// The time elapsed since the triggering commit was created is saved
+	// to track how long it takes the new commits to be deployed, e.g. by the polling interval.
+	latency, ok := commitToDeploymentLatency(deployment, time.Now())
+	if ok {
+		if deployment.Metadata == nil {
+			deployment.Metadata = make(map[string]string)
+		}
+		deployment.Metadata[model.MetadataKeyTriggerLatency] = latency.String()
+	}
+
+	if t.config.DryRun {
+		t.logDryRunDeployment(deployment)
+		return nil
+	}
+
+	var (
+		req      = NewCreateDeploymentRequest(deployment)
+		retry    = t.newDeploymentCreationRetry()
+		attempts = 0
+	)
+	// The trace context is propagated to let the deployment be traced from its trigger decision.
+	rpcCtx := withTraceContext(ctx)
+	_, err = retry.Do(ctx, func() (interface{}, error) {
+		attempts++
+		_, err := t.apiClient.CreateDeployment(rpcCtx, req)
+		switch code := status.Code(err); {
+		case err == nil:
+			return nil, nil
+		case code == codes.AlreadyExists && attempts > 1:
+			// The deployment was registered by the previous attempt but its response was lost.
+			return nil, nil
+		case code == codes.Unavailable || code == codes.DeadlineExceeded:
+			return nil, backoff.NewError(err, true)
+		default:
+			return nil, backoff.NewError(err, false)
+		}
+	})
+	if err != nil {
+		triggermetrics.DeploymentCreationFailed(deployment.ApplicationId, triggermetrics.FailureReasonAPI)
 		return fmt.Errorf("cound not register a new deployment to control-plane: %w", err)
 	}
+	triggermetrics.DeploymentCreationSucceeded(deployment.ApplicationId)
+	if ok {
+		triggermetrics.CreatedDeployment(kind.String(), latency)
+	}
 	return nil
 }
 
-func buildDeployment(
-	app *model.Application,
-	branch string,
-	commit git.Commit,
-	commander string,
-	syncStrategy model.SyncStrategy,
-	strategySummary string,
-	now time.Time,
-	noti *config.DeploymentNotification,
-	deploymentChainID string,
-	deploymentChainBlockIndex uint32,
-) (*model.Deployment, error) {
+// failureReasonOf returns the category of the given error returned while checking a candidate.
+// The errors returned by the control-plane have their gRPC status
+// while the others are caused by reading the Git repository.
+func failureReasonOf(err error) triggermetrics.FailureReason {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return triggermetrics.FailureReasonAPI
+	}
+	return triggermetrics.FailureReasonGit
+}
+
+// commitToDeploymentLatency returns the time elapsed from the creation of the triggering commit
+// of the given deployment to the given time. False is returned when the commit time is unknown.
+func commitToDeploymentLatency(d *model.Deployment, now time.Time) (time.Duration, bool) {
+	createdAt := d.GetTrigger().GetCommit().GetCreatedAt()
+	if createdAt <= 0 {
+		return 0, false
+	}
+	latency := now.Sub(time.Unix(createdAt, 0))
+	if latency < 0 {
+		// The clocks of the committer and piped may be skewed.
+		latency = 0
+	}
+	return latency.Truncate(time.Second), true
+}
+
+func (t *Trigger) newDeploymentCreationRetry() backoff.Retry {
+	var (
+		cfg         = t.config.DeploymentCreationRetry
+		maxAttempts = cfg.MaxAttempts
+	)
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	bo := backoff.NewExponential(cfg.BaseInterval.Duration(), cfg.MaxInterval.Duration())
+	return backoff.NewRetry(maxAttempts, bo)
+}
+
+// logDryRunDeployment logs the deployment would be created if the dry-run mode was disabled.
+func (t *Trigger) logDryRunDeployment(d *model.Deployment) {
+	t.logger.Info("skipped creating a new deployment since piped is running in dry-run mode",
+		zap.String("app", d.ApplicationName),
+		zap.String("app-id", d.ApplicationId),
+		zap.String("commit", d.Trigger.Commit.Hash),
+		zap.String("strategy", d.Trigger.SyncStrategy.String()),
+		zap.String("strategy-summary", d.Trigger.StrategySummary),
+		zap.Any("deployment", d),
+	)
+}
+
+// DeploymentInput is the input to build a new deployment of an application.
+type DeploymentInput struct {
+	// The ID of the deployment. Empty means a new one is generated.
+	ID          string
+	Application *model.Application
+	// The application configuration loaded at the commit.
+	// Nil means the deployment has neither the notification nor the trigger condition.
+	ApplicationConfig *config.GenericApplicationSpec
+	// The application config file name loaded instead of the registered one.
+	// Empty means the registered one was loaded.
+	ConfigFilename  string
+	Branch          string
+	Commit          git.Commit
+	Commander       string
+	SyncStrategy    model.SyncStrategy
+	StrategySummary string
+	// The reason why the deployment is triggered, saved to the deployment metadata.
+	TriggerReason string
+	// The source outside of Git which requested the deployment, saved to the deployment metadata.
+	TriggerSource string
+	// The note about the deployment provided by the commander.
+	// Empty means the body of the commit message is used as the description.
+	Description string
+	// Whether to save the commit reverted by the commit to the deployment metadata.
+	DetectRevert              bool
+	DeploymentChainID         string
+	DeploymentChainBlockIndex uint32
+	CreatedAt                 time.Time
+}
+
+// BuildDeployment builds a new deployment of the application at the commit from the given input.
+// It only builds the model without registering it at the control-plane,
+// so the same deployment as the trigger can be built outside of it.
+func BuildDeployment(in DeploymentInput) (*model.Deployment, error) {
+	var (
+		app    = in.Application
+		commit = in.Commit
+	)
 
 	var commitURL string
 	if r := app.GitPath.Repo; r != nil {
@@ -62,17 +202,93 @@ func buildDeployment(
 		commitURL = url
 	}
 
-	metadata := make(map[string]string)
-	if noti != nil {
-		value, err := json.Marshal(noti)
+	var (
+		labels   = app.Labels
+		metadata = make(map[string]string)
+	)
+	if cfg := in.ApplicationConfig; cfg != nil {
+		data := newDeploymentTemplateData(app, commit, in.Branch)
+		if len(cfg.Trigger.DeploymentLabels) > 0 {
+			rendered, err := renderDeploymentTemplates("trigger.deploymentLabels", cfg.Trigger.DeploymentLabels, data)
+			if err != nil {
+				return nil, err
+			}
+			// The labels of the application must not be modified.
+			labels = make(map[string]string, len(app.Labels)+len(rendered))
+			for k, v := range app.Labels {
+				labels[k] = v
+			}
+			for k, v := range rendered {
+				labels[k] = v
+			}
+		}
+		// The annotations are saved first to let the metadata saved by piped override them.
+		annotations, err := renderDeploymentTemplates("trigger.deploymentAnnotations", cfg.Trigger.DeploymentAnnotations, data)
 		if err != nil {
-			return nil, fmt.Errorf("failed to save notification config to deployment metadata: %w", err)
+			return nil, err
+		}
+		for k, v := range annotations {
+			metadata[k] = v
+		}
+		if noti := cfg.DeploymentNotification; noti != nil {
+			value, err := json.Marshal(noti)
+			if err != nil {
+				return nil, fmt.Errorf("failed to save notification config to deployment metadata: %w", err)
+			}
+			metadata[model.MetadataKeyDeploymentNotification] = string(value)
 		}
-		metadata[model.MetadataKeyDeploymentNotification] = string(value)
+		if cond := cfg.Trigger.Condition; cond != nil {
+			metadata[model.MetadataKeyTriggerCondition] = cond.String()
+		}
+	}
+	// The author of the triggering commit and the merged pull request are saved
+	// to let them be shown to the users. Nothing is saved for the unknown ones.
+	if commit.Author != "" {
+		metadata[model.MetadataKeyCommitAuthor] = commit.Author
+	}
+	if commit.AuthorEmail != "" {
+		metadata[model.MetadataKeyCommitAuthorEmail] = commit.AuthorEmail
+	}
+	if n, ok := commit.GetPullRequestNumber(); ok {
+		metadata[model.MetadataKeyPullRequestNumber] = strconv.Itoa(n)
+	}
+	// The hash of the reverted commit is saved, or its subject when the hash is unknown.
+	if in.DetectRevert {
+		if r, ok := commit.GetRevert(); ok {
+			if r.Hash != "" {
+				metadata[model.MetadataKeyRevertedCommit] = r.Hash
+			} else {
+				metadata[model.MetadataKeyRevertedCommit] = r.Subject
+			}
+		}
+	}
+	if in.TriggerReason != "" {
+		metadata[model.MetadataKeyTriggerReason] = in.TriggerReason
 	}
+	if in.TriggerSource != "" {
+		metadata[model.MetadataKeyTriggerSource] = in.TriggerSource
+	}
+
+	// The deployment must be planned and executed with the same config file loaded by the trigger.
+	gitPath := app.GitPath
+	if in.ConfigFilename != "" && in.ConfigFilename != gitPath.GetApplicationConfigFilename() {
+		gitPath = proto.Clone(gitPath).(*model.ApplicationGitPath)
+		gitPath.ConfigFilename = in.ConfigFilename
+	}
+
+	description := in.Description
+	if description == "" {
+		description = commit.Body
+	}
+
+	id := in.ID
+	if id == "" {
+		id = uuid.New().String()
+	}
+	now := in.CreatedAt.Unix()
 
 	deployment := &model.Deployment{
-		Id:              uuid.New().String(),
+		Id:              id,
 		ApplicationId:   app.Id,
 		ApplicationName: app.Name,
 		PipedId:         app.PipedId,
@@ -83,30 +299,53 @@ func buildDeployment(
 				Hash:      commit.Hash,
 				Message:   commit.Message,
 				Author:    commit.Author,
-				Branch:    branch,
+				Branch:    in.Branch,
 				Url:       commitURL,
 				CreatedAt: int64(commit.CreatedAt),
 			},
-			Commander:       commander,
-			Timestamp:       now.Unix(),
-			SyncStrategy:    syncStrategy,
-			StrategySummary: strategySummary,
+			Commander:       in.Commander,
+			Timestamp:       now,
+			SyncStrategy:    in.SyncStrategy,
+			StrategySummary: in.StrategySummary,
 		},
-		GitPath:                   app.GitPath,
+		Description:               truncateDeploymentDescription(description),
+		GitPath:                   gitPath,
 		CloudProvider:             app.CloudProvider,
-		Labels:                    app.Labels,
+		Labels:                    labels,
 		Status:                    model.DeploymentStatus_DEPLOYMENT_PENDING,
 		StatusReason:              "The deployment is waiting to be planned",
 		Metadata:                  metadata,
-		CreatedAt:                 now.Unix(),
-		UpdatedAt:                 now.Unix(),
-		DeploymentChainId:         deploymentChainID,
-		DeploymentChainBlockIndex: deploymentChainBlockIndex,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		DeploymentChainId:         in.DeploymentChainID,
+		DeploymentChainBlockIndex: in.DeploymentChainBlockIndex,
 	}
 
 	return deployment, nil
 }
 
+// truncateDeploymentDescription strips the trailing whitespace of the given description
+// and truncates it at a rune boundary not to exceed maxDeploymentDescriptionLength.
+func truncateDeploymentDescription(desc string) string {
+	desc = strings.TrimRightFunc(desc, unicode.IsSpace)
+	if len(desc) <= maxDeploymentDescriptionLength {
+		return desc
+	}
+	const ellipsis = "..."
+	n := maxDeploymentDescriptionLength - len(ellipsis)
+	for n > 0 && !utf8.RuneStart(desc[n]) {
+		n--
+	}
+	return desc[:n] + ellipsis
+}
+
+// NewCreateDeploymentRequest returns the request to register the given deployment at the control-plane.
+func NewCreateDeploymentRequest(d *model.Deployment) *pipedservice.CreateDeploymentRequest {
+	return &pipedservice.CreateDeploymentRequest{
+		Deployment: d,
+	}
+}
+
 func reportMostRecentlyTriggeredDeployment(ctx context.Context, client apiClient, d *model.Deployment) error {
 	var (
 		err error
@@ -28,6 +28,11 @@ func (t *Trigger) triggerDeploymentChain(
 	dc *config.DeploymentChain,
 	firstDeployment *model.Deployment,
 ) error {
+	if t.config.DryRun {
+		t.logDryRunDeployment(firstDeployment)
+		return nil
+	}
+
 	matchers := make([]*pipedservice.CreateDeploymentChainRequest_ApplicationMatcher, 0, len(dc.ApplicationMatchers))
 	for _, m := range dc.ApplicationMatchers {
 		matchers = append(matchers, &pipedservice.CreateDeploymentChainRequest_ApplicationMatcher{
@@ -0,0 +1,127 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The maximum number of characters of a rendered deployment label or annotation.
+	maxDeploymentTemplateOutputLength = 256
+	shortCommitHashLength             = 7
+)
+
+// deploymentTemplateData is the data available in the templates of
+// trigger.deploymentLabels and trigger.deploymentAnnotations.
+type deploymentTemplateData struct {
+	App    deploymentTemplateApp
+	Commit deploymentTemplateCommit
+	Env    deploymentTemplateEnv
+}
+
+type deploymentTemplateApp struct {
+	ID     string
+	Name   string
+	Kind   string
+	Labels map[string]string
+}
+
+type deploymentTemplateCommit struct {
+	Hash      string
+	ShortHash string
+	Author    string
+	Message   string
+	Branch    string
+}
+
+type deploymentTemplateEnv struct {
+	// Only the ID is available since piped does not know the names of the environments.
+	ID string
+}
+
+func newDeploymentTemplateData(app *model.Application, commit git.Commit, branch string) deploymentTemplateData {
+	shortHash := commit.Hash
+	if len(shortHash) > shortCommitHashLength {
+		shortHash = shortHash[:shortCommitHashLength]
+	}
+	return deploymentTemplateData{
+		App: deploymentTemplateApp{
+			ID:     app.Id,
+			Name:   app.Name,
+			Kind:   app.Kind.String(),
+			Labels: app.Labels,
+		},
+		Commit: deploymentTemplateCommit{
+			Hash:      commit.Hash,
+			ShortHash: shortHash,
+			Author:    commit.Author,
+			Message:   commit.Message,
+			Branch:    branch,
+		},
+		Env: deploymentTemplateEnv{
+			ID: app.EnvId,
+		},
+	}
+}
+
+// renderDeploymentTemplates renders the templated values of the deployment labels or annotations
+// at the given field with the given data. The rendered values are sanitized to be saved as is.
+func renderDeploymentTemplates(field string, templates map[string]string, data deploymentTemplateData) (map[string]string, error) {
+	keys := make([]string, 0, len(templates))
+	for k := range templates {
+		keys = append(keys, k)
+	}
+	// Sorted to report the same error for the same configuration.
+	sort.Strings(keys)
+
+	out := make(map[string]string, len(templates))
+	for _, k := range keys {
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(templates[k])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template of %s.%s: %w", field, k, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render %s.%s: %w", field, k, err)
+		}
+		out[k] = sanitizeDeploymentTemplateOutput(buf.String())
+	}
+	return out, nil
+}
+
+// sanitizeDeploymentTemplateOutput replaces the control characters such as the line breaks with spaces,
+// trims the surrounding spaces and truncates the given rendered value up to the maximum length.
+func sanitizeDeploymentTemplateOutput(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, s)
+	s = strings.TrimSpace(s)
+	if r := []rune(s); len(r) > maxDeploymentTemplateOutputLength {
+		s = strings.TrimSpace(string(r[:maxDeploymentTemplateOutputLength]))
+	}
+	return s
+}
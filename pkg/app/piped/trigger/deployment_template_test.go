@@ -0,0 +1,55 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeDeploymentTemplateOutput(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "as is",
+			value: "v1.0.0",
+			want:  "v1.0.0",
+		},
+		{
+			name:  "control characters are replaced",
+			value: " Update app\nby bot\t",
+			want:  "Update app by bot",
+		},
+		{
+			name:  "too long value is truncated",
+			value: strings.Repeat("a", maxDeploymentTemplateOutputLength+10),
+			want:  strings.Repeat("a", maxDeploymentTemplateOutputLength),
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, sanitizeDeploymentTemplateOutput(tc.value))
+		})
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIClient struct {
+	apiClient
+	createDeploymentErrs  []error
+	createDeploymentCalls int
+}
+
+func (c *fakeAPIClient) CreateDeployment(_ context.Context, _ *pipedservice.CreateDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.CreateDeploymentResponse, error) {
+	c.createDeploymentCalls++
+	if len(c.createDeploymentErrs) == 0 {
+		return &pipedservice.CreateDeploymentResponse{}, nil
+	}
+	err := c.createDeploymentErrs[0]
+	c.createDeploymentErrs = c.createDeploymentErrs[1:]
+	return &pipedservice.CreateDeploymentResponse{}, err
+}
+
+func TestTriggerDeploymentRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		unavailable      = status.Error(codes.Unavailable, "unavailable")
+		deadlineExceeded = status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		invalidArgument  = status.Error(codes.InvalidArgument, "invalid argument")
+		alreadyExists    = status.Error(codes.AlreadyExists, "already exists")
+	)
+
+	testcases := []struct {
+		name          string
+		errs          []error
+		expectedErr   bool
+		expectedCalls int
+	}{
+		{
+			name:          "succeeded at the first attempt",
+			expectedCalls: 1,
+		},
+		{
+			name:          "succeeded after transient errors",
+			errs:          []error{unavailable, deadlineExceeded},
+			expectedCalls: 3,
+		},
+		{
+			name:          "failed after all attempts",
+			errs:          []error{unavailable, unavailable, unavailable, unavailable},
+			expectedErr:   true,
+			expectedCalls: 3,
+		},
+		{
+			name:          "failed fast on invalid argument",
+			errs:          []error{invalidArgument},
+			expectedErr:   true,
+			expectedCalls: 1,
+		},
+		{
+			name:          "failed fast on already exists at the first attempt",
+			errs:          []error{alreadyExists},
+			expectedErr:   true,
+			expectedCalls: 1,
+		},
+		{
+			name:          "registered by the previous attempt",
+			errs:          []error{deadlineExceeded, alreadyExists},
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeAPIClient{createDeploymentErrs: tc.errs}
+			tr := &Trigger{
+				apiClient: client,
+				config: &config.PipedSpec{
+					DeploymentCreationRetry: config.PipedDeploymentCreationRetry{
+						MaxAttempts: 3,
+					},
+				},
+			}
+			err := tr.triggerDeployment(context.Background(), &model.Deployment{Id: "deployment-id"}, model.TriggerKind_ON_COMMIT)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedCalls, client.createDeploymentCalls)
+		})
+	}
+}
+
+func TestBuildDeploymentCommitMetadata(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{
+		Id:      "app-1",
+		GitPath: &model.ApplicationGitPath{},
+	}
+
+	testcases := []struct {
+		name     string
+		commit   git.Commit
+		expected map[string]string
+	}{
+		{
+			name: "commit merging pull request",
+			commit: git.Commit{
+				Hash:        "commit-1",
+				Author:      "foo",
+				AuthorEmail: "foo@example.com",
+				Message:     "Add new feature (#123)",
+			},
+			expected: map[string]string{
+				model.MetadataKeyCommitAuthor:      "foo",
+				model.MetadataKeyCommitAuthorEmail: "foo@example.com",
+				model.MetadataKeyPullRequestNumber: "123",
+			},
+		},
+		{
+			name: "commit without author",
+			commit: git.Commit{
+				Hash:    "commit-1",
+				Message: "Add new feature",
+			},
+			expected: map[string]string{},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := BuildDeployment(DeploymentInput{
+				Application:  app,
+				Branch:       "main",
+				Commit:       tc.commit,
+				SyncStrategy: model.SyncStrategy_AUTO,
+				CreatedAt:    time.Now(),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d.Metadata)
+		})
+	}
+}
+
+func TestBuildDeployment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app = &model.Application{
+			Id:        "app-1",
+			Name:      "app",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		appCfg = &config.GenericApplicationSpec{
+			Trigger: config.Trigger{Condition: &config.TriggerCondition{Kind: "ON_COMMIT"}},
+		}
+		now = time.Unix(1700000000, 0)
+	)
+
+	d, err := BuildDeployment(DeploymentInput{
+		ID:                "deployment-1",
+		Application:       app,
+		ApplicationConfig: appCfg,
+		ConfigFilename:    ".pipe.yaml",
+		Branch:            "main",
+		Commit:            git.Commit{Hash: "commit-1", Message: "Update app"},
+		Commander:         "user",
+		SyncStrategy:      model.SyncStrategy_QUICK_SYNC,
+		StrategySummary:   "Quick sync",
+		TriggerReason:     "received a SYNC command",
+		TriggerSource:     "gcr.io/org/app",
+		CreatedAt:         now,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "deployment-1", d.Id)
+	assert.Equal(t, "app-1", d.ApplicationId)
+	assert.Equal(t, "commit-1", d.Trigger.Commit.Hash)
+	assert.Equal(t, "main", d.Trigger.Commit.Branch)
+	assert.Equal(t, "https://github.com/org/repo-1/commit/commit-1", d.Trigger.Commit.Url)
+	assert.Equal(t, "user", d.Trigger.Commander)
+	assert.Equal(t, model.SyncStrategy_QUICK_SYNC, d.Trigger.SyncStrategy)
+	assert.Equal(t, now.Unix(), d.CreatedAt)
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_PENDING, d.Status)
+	assert.Equal(t, map[string]string{
+		model.MetadataKeyTriggerCondition: "ON_COMMIT",
+		model.MetadataKeyTriggerReason:    "received a SYNC command",
+		model.MetadataKeyTriggerSource:    "gcr.io/org/app",
+	}, d.Metadata)
+	// The loaded config file is used without changing the registered one.
+	assert.Equal(t, ".pipe.yaml", d.GitPath.ConfigFilename)
+	assert.Equal(t, "app.pipecd.yaml", app.GitPath.ConfigFilename)
+
+	// A new ID is generated unless specified.
+	d, err = BuildDeployment(DeploymentInput{Application: app, CreatedAt: now})
+	require.NoError(t, err)
+	assert.NotEmpty(t, d.Id)
+	assert.Same(t, app.GitPath, d.GitPath)
+}
+
+func TestBuildDeploymentWithTemplates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app = &model.Application{
+			Id:     "app-1",
+			Name:   "app",
+			Kind:   model.ApplicationKind_KUBERNETES,
+			EnvId:  "env-1",
+			Labels: map[string]string{"team": "payment"},
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path: "app",
+			},
+		}
+		commit = git.Commit{Hash: "0123456789abcdef", Message: "Update app"}
+		now    = time.Unix(1700000000, 0)
+	)
+
+	appCfg := &config.GenericApplicationSpec{
+		Trigger: config.Trigger{
+			DeploymentLabels: map[string]string{
+				"commit": "{{ .Commit.ShortHash }}",
+				"team":   "{{ .App.Labels.team }}-{{ .Env.ID }}",
+			},
+			DeploymentAnnotations: map[string]string{
+				"message":                      "{{ .Commit.Message }}\n",
+				model.MetadataKeyTriggerReason: "overridden",
+			},
+		},
+	}
+	d, err := BuildDeployment(DeploymentInput{
+		Application:       app,
+		ApplicationConfig: appCfg,
+		Branch:            "main",
+		Commit:            commit,
+		TriggerReason:     "new commit",
+		CreatedAt:         now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"commit": "0123456", "team": "payment-env-1"}, d.Labels)
+	// The labels of the application are kept as is.
+	assert.Equal(t, map[string]string{"team": "payment"}, app.Labels)
+	assert.Equal(t, "Update app", d.Metadata["message"])
+	// The metadata saved by piped cannot be overridden.
+	assert.Equal(t, "new commit", d.Metadata[model.MetadataKeyTriggerReason])
+
+	appCfg = &config.GenericApplicationSpec{
+		Trigger: config.Trigger{
+			DeploymentLabels: map[string]string{"owner": "{{ .App.Labels.owner }}"},
+		},
+	}
+	_, err = BuildDeployment(DeploymentInput{Application: app, ApplicationConfig: appCfg, Commit: commit, CreatedAt: now})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to render trigger.deploymentLabels.owner")
+}
+
+func TestBuildDeploymentRevert(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app = &model.Application{
+			Id:      "app-1",
+			GitPath: &model.ApplicationGitPath{},
+		}
+		revert = git.Commit{
+			Hash:    "commit-2",
+			Message: `Revert "Add new feature"`,
+			Body:    "This reverts commit 0123abc.",
+		}
+		now = time.Unix(1700000000, 0)
+	)
+
+	d, err := BuildDeployment(DeploymentInput{Application: app, Commit: revert, DetectRevert: true, CreatedAt: now})
+	require.NoError(t, err)
+	assert.Equal(t, "0123abc", d.Metadata[model.MetadataKeyRevertedCommit])
+
+	// The subject of the reverted commit is saved when its hash is unknown.
+	revert.Body = ""
+	d, err = BuildDeployment(DeploymentInput{Application: app, Commit: revert, DetectRevert: true, CreatedAt: now})
+	require.NoError(t, err)
+	assert.Equal(t, "Add new feature", d.Metadata[model.MetadataKeyRevertedCommit])
+
+	// Nothing is saved unless enabled.
+	d, err = BuildDeployment(DeploymentInput{Application: app, Commit: revert, CreatedAt: now})
+	require.NoError(t, err)
+	assert.NotContains(t, d.Metadata, model.MetadataKeyRevertedCommit)
+
+	d, err = BuildDeployment(DeploymentInput{Application: app, Commit: git.Commit{Hash: "commit-3", Message: "Add new feature"}, DetectRevert: true, CreatedAt: now})
+	require.NoError(t, err)
+	assert.NotContains(t, d.Metadata, model.MetadataKeyRevertedCommit)
+}
+
+func TestBuildDeploymentDescription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app = &model.Application{
+			Id:      "app-1",
+			GitPath: &model.ApplicationGitPath{},
+		}
+		commit = git.Commit{
+			Hash:    "commit-1",
+			Message: "Update image",
+			Body:    "Bump the image to v1.2.3.\n\nSee the release note for the details.",
+		}
+		now = time.Unix(1700000000, 0)
+	)
+
+	d, err := BuildDeployment(DeploymentInput{Application: app, Commit: commit, CreatedAt: now})
+	require.NoError(t, err)
+	assert.Equal(t, "Bump the image to v1.2.3.\n\nSee the release note for the details.", d.Description)
+
+	// The note of the commander is preferred to the commit body.
+	d, err = BuildDeployment(DeploymentInput{Application: app, Commit: commit, Description: "Hotfix for the incident  \n", CreatedAt: now})
+	require.NoError(t, err)
+	assert.Equal(t, "Hotfix for the incident", d.Description)
+
+	d, err = BuildDeployment(DeploymentInput{Application: app, Commit: git.Commit{Hash: "commit-2", Message: "Update image"}, CreatedAt: now})
+	require.NoError(t, err)
+	assert.Empty(t, d.Description)
+}
+
+func TestTruncateDeploymentDescription(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", truncateDeploymentDescription(" \n"))
+	assert.Equal(t, "  indented", truncateDeploymentDescription("  indented\t\n"))
+
+	long := strings.Repeat("a", maxDeploymentDescriptionLength)
+	assert.Equal(t, long, truncateDeploymentDescription(long))
+
+	got := truncateDeploymentDescription(long + "b")
+	assert.Len(t, got, maxDeploymentDescriptionLength)
+	assert.True(t, strings.HasSuffix(got, "..."))
+
+	// The multi-byte characters are not split.
+	got = truncateDeploymentDescription(strings.Repeat("あ", maxDeploymentDescriptionLength))
+	assert.True(t, utf8.ValidString(got))
+	assert.LessOrEqual(t, len(got), maxDeploymentDescriptionLength)
+}
+
+func TestFailureReasonOf(t *testing.T) {
+	t.Parallel()
+
+	apiErr := fmt.Errorf("failed to get the most recent deployment: %w", status.Error(codes.Unavailable, "unavailable"))
+	assert.Equal(t, triggermetrics.FailureReasonAPI, failureReasonOf(apiErr))
+	assert.Equal(t, triggermetrics.FailureReasonGit, failureReasonOf(errors.New("failed to list changed files")))
+}
+
+func TestCommitToDeploymentLatency(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now       = time.Unix(1700000000, 0)
+		newDeploy = func(createdAt int64) *model.Deployment {
+			return &model.Deployment{
+				Trigger: &model.DeploymentTrigger{
+					Commit: &model.Commit{Hash: "commit-1", CreatedAt: createdAt},
+				},
+			}
+		}
+	)
+
+	latency, ok := commitToDeploymentLatency(newDeploy(now.Unix()-90), now)
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, latency)
+
+	// The commit created in the future by the skewed clock.
+	latency, ok = commitToDeploymentLatency(newDeploy(now.Unix()+10), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), latency)
+
+	_, ok = commitToDeploymentLatency(newDeploy(0), now)
+	assert.False(t, ok)
+	_, ok = commitToDeploymentLatency(&model.Deployment{}, now)
+	assert.False(t, ok)
+}
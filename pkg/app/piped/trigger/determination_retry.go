@@ -0,0 +1,127 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/backoff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// determination is the result of determining whether a candidate should be triggered.
+type determination struct {
+	shouldTrigger bool
+	candidate     candidate
+}
+
+// determineWithRetry runs the given function to determine whether the given application should be triggered.
+// It is retried shortly within this check while it fails due to a transient error
+// instead of leaving the application to the next check, up to the configured number of attempts.
+func (t *Trigger) determineWithRetry(ctx context.Context, app *model.Application, determine func() (bool, candidate, error)) (shouldTrigger bool, c candidate, err error) {
+	ctx, span := tracer.Start(ctx, "determine", trace.WithAttributes(
+		attribute.String("app.id", app.Id),
+		attribute.String("app.name", app.Name),
+	))
+	defer func() {
+		span.SetAttributes(
+			attribute.String("trigger.kind", c.kind.String()),
+			attribute.Bool("trigger.should_trigger", shouldTrigger),
+		)
+		endSpan(span, err)
+	}()
+
+	var (
+		cfg         = t.config.DeterminationRetry
+		maxAttempts = cfg.MaxAttempts
+		attempts    = 0
+		last        candidate
+	)
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	retry := backoff.NewRetry(maxAttempts, backoff.NewExponential(cfg.BaseInterval.Duration(), cfg.MaxInterval.Duration()))
+
+	v, err := retry.Do(ctx, func() (interface{}, error) {
+		attempts++
+		ok, c, err := determine()
+		last = c
+		if err == nil {
+			return determination{shouldTrigger: ok, candidate: c}, nil
+		}
+		if ctx.Err() != nil || !isTransientError(err) {
+			return nil, backoff.NewError(err, false)
+		}
+		if attempts < maxAttempts {
+			t.logger.Info(fmt.Sprintf("retrying to determine whether application %s should be triggered after a transient error", app.Name),
+				zap.String("app-id", app.Id),
+				zap.Int("attempts", attempts),
+				zap.Error(err),
+			)
+		}
+		return nil, backoff.NewError(err, true)
+	})
+	if err != nil {
+		return false, last, err
+	}
+	d := v.(determination)
+	return d.shouldTrigger, d.candidate, nil
+}
+
+// isTransientError reports whether the given error returned while determining a candidate
+// is likely to be resolved by retrying shortly.
+// The configuration errors and the errors of the other kinds are never treated as transient.
+func isTransientError(err error) bool {
+	var cfgErr *ConfigError
+	if errors.As(err, &cfgErr) {
+		return false
+	}
+
+	// The errors returned by the control-plane.
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		switch se.GRPCStatus().Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// The timeouts of the git operations and the storage.
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var te interface{ Timeout() bool }
+	if errors.As(err, &te) && te.Timeout() {
+		return true
+	}
+	if errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EBUSY) || errors.Is(err, syscall.EINTR) {
+		return true
+	}
+
+	// The git commands fail while another process is holding the lock file of the repository.
+	return strings.Contains(err.Error(), ".lock': File exists")
+}
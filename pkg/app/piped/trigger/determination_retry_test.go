@@ -0,0 +1,143 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestIsTransientError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "control-plane unavailable",
+			err:      fmt.Errorf("failed to get deployment: %w", status.Error(codes.Unavailable, "unavailable")),
+			expected: true,
+		},
+		{
+			name:     "deployment not found",
+			err:      status.Error(codes.NotFound, "not found"),
+			expected: false,
+		},
+		{
+			name:     "git operation timed out",
+			err:      fmt.Errorf("failed to list changed files: %w", context.DeadlineExceeded),
+			expected: true,
+		},
+		{
+			name:     "storage busy",
+			err:      &os.PathError{Op: "open", Path: "repo/.git/HEAD", Err: syscall.EAGAIN},
+			expected: true,
+		},
+		{
+			name:     "git lock file held by another process",
+			err:      errors.New("err: exit status 128, out: fatal: Unable to create '/repo/.git/index.lock': File exists."),
+			expected: true,
+		},
+		{
+			name:     "unknown revision",
+			err:      errors.New("err: exit status 128, out: fatal: bad revision 'commit-1'"),
+			expected: false,
+		},
+		{
+			name:     "config error",
+			err:      &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.paths: %w", context.DeadlineExceeded)},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, isTransientError(tc.err))
+		})
+	}
+}
+
+func TestDetermineWithRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app        = &model.Application{Id: "app-1", Name: "app"}
+		c          = candidate{application: app, kind: model.TriggerKind_ON_COMMIT}
+		ctx        = context.Background()
+		newTrigger = func(maxAttempts int) *Trigger {
+			return &Trigger{
+				config: &config.PipedSpec{
+					DeterminationRetry: config.PipedDeterminationRetry{MaxAttempts: maxAttempts},
+				},
+				logger: zap.NewNop(),
+			}
+		}
+	)
+	// failing returns the given error at the first given number of calls.
+	failing := func(err error, failures int) (func() (bool, candidate, error), *int) {
+		calls := 0
+		return func() (bool, candidate, error) {
+			calls++
+			if calls <= failures {
+				return false, c, err
+			}
+			return true, c, nil
+		}, &calls
+	}
+	transient := status.Error(codes.Unavailable, "unavailable")
+
+	// Succeeded after retrying the transient errors.
+	determine, calls := failing(transient, 2)
+	ok, got, err := newTrigger(3).determineWithRetry(ctx, app, determine)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, c, got)
+	assert.Equal(t, 3, *calls)
+
+	// The retries are capped.
+	determine, calls = failing(transient, 5)
+	_, _, err = newTrigger(3).determineWithRetry(ctx, app, determine)
+	assert.Equal(t, transient, err)
+	assert.Equal(t, 3, *calls)
+
+	// The permanent errors are not retried.
+	cfgErr := &ConfigError{Err: errors.New("invalid trigger.onCommit.ignores")}
+	determine, calls = failing(cfgErr, 1)
+	_, _, err = newTrigger(3).determineWithRetry(ctx, app, determine)
+	assert.Equal(t, cfgErr, err)
+	assert.Equal(t, 1, *calls)
+
+	// No retry is made unless configured.
+	determine, calls = failing(transient, 1)
+	_, _, err = newTrigger(0).determineWithRetry(ctx, app, determine)
+	assert.Equal(t, transient, err)
+	assert.Equal(t, 1, *calls)
+}
@@ -17,10 +17,13 @@ package trigger
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -30,7 +33,10 @@ import (
 )
 
 type Determiner interface {
-	ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, error)
+	// ShouldTrigger decides whether a given application should be triggered or not.
+	// A human-readable reason is returned together to explain why it should or should not be triggered.
+	// A ConfigError is returned when it could not be decided due to the application configuration.
+	ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error)
 }
 
 type determiners struct {
@@ -38,6 +44,23 @@ type determiners struct {
 	onOutOfSync Determiner
 	onCommit    Determiner
 	onChain     Determiner
+	onExternal  Determiner
+	onSchedule  Determiner
+}
+
+// changesSummarizer is implemented by the determiners summarizing the changes
+// which made the applications be triggered.
+type changesSummarizer interface {
+	ChangesSummary(applicationID string) string
+}
+
+// commitRangeLister is implemented by the determiners deciding to deploy
+// the new commits one by one instead of only the head commit.
+type commitRangeLister interface {
+	// TargetCommits returns the commits to be deployed in order for the given application
+	// and whether some of the new commits were left to the subsequent checks.
+	// Empty is returned when only the head commit should be deployed.
+	TargetCommits(applicationID string) ([]git.Commit, bool)
 }
 
 func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
@@ -48,6 +71,10 @@ func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
 		return ds.onOutOfSync
 	case model.TriggerKind_ON_CHAIN:
 		return ds.onChain
+	case model.TriggerKind_ON_EXTERNAL:
+		return ds.onExternal
+	case model.TriggerKind_ON_SCHEDULE:
+		return ds.onSchedule
 	default:
 		return ds.onCommit
 	}
@@ -61,12 +88,12 @@ func NewOnCommandDeterminer() *OnCommandDeterminer {
 }
 
 // ShouldTrigger decides whether a given application should be triggered or not.
-func (d *OnCommandDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+func (d *OnCommandDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
 	if appCfg.Trigger.OnCommand.Disabled {
-		return false, nil
+		return false, "trigger.onCommand is disabled", nil
 	}
 
-	return true, nil
+	return true, "received a SYNC command", nil
 }
 
 type OnChainDeterminer struct {
@@ -76,27 +103,108 @@ func NewOnChainDeterminer() *OnChainDeterminer {
 	return &OnChainDeterminer{}
 }
 
-func (d *OnChainDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+func (d *OnChainDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
 	if *appCfg.Trigger.OnChain.Disabled {
-		return false, nil
+		return false, "trigger.onChain is disabled", nil
 	}
-	return true, nil
+	return true, "triggered as a node of a deployment chain", nil
+}
+
+// OnExternalDeterminer always triggers the applications requested by the external sources
+// since they want the head commit to be deployed even though it has no change.
+type OnExternalDeterminer struct {
+}
+
+func NewOnExternalDeterminer() *OnExternalDeterminer {
+	return &OnExternalDeterminer{}
+}
+
+func (d *OnExternalDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, _ *config.GenericApplicationSpec) (bool, string, error) {
+	return true, "received a request from an external source", nil
+}
+
+// skipDeterminer never triggers the applications for the given reason.
+// This is used to suppress a kind of triggering for all applications in a repository.
+type skipDeterminer struct {
+	reason string
+}
+
+func (d skipDeterminer) ShouldTrigger(_ context.Context, _ *model.Application, _ *config.GenericApplicationSpec) (bool, string, error) {
+	return false, d.reason, nil
+}
+
+type OutOfSyncCountGetter interface {
+	Get(applicationID string) int
+}
+
+type DeploymentFailureObserver interface {
+	Observe(applicationID string, deployment *model.Deployment) int
+}
+
+type IdenticalManifestChecker interface {
+	// IsIdentical reports whether the manifests of the given application
+	// rendered at the head commit are identical to its live state.
+	IsIdentical(ctx context.Context, app *model.Application) bool
+}
+
+// DeploymentGetter gets the deployment from the control-plane.
+// This is satisfied by the piped API client.
+type DeploymentGetter interface {
+	GetDeployment(ctx context.Context, in *pipedservice.GetDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.GetDeploymentResponse, error)
 }
 
 type OnOutOfSyncDeterminer struct {
-	client apiClient
+	client          DeploymentGetter
+	countGetter     OutOfSyncCountGetter
+	failureObserver DeploymentFailureObserver
+	manifestChecker IdenticalManifestChecker
+	headCommit      string
 }
 
-func NewOnOutOfSyncDeterminer(client apiClient) *OnOutOfSyncDeterminer {
+// NewOnOutOfSyncDeterminer returns a determiner for the out-of-sync applications.
+// The count getter, the failure observer and the manifest checker are optional,
+// and nil disables the confirmation, the failure backoff and the manifest comparison respectively.
+func NewOnOutOfSyncDeterminer(client DeploymentGetter, cg OutOfSyncCountGetter, fo DeploymentFailureObserver, mc IdenticalManifestChecker, headCommit string) *OnOutOfSyncDeterminer {
 	return &OnOutOfSyncDeterminer{
-		client: client,
+		client:          client,
+		countGetter:     cg,
+		failureObserver: fo,
+		manifestChecker: mc,
+		headCommit:      headCommit,
 	}
 }
 
 // ShouldTrigger decides whether a given application should be triggered or not.
-func (d *OnOutOfSyncDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+func (d *OnOutOfSyncDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
+	// The drift is left as is for the application configured never to be synced automatically.
+	if v := appCfg.Trigger.TriggerOnOutOfSync; v != nil && !*v {
+		return false, "trigger.triggerOnOutOfSync is false", nil
+	}
 	if *appCfg.Trigger.OnOutOfSync.Disabled {
-		return false, nil
+		return false, "trigger.onOutOfSync is disabled", nil
+	}
+	// The pinned application must be kept at its currently deployed commit.
+	if appCfg.Trigger.Pinned {
+		return false, "the application is pinned", nil
+	}
+
+	// Wait until the drift was confirmed in the configured number of consecutive checks
+	// to avoid triggering by the transient drift.
+	if n := appCfg.Trigger.OnOutOfSync.ConfirmationCount; n > 1 && d.countGetter != nil && d.countGetter.Get(app.Id) < n {
+		return false, fmt.Sprintf("the configuration drift has not been confirmed in %d consecutive checks yet", n), nil
+	}
+
+	// Deploying again does not remove the live resources not defined in Git,
+	// so the drift caused only by them is left as is if configured.
+	if appCfg.Trigger.OnOutOfSync.IgnoreExtraResources {
+		if r, ok := app.SyncState.GetOutOfSyncResources(); ok && r.Extra > 0 && r.Missing == 0 && r.Changed == 0 {
+			return false, fmt.Sprintf("the configuration drift is caused only by %d live resources not defined in Git", r.Extra), nil
+		}
+	}
+
+	reason := "detected a configuration drift"
+	if s := app.SyncState; s != nil && s.ShortReason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, s.ShortReason)
 	}
 
 	// Find the most recently triggered deployment.
@@ -104,14 +212,14 @@ func (d *OnOutOfSyncDeterminer) ShouldTrigger(ctx context.Context, app *model.Ap
 	// and no deployment was triggered yet.
 	ref := app.MostRecentlyTriggeredDeployment
 	if ref == nil {
-		return true, nil
+		return true, reason, nil
 	}
 
 	resp, err := d.client.GetDeployment(ctx, &pipedservice.GetDeploymentRequest{
 		Id: ref.DeploymentId,
 	})
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	deployment := resp.Deployment
 
@@ -119,39 +227,110 @@ func (d *OnOutOfSyncDeterminer) ShouldTrigger(ctx context.Context, app *model.Ap
 	// Not yet completed means the application is deploying currently,
 	// so no need to trigger a new deployment for it.
 	if !deployment.Status.IsCompleted() {
-		return false, nil
+		return false, fmt.Sprintf("the most recent deployment %s has not been completed yet", deployment.Id), nil
+	}
+
+	// Back off while the deployments keep failing at the same commit
+	// to avoid creating a lot of failed deployments by triggering at every check.
+	if b := appCfg.Trigger.OnOutOfSync.FailureBackoff; b != nil && d.failureObserver != nil {
+		failures := d.failureObserver.Observe(app.Id, deployment)
+		if deployment.GetTrigger().GetCommit().GetHash() == d.headCommit && time.Since(time.Unix(deployment.CompletedAt, 0)) < b.Interval(failures) {
+			return false, fmt.Sprintf("backing off since %d deployments failed at the head commit", failures), nil
+		}
+	}
+
+	// Triggering at the same commit just applies the same manifests again,
+	// so the drift is left to be fixed by another reconciler if configured.
+	if appCfg.Trigger.SkipOutOfSyncWhenCommitUnchanged && deployment.GetTrigger().GetCommit().GetHash() == d.headCommit {
+		return false, "the head commit has already been deployed", nil
+	}
+
+	// The drift reported at the deployed commit may be a false one,
+	// so render the manifests again to confirm it before triggering.
+	if appCfg.Trigger.OnOutOfSync.SkipIdenticalManifests && d.manifestChecker != nil && deployment.GetTrigger().GetCommit().GetHash() == d.headCommit {
+		if d.manifestChecker.IsIdentical(ctx, app) {
+			return false, "the manifests rendered at the head commit are identical to the live state", nil
+		}
 	}
 
 	// Check the elapsed time since the last deployment.
 	if time.Since(time.Unix(deployment.CompletedAt, 0)) < appCfg.Trigger.OnOutOfSync.MinWindow.Duration() {
-		return false, nil
+		return false, "the minimum window since the most recent deployment has not elapsed yet", nil
 	}
 
-	return true, nil
+	return true, reason, nil
 }
 
+const (
+	// maxReasonFiles is the maximum number of changed files listed in the trigger reason.
+	maxReasonFiles = 5
+	// defaultMaxCommitsPerCheck is the number of commits deployed one by one at a check
+	// when trigger.onCommit.maxCommitsPerCheck is not configured.
+	defaultMaxCommitsPerCheck = 5
+)
+
 type LastTriggeredCommitGetter interface {
 	Get(ctx context.Context, applicationID string) (string, error)
+	// Refresh re-fetches the last triggered commit of the given application
+	// from the control-plane instead of using the cached one.
+	Refresh(ctx context.Context, applicationID string) (string, error)
 }
 
 type OnCommitDeterminer struct {
 	repo         git.Repo
 	targetCommit string
+	// Whether to take the changes inside the submodules into account.
+	submodules bool
+	// Whether only the latest commits of the repository were cloned.
+	shallow      bool
 	commitGetter LastTriggeredCommitGetter
 	logger       *zap.Logger
+
+	// The summaries of the changed files of the applications determined to be triggered.
+	mu        sync.Mutex
+	summaries map[string]string
+	// The commits to be deployed one by one for the applications determined to be triggered.
+	ranges map[string]commitRange
+}
+
+type commitRange struct {
+	commits   []git.Commit
+	truncated bool
 }
 
-func NewOnCommitDeterminer(repo git.Repo, targetCommit string, cg LastTriggeredCommitGetter, logger *zap.Logger) Determiner {
+func NewOnCommitDeterminer(repo git.Repo, targetCommit string, submodules, shallow bool, cg LastTriggeredCommitGetter, logger *zap.Logger) Determiner {
 	return &OnCommitDeterminer{
 		repo:         repo,
 		targetCommit: targetCommit,
+		submodules:   submodules,
+		shallow:      shallow,
 		commitGetter: cg,
 		logger:       logger.Named("determiner"),
+		summaries:    make(map[string]string),
+		ranges:       make(map[string]commitRange),
 	}
 }
 
+// ChangesSummary returns the summary of the files changed since the last triggered commit
+// of the given application. Empty is returned when it was not determined to be triggered by them.
+func (d *OnCommitDeterminer) ChangesSummary(applicationID string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.summaries[applicationID]
+}
+
+// TargetCommits returns the new commits touching the given application to be deployed in order
+// and whether some of them were left to the subsequent checks.
+// Empty is returned when trigger.onCommit.deployEachCommit is not enabled.
+func (d *OnCommitDeterminer) TargetCommits(applicationID string) ([]git.Commit, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r := d.ranges[applicationID]
+	return r.commits, r.truncated
+}
+
 // ShouldTrigger decides whether a given application should be triggered or not.
-func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
 	logger := d.logger.With(
 		zap.String("app", app.Name),
 		zap.String("app-id", app.Id),
@@ -161,36 +340,330 @@ func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Appli
 	// Not trigger in case users disable auto trigger deploy on change and the user config is unignorable.
 	if appCfg.Trigger.OnCommit.Disabled {
 		logger.Info(fmt.Sprintf("auto trigger deployment disabled for application, hash: %s", d.targetCommit))
-		return false, nil
+		return false, "trigger.onCommit is disabled", nil
+	}
+
+	// The pinned application must be kept at its currently deployed commit.
+	// The new commits are handled from the head commit once it was unpinned.
+	if appCfg.Trigger.Pinned {
+		logger.Info("skipped triggering a new deployment since the application is pinned")
+		return false, "the application is pinned", nil
+	}
+
+	// The head commits other than merge commits are not deployed
+	// and their changes are treated as handled once the last triggered commit was advanced.
+	if appCfg.Trigger.OnCommit.MergeCommitsOnly {
+		commit, err := d.repo.GetCommitForRev(ctx, d.targetCommit)
+		if err != nil {
+			logger.Error("failed to get the target commit", zap.Error(err))
+			return false, "", err
+		}
+		if !commit.IsMerge() {
+			logger.Info(fmt.Sprintf("skipped triggering a new deployment since the target commit is not a merge commit, hash: %s", d.targetCommit))
+			return false, fmt.Sprintf("the head commit %s is not a merge commit", d.targetCommit), nil
+		}
 	}
 
 	preCommit, err := d.commitGetter.Get(ctx, app.Id)
 	if err != nil {
 		logger.Error("failed to get last triggered commit", zap.Error(err))
-		return false, err
+		return false, "", err
 	}
 
 	// There is no previous deployment so we don't need to check anymore.
 	// Just do it.
 	if preCommit == "" {
 		logger.Info("no previously triggered deployment was found")
-		return true, nil
+		return true, fmt.Sprintf("no previously triggered deployment was found, commit: %s", d.targetCommit), nil
 	}
 
 	// Check whether the most recently applied one is the target commit or not.
 	// If so, nothing to do for this time.
 	if preCommit == d.targetCommit {
 		logger.Info(fmt.Sprintf("no update to sync for application, hash: %s", d.targetCommit))
-		return false, nil
+		return false, fmt.Sprintf("no new commit since the last triggered commit %s", preCommit), nil
+	}
+
+	// The last triggered commit may be older than the commits fetched by the shallow clone.
+	// Its changes cannot be listed in that case, so this application is triggered conservatively.
+	if d.shallow {
+		if _, err := d.repo.GetCommitForRev(ctx, preCommit); err != nil {
+			logger.Info(fmt.Sprintf("triggering conservatively since the last triggered commit %s is older than the shallow clone", preCommit), zap.Error(err))
+			return true, fmt.Sprintf("the last triggered commit %s is older than the shallow clone, commit: %s", preCommit, d.targetCommit), nil
+		}
 	}
 
 	// List the changed files between those two commits and
 	// determine whether this application was touch by those changed files.
-	changedFiles, err := d.repo.ChangedFiles(ctx, preCommit, d.targetCommit)
+	changedFiles, err := d.changedFiles(ctx, preCommit, d.targetCommit)
 	if err != nil {
-		return false, err
+		// The last triggered commit may belong to another repository where the application was moved from.
+		// Its changes cannot be listed in that case, so the stale commit is ignored to trigger the head commit.
+		if _, cerr := d.repo.GetCommitForRev(ctx, preCommit); cerr != nil {
+			logger.Info(fmt.Sprintf("triggering since the last triggered commit %s was not found in the repository", preCommit), zap.Error(err))
+			return true, fmt.Sprintf("the last triggered commit %s was not found in the repository, commit: %s", preCommit, d.targetCommit), nil
+		}
+		return false, "", err
+	}
+
+	// The ignored files are dropped before checking the paths,
+	// so they never touch the application even if they are inside the application directory
+	// or matching the configured paths. Nothing is triggered when all changed files were ignored,
+	// but the other changed files in the same commits can still trigger the deployment as usual.
+	changedFiles, err = filterIgnoredFiles(appCfg.Trigger.OnCommit.Ignores, changedFiles)
+	if err != nil {
+		return false, "", &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.ignores: %w", err)}
+	}
+	if len(changedFiles) == 0 {
+		logger.Info("all changed files in new commits were ignored", zap.String("last-triggered-commit", preCommit))
+		return false, fmt.Sprintf("all files changed since the last triggered commit %s were ignored", preCommit), nil
+	}
+
+	checkingPaths := triggerPaths(appCfg)
+
+	var reason string
+	if contents := appCfg.Trigger.OnCommit.Contents; len(contents) > 0 {
+		// Only the changes of the targeted fields trigger the deployment
+		// instead of the changes of any file matching the application.
+		fields, err := d.changedContents(ctx, app.GitPath.Path, contents, preCommit, d.targetCommit, changedFiles)
+		if err != nil {
+			logger.Error("failed to compare the contents of the changed files", zap.Error(err))
+			return false, "", err
+		}
+		if len(fields) == 0 {
+			logger.Info("no content of the application was changed by any new commits", zap.String("last-triggered-commit", preCommit))
+			return false, fmt.Sprintf("no field in trigger.onCommit.contents was changed since the last triggered commit %s", preCommit), nil
+		}
+		if len(fields) > maxReasonFiles {
+			fields = fields[:maxReasonFiles]
+		}
+		reason = fmt.Sprintf("new commits from %s to %s changed the application contents, changed fields: %s", preCommit, d.targetCommit, strings.Join(fields, ", "))
+	} else {
+		touched, err := isTouchedByChangedFiles(app.GitPath.Path, checkingPaths, changedFiles)
+		if err != nil {
+			return false, "", &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.paths: %w", err)}
+		}
+
+		if !touched {
+			logger.Info("application was not touched by any new commits", zap.String("last-triggered-commit", preCommit))
+			return false, fmt.Sprintf("no file changed since the last triggered commit %s matches the application", preCommit), nil
+		}
+
+		touchedFiles := make([]string, 0, maxReasonFiles)
+		for _, cf := range changedFiles {
+			if ok, err := isTouchedByChangedFiles(app.GitPath.Path, checkingPaths, []string{cf}); err == nil && ok {
+				touchedFiles = append(touchedFiles, cf)
+				if len(touchedFiles) == maxReasonFiles {
+					break
+				}
+			}
+		}
+		reason = fmt.Sprintf("new commits from %s to %s touched the application", preCommit, d.targetCommit)
+		if len(touchedFiles) > 0 {
+			reason = fmt.Sprintf("%s, changed files: %s", reason, strings.Join(touchedFiles, ", "))
+		}
 	}
 
+	// Each of the new commits touching the application is deployed in order
+	// to keep them as the rollback points instead of deploying only the head commit.
+	var r commitRange
+	if appCfg.Trigger.OnCommit.DeployEachCommit {
+		r.commits, r.truncated, err = d.listTouchingCommits(ctx, app, appCfg, preCommit, checkingPaths)
+		if err != nil {
+			logger.Error("failed to list the new commits touching the application", zap.Error(err))
+			return false, "", err
+		}
+	}
+
+	d.mu.Lock()
+	d.summaries[app.Id] = summarizeChangedFiles(changedFiles)
+	d.ranges[app.Id] = r
+	d.mu.Unlock()
+	return true, reason, nil
+}
+
+// listTouchingCommits returns the commits after the given one until the target commit touching the application in order,
+// at most trigger.onCommit.maxCommitsPerCheck of them, and whether some of them were left.
+func (d *OnCommitDeterminer) listTouchingCommits(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec, from string, checkingPaths []string) ([]git.Commit, bool, error) {
+	limit := appCfg.Trigger.OnCommit.MaxCommitsPerCheck
+	if limit <= 0 {
+		limit = defaultMaxCommitsPerCheck
+	}
+
+	commits, err := d.repo.ListCommitRange(ctx, from, d.targetCommit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	touching := make([]git.Commit, 0, limit)
+	prev := from
+	for _, commit := range commits {
+		changedFiles, err := d.changedFiles(ctx, prev, commit.Hash)
+		if err != nil {
+			return nil, false, err
+		}
+		changedFiles, err = filterIgnoredFiles(appCfg.Trigger.OnCommit.Ignores, changedFiles)
+		if err != nil {
+			return nil, false, &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.ignores: %w", err)}
+		}
+		touched, err := d.isTouched(ctx, app, appCfg, checkingPaths, prev, commit.Hash, changedFiles)
+		if err != nil {
+			return nil, false, err
+		}
+		prev = commit.Hash
+		if !touched {
+			continue
+		}
+		if len(touching) == limit {
+			return touching, true, nil
+		}
+		touching = append(touching, commit)
+	}
+	return touching, false, nil
+}
+
+// isTouched checks whether the application was touched by the files changed between two commits.
+// Only the changes of the fields in trigger.onCommit.contents are taken into account when they are specified.
+func (d *OnCommitDeterminer) isTouched(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec, checkingPaths []string, from, to string, changedFiles []string) (bool, error) {
+	if contents := appCfg.Trigger.OnCommit.Contents; len(contents) > 0 {
+		fields, err := d.changedContents(ctx, app.GitPath.Path, contents, from, to, changedFiles)
+		if err != nil {
+			return false, err
+		}
+		return len(fields) > 0, nil
+	}
+
+	touched, err := isTouchedByChangedFiles(app.GitPath.Path, checkingPaths, changedFiles)
+	if err != nil {
+		return false, &ConfigError{Err: fmt.Errorf("invalid trigger.onCommit.paths: %w", err)}
+	}
+	return touched, nil
+}
+
+// changedFiles returns the files changed between two commits
+// including the ones inside the submodules if enabled.
+func (d *OnCommitDeterminer) changedFiles(ctx context.Context, from, to string) ([]string, error) {
+	changedFiles, err := d.repo.ChangedFiles(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	// Only the commit recorded for each submodule is changed in this repository,
+	// so the files changed inside the submodules are listed to be checked as well.
+	if d.submodules {
+		submoduleFiles, err := d.repo.ChangedSubmoduleFiles(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		changedFiles = append(changedFiles, submoduleFiles...)
+	}
+	return changedFiles, nil
+}
+
+type LastTriggeredTagGetter interface {
+	Get(applicationID string) string
+}
+
+// OnTagDeterminer decides to trigger the applications in the repositories
+// using tags instead of the branch head when a new tag was pushed.
+type OnTagDeterminer struct {
+	// The newest tag matching the configured pattern.
+	// Nil means no tag was found.
+	tag          *git.Tag
+	tagGetter    LastTriggeredTagGetter
+	commitGetter LastTriggeredCommitGetter
+	logger       *zap.Logger
+}
+
+func NewOnTagDeterminer(tag *git.Tag, tg LastTriggeredTagGetter, cg LastTriggeredCommitGetter, logger *zap.Logger) Determiner {
+	return &OnTagDeterminer{
+		tag:          tag,
+		tagGetter:    tg,
+		commitGetter: cg,
+		logger:       logger.Named("tag-determiner"),
+	}
+}
+
+// ShouldTrigger decides whether a given application should be triggered or not.
+func (d *OnTagDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
+	if d.tag == nil {
+		return false, "no tag matching the pattern was found", nil
+	}
+	logger := d.logger.With(
+		zap.String("app", app.Name),
+		zap.String("app-id", app.Id),
+		zap.String("tag", d.tag.Name),
+	)
+
+	// The tags are the replacement of the new commits so the same configuration is respected.
+	if appCfg.Trigger.OnCommit.Disabled {
+		logger.Info("auto trigger deployment disabled for application")
+		return false, "trigger.onCommit is disabled", nil
+	}
+	if appCfg.Trigger.Pinned {
+		logger.Info("skipped triggering a new deployment since the application is pinned")
+		return false, "the application is pinned", nil
+	}
+
+	if d.tagGetter.Get(app.Id) == d.tag.Name {
+		return false, fmt.Sprintf("tag %s has already been triggered", d.tag.Name), nil
+	}
+
+	// The last triggered tag is not kept after restarting piped,
+	// so the last triggered commit is checked too to avoid deploying the same tag again.
+	preCommit, err := d.commitGetter.Get(ctx, app.Id)
+	if err != nil {
+		logger.Error("failed to get last triggered commit", zap.Error(err))
+		return false, "", err
+	}
+	if preCommit == d.tag.Hash {
+		logger.Info("no update to sync for application")
+		return false, fmt.Sprintf("tag %s points to the last triggered commit", d.tag.Name), nil
+	}
+
+	return true, fmt.Sprintf("new tag %s was found, commit: %s", d.tag.Name, d.tag.Hash), nil
+}
+
+type LastScheduledTimeGetter interface {
+	// Get returns the time from which the next scheduled time of the given application is computed.
+	Get(applicationID string, now time.Time) time.Time
+}
+
+// OnScheduleDeterminer decides to trigger the applications whose cron schedule has been reached
+// since they were last triggered, even though nothing was pushed.
+type OnScheduleDeterminer struct {
+	timeGetter LastScheduledTimeGetter
+	now        time.Time
+}
+
+func NewOnScheduleDeterminer(tg LastScheduledTimeGetter, now time.Time) *OnScheduleDeterminer {
+	return &OnScheduleDeterminer{
+		timeGetter: tg,
+		now:        now,
+	}
+}
+
+// ShouldTrigger decides whether a given application should be triggered or not.
+func (d *OnScheduleDeterminer) ShouldTrigger(_ context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, string, error) {
+	sched, err := appCfg.Trigger.OnSchedule.Schedule()
+	if err != nil {
+		return false, "", &ConfigError{Err: err}
+	}
+	if sched == nil {
+		return false, "", nil
+	}
+	if appCfg.Trigger.Pinned {
+		return false, "the application is pinned", nil
+	}
+
+	next := sched.Next(d.timeGetter.Get(app.Id, d.now))
+	if d.now.Before(next) {
+		return false, fmt.Sprintf("the next scheduled time is %s", next.Format(time.RFC3339)), nil
+	}
+	return true, fmt.Sprintf("reached the scheduled time %s of cron %q", next.Format(time.RFC3339), appCfg.Trigger.OnSchedule.Cron), nil
+}
+
+// triggerPaths returns the paths whose changes trigger the deployment of the given application.
+func triggerPaths(appCfg *config.GenericApplicationSpec) []string {
 	// TODO: Remove deprecated `appCfg.TriggerPaths` configuration.
 	checkingPaths := make([]string, 0, len(appCfg.Trigger.OnCommit.Paths)+len(appCfg.TriggerPaths))
 	// Note: appCfg.TriggerPaths or appCfg.Trigger.OnCommit.Paths may contain "" (empty string)
@@ -208,25 +681,58 @@ func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Appli
 			checkingPaths = append(checkingPaths, p)
 		}
 	}
+	return checkingPaths
+}
 
-	touched, err := isTouchedByChangedFiles(app.GitPath.Path, checkingPaths, changedFiles)
+// filterIgnoredFiles returns the changed files not matching any of the given ignore patterns.
+func filterIgnoredFiles(ignores []string, changedFiles []string) ([]string, error) {
+	if len(ignores) == 0 {
+		return changedFiles, nil
+	}
+	matcher, err := filematcher.NewPatternMatcher(ignores)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-
-	if !touched {
-		logger.Info("application was not touched by any new commits", zap.String("last-triggered-commit", preCommit))
-		return false, nil
+	files := make([]string, 0, len(changedFiles))
+	for _, cf := range changedFiles {
+		if !matcher.Matches(cf) {
+			files = append(files, cf)
+		}
 	}
-
-	return true, nil
+	return files, nil
 }
 
+// isTouchedByChangedFiles checks whether the application was touched by the changed files.
+// The specified "changes" are glob patterns, the ones prefixed with "!" are exclusions.
+// The patterns starting with "./" or "../" are relative to the application directory,
+// e.g. "../base" for the kustomize base or the Helm values shared with the other applications.
+// The changed files matching any exclusion are not taken into account at all,
+// so the application is not touched when all changed files were excluded
+// even if they are inside the application directory.
 func isTouchedByChangedFiles(appDir string, changes []string, changedFiles []string) (bool, error) {
+	includes := make([]string, 0, len(changes))
+	excludes := make([]string, 0)
+	for _, c := range changes {
+		c = strings.TrimSpace(c)
+		if !strings.HasPrefix(c, "!") {
+			includes = append(includes, resolveAppRelativePath(appDir, c))
+			continue
+		}
+		if c = strings.TrimSpace(c[1:]); c == "" {
+			return false, fmt.Errorf("illegal exclusion pattern: %q", "!")
+		}
+		excludes = append(excludes, resolveAppRelativePath(appDir, c))
+	}
+
 	if !strings.HasSuffix(appDir, "/") {
 		appDir += "/"
 	}
 
+	changedFiles, err := filterIgnoredFiles(excludes, changedFiles)
+	if err != nil {
+		return false, err
+	}
+
 	// If any files inside the application directory was changed
 	// this application is considered as touched.
 	for _, cf := range changedFiles {
@@ -237,7 +743,7 @@ func isTouchedByChangedFiles(appDir string, changes []string, changedFiles []str
 
 	// If any changed files matches the specified "changes"
 	// this application is consided as touched too.
-	for _, change := range changes {
+	for _, change := range includes {
 		matcher, err := filematcher.NewPatternMatcher([]string{change})
 		if err != nil {
 			return false, err
@@ -249,3 +755,12 @@ func isTouchedByChangedFiles(appDir string, changes []string, changedFiles []str
 
 	return false, nil
 }
+
+// resolveAppRelativePath returns the path from the repository root of the given pattern
+// if it is relative to the given application directory, otherwise returns as is.
+func resolveAppRelativePath(appDir, pattern string) string {
+	if !strings.HasPrefix(pattern, "./") && !strings.HasPrefix(pattern, "../") {
+		return pattern
+	}
+	return path.Join(appDir, pattern)
+}
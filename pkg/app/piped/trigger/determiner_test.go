@@ -15,9 +15,18 @@
 package trigger
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestIsTouchedByChangedFiles(t *testing.T) {
@@ -69,6 +78,80 @@ func TestIsTouchedByChangedFiles(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:   "touched in the changes relative to app dir",
+			appDir: "app/demo",
+			changes: []string{
+				"../base",
+				"./../values/*.yaml",
+			},
+			changedFiles: []string{
+				"app/hello.txt",
+				"app/base/kustomization.yaml",
+			},
+			expected: true,
+		},
+		{
+			name:   "not touched since the changes relative to app dir were excluded",
+			appDir: "app/demo",
+			changes: []string{
+				"../values/*.yaml",
+				"!../values/test-*.yaml",
+			},
+			changedFiles: []string{
+				"app/values/test-values.yaml",
+				"values/prod-values.yaml",
+			},
+			expected: false,
+		},
+		{
+			name:   "touched by glob pattern",
+			appDir: "app/demo",
+			changes: []string{
+				"manifests/**/*.yaml",
+			},
+			changedFiles: []string{
+				"app/hello.txt",
+				"manifests/demo/dev/deployment.yaml",
+			},
+			expected: true,
+		},
+		{
+			name:   "not touched since the matched files were excluded",
+			appDir: "app/demo",
+			changes: []string{
+				"manifests/**/*.yaml",
+				"!**/test/**",
+			},
+			changedFiles: []string{
+				"manifests/demo/test/deployment.yaml",
+			},
+			expected: false,
+		},
+		{
+			name:   "not touched since all changed files in app dir were excluded",
+			appDir: "app/demo",
+			changes: []string{
+				"!**/test/**",
+			},
+			changedFiles: []string{
+				"app/demo/test/fixture.yaml",
+				"app/demo/test/data/fixture.yaml",
+			},
+			expected: false,
+		},
+		{
+			name:   "touched by the changed files were not excluded",
+			appDir: "app/demo",
+			changes: []string{
+				"!**/test/**",
+			},
+			changedFiles: []string{
+				"app/demo/test/fixture.yaml",
+				"app/demo/deployment.yaml",
+			},
+			expected: true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -79,3 +162,901 @@ func TestIsTouchedByChangedFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterIgnoredFiles(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		ignores      []string
+		changedFiles []string
+		expected     []string
+	}{
+		{
+			name:         "no ignore",
+			changedFiles: []string{"app/demo/README.md", "app/demo/deployment.yaml"},
+			expected:     []string{"app/demo/README.md", "app/demo/deployment.yaml"},
+		},
+		{
+			name:         "all changed files were ignored",
+			ignores:      []string{"**/*.md"},
+			changedFiles: []string{"app/demo/README.md", "app/demo/CHANGELOG.md"},
+			expected:     []string{},
+		},
+		{
+			name:         "ignored file was changed with a manifest",
+			ignores:      []string{"**/README.md", "**/CHANGELOG.md"},
+			changedFiles: []string{"app/demo/README.md", "app/demo/deployment.yaml"},
+			expected:     []string{"app/demo/deployment.yaml"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := filterIgnoredFiles(tc.ignores, tc.changedFiles)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPinnedApplication(t *testing.T) {
+	t.Parallel()
+
+	enabled := false
+	appCfg := &config.GenericApplicationSpec{
+		Trigger: config.Trigger{
+			OnOutOfSync: config.OnOutOfSync{Disabled: &enabled},
+			Pinned:      true,
+		},
+	}
+	app := &model.Application{Id: "app-id", Name: "app"}
+
+	// The pinned application is skipped before accessing the repository or the control-plane.
+	ok, _, err := NewOnCommitDeterminer(nil, "commit-hash", false, false, nil, zap.NewNop()).ShouldTrigger(context.Background(), app, appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, _, err = NewOnOutOfSyncDeterminer(nil, nil, nil, nil, "").ShouldTrigger(context.Background(), app, appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, _, err = NewOnCommandDeterminer().ShouldTrigger(context.Background(), app, appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+type fakeLastTriggeredCommitGetter map[string]string
+
+func (g fakeLastTriggeredCommitGetter) Get(_ context.Context, applicationID string) (string, error) {
+	return g[applicationID], nil
+}
+
+func (g fakeLastTriggeredCommitGetter) Refresh(_ context.Context, applicationID string) (string, error) {
+	return g[applicationID], nil
+}
+
+type fakeSubmoduleRepo struct {
+	git.Repo
+	changedFiles          []string
+	changedSubmoduleFiles []string
+}
+
+func (r *fakeSubmoduleRepo) ChangedFiles(_ context.Context, _, _ string) ([]string, error) {
+	return r.changedFiles, nil
+}
+
+func (r *fakeSubmoduleRepo) ChangedSubmoduleFiles(_ context.Context, _, _ string) ([]string, error) {
+	return r.changedSubmoduleFiles, nil
+}
+
+func TestOnCommitDeterminerSubmodules(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeSubmoduleRepo{
+			changedFiles:          []string{"manifests"},
+			changedSubmoduleFiles: []string{"manifests/app-1/deployment.yaml"},
+		}
+		cg  = fakeLastTriggeredCommitGetter{"app-1": "commit-1", "app-2": "commit-1"}
+		app = func(id, path string) *model.Application {
+			return &model.Application{Id: id, GitPath: &model.ApplicationGitPath{Path: path}}
+		}
+		appCfg = &config.GenericApplicationSpec{}
+		ctx    = context.Background()
+	)
+
+	// The update of the submodule pointer touches no application without the submodule awareness.
+	ok, _, err := NewOnCommitDeterminer(repo, "commit-2", false, false, cg, zap.NewNop()).ShouldTrigger(ctx, app("app-1", "manifests/app-1"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	d := NewOnCommitDeterminer(repo, "commit-2", true, false, cg, zap.NewNop())
+	ok, reason, err := d.ShouldTrigger(ctx, app("app-1", "manifests/app-1"), appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Contains(t, reason, "manifests/app-1/deployment.yaml")
+	assert.Equal(t, "2 files changed: ./ (1), manifests/ (1)", d.(changesSummarizer).ChangesSummary("app-1"))
+
+	ok, _, err = d.ShouldTrigger(ctx, app("app-2", "manifests/app-2"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, d.(changesSummarizer).ChangesSummary("app-2"))
+}
+
+type fakeMergeCommitRepo struct {
+	git.Repo
+	commits map[string]git.Commit
+}
+
+func (r *fakeMergeCommitRepo) GetCommitForRev(_ context.Context, rev string) (git.Commit, error) {
+	return r.commits[rev], nil
+}
+
+func (r *fakeMergeCommitRepo) ChangedFiles(_ context.Context, _, _ string) ([]string, error) {
+	return []string{"app/deployment.yaml"}, nil
+}
+
+func TestOnCommitDeterminerMergeCommitsOnly(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeMergeCommitRepo{
+			commits: map[string]git.Commit{
+				"commit-2": {Hash: "commit-2", Parents: []string{"commit-1"}},
+				"merge-1":  {Hash: "merge-1", Parents: []string{"commit-1", "feature-1"}},
+			},
+		}
+		cg     = fakeLastTriggeredCommitGetter{"app-1": "commit-1"}
+		app    = &model.Application{Id: "app-1", GitPath: &model.ApplicationGitPath{Path: "app"}}
+		appCfg = &config.GenericApplicationSpec{
+			Trigger: config.Trigger{OnCommit: config.OnCommit{MergeCommitsOnly: true}},
+		}
+		ctx = context.Background()
+	)
+
+	ok, _, err := NewOnCommitDeterminer(repo, "commit-2", false, false, cg, zap.NewNop()).ShouldTrigger(ctx, app, appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, _, err = NewOnCommitDeterminer(repo, "merge-1", false, false, cg, zap.NewNop()).ShouldTrigger(ctx, app, appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// The option is not enabled.
+	ok, _, err = NewOnCommitDeterminer(repo, "commit-2", false, false, cg, zap.NewNop()).ShouldTrigger(ctx, app, &config.GenericApplicationSpec{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestOnCommitDeterminerDeployEachCommit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeHistoryRepo{
+			first: "commit-1",
+			commits: []git.Commit{
+				{Hash: "commit-2"},
+				{Hash: "commit-3"},
+				{Hash: "commit-4"},
+				{Hash: "commit-5"},
+			},
+			changes: map[string][]string{
+				"commit-2": {"app/deployment.yaml"},
+				"commit-3": {"another/deployment.yaml"},
+				"commit-4": {"app/service.yaml"},
+				"commit-5": {"app/README.md"},
+			},
+		}
+		cg     = fakeLastTriggeredCommitGetter{"app-1": "commit-1"}
+		app    = &model.Application{Id: "app-1", GitPath: &model.ApplicationGitPath{Path: "app"}}
+		appCfg = func(limit int) *config.GenericApplicationSpec {
+			return &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnCommit: config.OnCommit{
+					Ignores:            []string{"**/README.md"},
+					DeployEachCommit:   true,
+					MaxCommitsPerCheck: limit,
+				}},
+			}
+		}
+		ctx = context.Background()
+	)
+	hashes := func(commits []git.Commit) []string {
+		out := make([]string, 0, len(commits))
+		for _, c := range commits {
+			out = append(out, c.Hash)
+		}
+		return out
+	}
+
+	d := NewOnCommitDeterminer(repo, "commit-5", false, false, cg, zap.NewNop())
+	ok, _, err := d.ShouldTrigger(ctx, app, appCfg(0))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	commits, truncated := d.(commitRangeLister).TargetCommits(app.Id)
+	assert.Equal(t, []string{"commit-2", "commit-4"}, hashes(commits))
+	assert.False(t, truncated)
+
+	d = NewOnCommitDeterminer(repo, "commit-5", false, false, cg, zap.NewNop())
+	ok, _, err = d.ShouldTrigger(ctx, app, appCfg(1))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	commits, truncated = d.(commitRangeLister).TargetCommits(app.Id)
+	assert.Equal(t, []string{"commit-2"}, hashes(commits))
+	assert.True(t, truncated)
+
+	// Only the head commit is deployed when the option is not enabled.
+	d = NewOnCommitDeterminer(repo, "commit-5", false, false, cg, zap.NewNop())
+	ok, _, err = d.ShouldTrigger(ctx, app, &config.GenericApplicationSpec{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	commits, _ = d.(commitRangeLister).TargetCommits(app.Id)
+	assert.Empty(t, commits)
+}
+
+func TestOnCommitDeterminerContents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeRepo{
+			head:         git.Commit{Hash: "commit-2"},
+			changedFiles: []string{"app/values.yaml", "app/deployment.yaml"},
+			contents: map[string]map[string]string{
+				"commit-1": {
+					"app/values.yaml": "image:\n  tag: v1\nreplicas: 1\n",
+				},
+				"commit-2": {
+					"app/values.yaml": "image:\n  tag: v1\nreplicas: 2\n",
+				},
+			},
+		}
+		cg  = fakeLastTriggeredCommitGetter{"app-1": "commit-1"}
+		app = &model.Application{Id: "app-1", GitPath: &model.ApplicationGitPath{Path: "app"}}
+		ctx = context.Background()
+	)
+	appCfg := func(contents ...config.OnCommitContent) *config.GenericApplicationSpec {
+		return &config.GenericApplicationSpec{
+			Trigger: config.Trigger{OnCommit: config.OnCommit{Contents: contents}},
+		}
+	}
+
+	testcases := []struct {
+		name       string
+		appCfg     *config.GenericApplicationSpec
+		want       bool
+		wantReason string
+		wantErr    bool
+		wantCfgErr bool
+	}{
+		{
+			name:       "fall back to the paths when no content is specified",
+			appCfg:     appCfg(),
+			want:       true,
+			wantReason: "new commits from commit-1 to commit-2 touched the application, changed files: app/values.yaml, app/deployment.yaml",
+		},
+		{
+			name:       "targeted field was not changed",
+			appCfg:     appCfg(config.OnCommitContent{File: "values.yaml", Field: "$.image.tag"}),
+			want:       false,
+			wantReason: "no field in trigger.onCommit.contents was changed since the last triggered commit commit-1",
+		},
+		{
+			name:       "targeted field was changed",
+			appCfg:     appCfg(config.OnCommitContent{File: "values.yaml", Field: "$.image.tag"}, config.OnCommitContent{File: "values.yaml", Field: "$.replicas"}),
+			want:       true,
+			wantReason: "new commits from commit-1 to commit-2 changed the application contents, changed fields: app/values.yaml:$.replicas",
+		},
+		{
+			name:       "targeted field does not exist",
+			appCfg:     appCfg(config.OnCommitContent{File: "values.yaml", Field: "$.image.repository"}),
+			want:       false,
+			wantReason: "no field in trigger.onCommit.contents was changed since the last triggered commit commit-1",
+		},
+		{
+			name:       "targeted file was not changed",
+			appCfg:     appCfg(config.OnCommitContent{File: "../base/values.yaml", Field: "$.image.tag"}),
+			want:       false,
+			wantReason: "no field in trigger.onCommit.contents was changed since the last triggered commit commit-1",
+		},
+		{
+			name:       "invalid field",
+			appCfg:     appCfg(config.OnCommitContent{File: "values.yaml", Field: "$.image["}),
+			wantErr:    true,
+			wantCfgErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := NewOnCommitDeterminer(repo, "commit-2", false, false, cg, zap.NewNop())
+			got, reason, err := d.ShouldTrigger(ctx, app, tc.appCfg)
+			if tc.wantErr {
+				require.Error(t, err)
+				var cfgErr *ConfigError
+				assert.Equal(t, tc.wantCfgErr, errors.As(err, &cfgErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+}
+
+func TestOnCommitDeterminerShallowClone(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeRepo{
+			head:         git.Commit{Hash: "commit-3"},
+			ancestors:    []string{"commit-2"},
+			changedFiles: []string{"another/deployment.yaml"},
+		}
+		cg = fakeLastTriggeredCommitGetter{
+			"app-1": "commit-2",
+			// This is older than the cloned commits.
+			"app-2": "commit-1",
+		}
+		appCfg = &config.GenericApplicationSpec{}
+		ctx    = context.Background()
+	)
+	app := func(id string) *model.Application {
+		return &model.Application{Id: id, GitPath: &model.ApplicationGitPath{Path: "app"}}
+	}
+
+	d := NewOnCommitDeterminer(repo, "commit-3", false, true, cg, zap.NewNop())
+	ok, _, err := d.ShouldTrigger(ctx, app("app-1"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, reason, err := d.ShouldTrigger(ctx, app("app-2"), appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "the last triggered commit commit-1 is older than the shallow clone, commit: commit-3", reason)
+
+	// The changes are listed as usual for the full clone.
+	ok, _, err = NewOnCommitDeterminer(repo, "commit-3", false, false, cg, zap.NewNop()).ShouldTrigger(ctx, app("app-2"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestOnCommitDeterminerConfigError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeRepo{
+			head:         git.Commit{Hash: "commit-2"},
+			changedFiles: []string{"app/deployment.yaml"},
+		}
+		cg  = fakeLastTriggeredCommitGetter{"app-1": "commit-1"}
+		app = &model.Application{Id: "app-1", GitPath: &model.ApplicationGitPath{Path: "app"}}
+		d   = NewOnCommitDeterminer(repo, "commit-2", false, false, cg, zap.NewNop())
+	)
+
+	testcases := []struct {
+		name     string
+		onCommit config.OnCommit
+	}{
+		{
+			name:     "illegal exclusion pattern of paths",
+			onCommit: config.OnCommit{Paths: []string{"!"}},
+		},
+		{
+			name:     "malformed ignore pattern",
+			onCommit: config.OnCommit{Ignores: []string{"[a-"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			appCfg := &config.GenericApplicationSpec{Trigger: config.Trigger{OnCommit: tc.onCommit}}
+			_, _, err := d.ShouldTrigger(context.Background(), app, appCfg)
+			var cfgErr *ConfigError
+			assert.True(t, errors.As(err, &cfgErr), err)
+		})
+	}
+}
+
+func TestOnTagDeterminer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tag         = &git.Tag{Name: "v1.1.0", Hash: "commit-2"}
+		tagStore    = newLastTriggeredTagStore()
+		commitStore = fakeLastTriggeredCommitGetter{
+			"app-1": "commit-1",
+			"app-2": "commit-1",
+			"app-3": "commit-2",
+		}
+		appCfg = &config.GenericApplicationSpec{}
+		ctx    = context.Background()
+	)
+	tagStore.Put("app-2", "v1.1.0")
+
+	testcases := []struct {
+		name     string
+		tag      *git.Tag
+		appID    string
+		appCfg   *config.GenericApplicationSpec
+		expected bool
+	}{
+		{
+			name:     "no tag was found",
+			appID:    "app-1",
+			appCfg:   appCfg,
+			expected: false,
+		},
+		{
+			name:     "new tag was found",
+			tag:      tag,
+			appID:    "app-1",
+			appCfg:   appCfg,
+			expected: true,
+		},
+		{
+			name:     "tag was already triggered",
+			tag:      tag,
+			appID:    "app-2",
+			appCfg:   appCfg,
+			expected: false,
+		},
+		{
+			name:     "tagged commit was already triggered",
+			tag:      tag,
+			appID:    "app-3",
+			appCfg:   appCfg,
+			expected: false,
+		},
+		{
+			name:  "disabled on commit",
+			tag:   tag,
+			appID: "app-1",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnCommit: config.OnCommit{Disabled: true}},
+			},
+			expected: false,
+		},
+		{
+			name:  "pinned",
+			tag:   tag,
+			appID: "app-1",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{Pinned: true},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := NewOnTagDeterminer(tc.tag, tagStore, commitStore, zap.NewNop())
+			got, reason, err := d.ShouldTrigger(ctx, &model.Application{Id: tc.appID}, tc.appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			if got {
+				assert.Equal(t, "new tag v1.1.0 was found, commit: commit-2", reason)
+			}
+		})
+	}
+}
+
+func TestOnScheduleDeterminer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now   = time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC)
+		store = newScheduleStore()
+		ctx   = context.Background()
+	)
+	store.Triggered("app-1", time.Date(2022, 1, 1, 9, 30, 0, 0, time.UTC))
+	store.Triggered("app-2", time.Date(2022, 1, 1, 10, 10, 0, 0, time.UTC))
+
+	testcases := []struct {
+		name           string
+		appID          string
+		appCfg         *config.GenericApplicationSpec
+		expected       bool
+		expectedReason string
+		wantErr        bool
+	}{
+		{
+			name:   "no schedule",
+			appID:  "app-1",
+			appCfg: &config.GenericApplicationSpec{},
+		},
+		{
+			name:  "scheduled time was reached",
+			appID: "app-1",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: config.OnSchedule{Cron: "0 * * * *"}},
+			},
+			expected:       true,
+			expectedReason: `reached the scheduled time 2022-01-01T10:00:00Z of cron "0 * * * *"`,
+		},
+		{
+			name:  "scheduled time was not reached",
+			appID: "app-2",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: config.OnSchedule{Cron: "0 * * * *"}},
+			},
+			expectedReason: "the next scheduled time is 2022-01-01T11:00:00Z",
+		},
+		{
+			name:  "scheduled in the configured timezone",
+			appID: "app-2",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: config.OnSchedule{Cron: "15 19 * * *", Timezone: "Asia/Tokyo"}},
+			},
+			expected:       true,
+			expectedReason: `reached the scheduled time 2022-01-01T10:15:00Z of cron "15 19 * * *"`,
+		},
+		{
+			name:  "never triggered",
+			appID: "app-3",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: config.OnSchedule{Cron: "* * * * *"}},
+			},
+			expectedReason: "the next scheduled time is 2022-01-01T10:31:00Z",
+		},
+		{
+			name:  "pinned",
+			appID: "app-1",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{Pinned: true, OnSchedule: config.OnSchedule{Cron: "0 * * * *"}},
+			},
+			expectedReason: "the application is pinned",
+		},
+		{
+			name:  "invalid cron",
+			appID: "app-1",
+			appCfg: &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: config.OnSchedule{Cron: "every hour"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := NewOnScheduleDeterminer(store, now)
+			got, reason, err := d.ShouldTrigger(ctx, &model.Application{Id: tc.appID}, tc.appCfg)
+			if tc.wantErr {
+				var cfgErr *ConfigError
+				assert.True(t, errors.As(err, &cfgErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
+
+type fakeOutOfSyncCountGetter map[string]int
+
+func (g fakeOutOfSyncCountGetter) Get(applicationID string) int {
+	return g[applicationID]
+}
+
+func TestOnOutOfSyncDeterminerConfirmationCount(t *testing.T) {
+	t.Parallel()
+
+	enabled := false
+	appCfg := &config.GenericApplicationSpec{
+		Trigger: config.Trigger{
+			OnOutOfSync: config.OnOutOfSync{Disabled: &enabled, ConfirmationCount: 3},
+		},
+	}
+	d := NewOnOutOfSyncDeterminer(nil, fakeOutOfSyncCountGetter{"app-1": 2, "app-2": 3}, nil, nil, "")
+
+	// The drift has not been confirmed yet.
+	ok, _, err := d.ShouldTrigger(context.Background(), &model.Application{Id: "app-1"}, appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// No deployment has been triggered yet so the confirmed drift triggers a new one.
+	ok, reason, err := d.ShouldTrigger(context.Background(), &model.Application{Id: "app-2"}, appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "detected a configuration drift", reason)
+}
+
+func TestOnOutOfSyncDeterminerIgnoreExtraResources(t *testing.T) {
+	t.Parallel()
+
+	enabled := false
+	appCfg := &config.GenericApplicationSpec{
+		Trigger: config.Trigger{
+			OnOutOfSync: config.OnOutOfSync{Disabled: &enabled, IgnoreExtraResources: true},
+		},
+	}
+	newApp := func(shortReason string) *model.Application {
+		return &model.Application{
+			Id: "app-1",
+			SyncState: &model.ApplicationSyncState{
+				Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+				ShortReason: shortReason,
+			},
+		}
+	}
+	d := NewOnOutOfSyncDeterminer(nil, fakeOutOfSyncCountGetter{}, nil, nil, "")
+
+	testcases := []struct {
+		name        string
+		shortReason string
+		want        bool
+	}{
+		{
+			name:        "only extra resources",
+			shortReason: model.MakeOutOfSyncShortReason(model.OutOfSyncResources{Extra: 2}),
+			want:        false,
+		},
+		{
+			name:        "extra and changed resources",
+			shortReason: model.MakeOutOfSyncShortReason(model.OutOfSyncResources{Extra: 2, Changed: 1}),
+			want:        true,
+		},
+		{
+			name:        "missing resources",
+			shortReason: model.MakeOutOfSyncShortReason(model.OutOfSyncResources{Missing: 1}),
+			want:        true,
+		},
+		{
+			name:        "unknown reason",
+			shortReason: "The service manifest doesn't be synced",
+			want:        true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ok, _, err := d.ShouldTrigger(context.Background(), newApp(tc.shortReason), appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ok)
+		})
+	}
+}
+
+func TestOnOutOfSyncDeterminerSkipWhenCommitUnchanged(t *testing.T) {
+	t.Parallel()
+
+	var (
+		enabled = false
+		client  = &fakeDependencyAPIClient{
+			deployments: map[string]*model.Deployment{
+				"deployment-1": {
+					Id:          "deployment-1",
+					Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+					Trigger:     &model.DeploymentTrigger{Commit: &model.Commit{Hash: "commit-1"}},
+					CompletedAt: time.Now().Add(-time.Hour).Unix(),
+				},
+			},
+		}
+		app = &model.Application{
+			Id:                              "app-1",
+			MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{DeploymentId: "deployment-1"},
+		}
+	)
+	newAppCfg := func(skip bool) *config.GenericApplicationSpec {
+		return &config.GenericApplicationSpec{
+			Trigger: config.Trigger{
+				OnOutOfSync:                      config.OnOutOfSync{Disabled: &enabled},
+				SkipOutOfSyncWhenCommitUnchanged: skip,
+			},
+		}
+	}
+
+	testcases := []struct {
+		name       string
+		skip       bool
+		headCommit string
+		expected   bool
+	}{
+		{
+			name:       "not configured",
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "commit unchanged",
+			skip:       true,
+			headCommit: "commit-1",
+			expected:   false,
+		},
+		{
+			name:       "commit changed",
+			skip:       true,
+			headCommit: "commit-2",
+			expected:   true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewOnOutOfSyncDeterminer(client, nil, nil, nil, tc.headCommit)
+			ok, _, err := d.ShouldTrigger(context.Background(), app, newAppCfg(tc.skip))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+func TestOnOutOfSyncDeterminerFailureBackoff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		enabled = false
+		client  = &fakeDependencyAPIClient{
+			deployments: map[string]*model.Deployment{
+				"deployment-1": {
+					Id:          "deployment-1",
+					Status:      model.DeploymentStatus_DEPLOYMENT_FAILURE,
+					Trigger:     &model.DeploymentTrigger{Commit: &model.Commit{Hash: "commit-1"}},
+					CompletedAt: time.Now().Add(-30 * time.Minute).Unix(),
+				},
+			},
+		}
+		app = &model.Application{
+			Id:                              "app-1",
+			MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{DeploymentId: "deployment-1"},
+		}
+		backoff = &config.OnOutOfSyncFailureBackoff{
+			Threshold:    3,
+			BaseInterval: config.Duration(10 * time.Minute),
+			MaxInterval:  config.Duration(time.Hour),
+		}
+	)
+
+	testcases := []struct {
+		name       string
+		backoff    *config.OnOutOfSyncFailureBackoff
+		failures   int
+		headCommit string
+		expected   bool
+	}{
+		{
+			name:       "not configured",
+			failures:   5,
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "below the threshold",
+			backoff:    backoff,
+			failures:   2,
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "backoff elapsed",
+			backoff:    backoff,
+			failures:   4,
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "backing off",
+			backoff:    backoff,
+			failures:   5,
+			headCommit: "commit-1",
+			expected:   false,
+		},
+		{
+			name:       "new commit arrived",
+			backoff:    backoff,
+			failures:   5,
+			headCommit: "commit-2",
+			expected:   true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			appCfg := &config.GenericApplicationSpec{
+				Trigger: config.Trigger{
+					OnOutOfSync: config.OnOutOfSync{Disabled: &enabled, FailureBackoff: tc.backoff},
+				},
+			}
+			d := NewOnOutOfSyncDeterminer(client, nil, fakeDeploymentFailureObserver(tc.failures), nil, tc.headCommit)
+			ok, _, err := d.ShouldTrigger(context.Background(), app, appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+type fakeDeploymentFailureObserver int
+
+func (o fakeDeploymentFailureObserver) Observe(_ string, _ *model.Deployment) int {
+	return int(o)
+}
+
+type fakeIdenticalManifestChecker bool
+
+func (c fakeIdenticalManifestChecker) IsIdentical(_ context.Context, _ *model.Application) bool {
+	return bool(c)
+}
+
+func TestOnOutOfSyncDeterminerSkipIdenticalManifests(t *testing.T) {
+	t.Parallel()
+
+	var (
+		enabled = false
+		client  = &fakeDependencyAPIClient{
+			deployments: map[string]*model.Deployment{
+				"deployment-1": {
+					Id:          "deployment-1",
+					Status:      model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+					Trigger:     &model.DeploymentTrigger{Commit: &model.Commit{Hash: "commit-1"}},
+					CompletedAt: time.Now().Add(-time.Hour).Unix(),
+				},
+			},
+		}
+		app = &model.Application{
+			Id:                              "app-1",
+			MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{DeploymentId: "deployment-1"},
+		}
+	)
+
+	testcases := []struct {
+		name       string
+		skip       bool
+		identical  bool
+		headCommit string
+		expected   bool
+	}{
+		{
+			name:       "not configured",
+			identical:  true,
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "identical manifests",
+			skip:       true,
+			identical:  true,
+			headCommit: "commit-1",
+			expected:   false,
+		},
+		{
+			name:       "different manifests",
+			skip:       true,
+			headCommit: "commit-1",
+			expected:   true,
+		},
+		{
+			name:       "commit changed",
+			skip:       true,
+			identical:  true,
+			headCommit: "commit-2",
+			expected:   true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			appCfg := &config.GenericApplicationSpec{
+				Trigger: config.Trigger{
+					OnOutOfSync: config.OnOutOfSync{Disabled: &enabled, SkipIdenticalManifests: tc.skip},
+				},
+			}
+			d := NewOnOutOfSyncDeterminer(client, nil, nil, fakeIdenticalManifestChecker(tc.identical), tc.headCommit)
+			ok, _, err := d.ShouldTrigger(context.Background(), app, appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ok)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// deploymentFailureCounter counts how many consecutive deployments of each application
+// have failed at the same commit.
+type deploymentFailureCounter struct {
+	mu      sync.Mutex
+	entries map[string]deploymentFailureEntry
+}
+
+type deploymentFailureEntry struct {
+	commit       string
+	deploymentID string
+	count        int
+}
+
+func newDeploymentFailureCounter() *deploymentFailureCounter {
+	return &deploymentFailureCounter{
+		entries: make(map[string]deploymentFailureEntry),
+	}
+}
+
+// Observe records the given completed deployment of the given application
+// and returns the number of consecutive failed deployments at its commit.
+// The count is reset once a deployment succeeded or was done at another commit.
+func (c *deploymentFailureCounter) Observe(applicationID string, d *model.Deployment) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := c.entries[applicationID]
+	switch d.Status {
+	case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
+		delete(c.entries, applicationID)
+		return 0
+	case model.DeploymentStatus_DEPLOYMENT_FAILURE:
+		commit := d.GetTrigger().GetCommit().GetHash()
+		if e.commit != commit {
+			e = deploymentFailureEntry{commit: commit}
+		}
+		// The same deployment is observed again at every check until a new one is triggered.
+		if e.deploymentID != d.Id {
+			e.deploymentID = d.Id
+			e.count++
+		}
+		c.entries[applicationID] = e
+		return e.count
+	default:
+		// The cancelled deployments are neither failures nor successes.
+		if e.commit != d.GetTrigger().GetCommit().GetHash() {
+			return 0
+		}
+		return e.count
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeploymentFailureCounter(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(id, commit string, status model.DeploymentStatus) *model.Deployment {
+		return &model.Deployment{
+			Id:      id,
+			Status:  status,
+			Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: commit}},
+		}
+	}
+
+	c := newDeploymentFailureCounter()
+	assert.Equal(t, 1, c.Observe("app-1", newDeployment("deployment-1", "commit-1", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+	// The same deployment is counted once.
+	assert.Equal(t, 1, c.Observe("app-1", newDeployment("deployment-1", "commit-1", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+	assert.Equal(t, 2, c.Observe("app-1", newDeployment("deployment-2", "commit-1", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+	assert.Equal(t, 2, c.Observe("app-1", newDeployment("deployment-3", "commit-1", model.DeploymentStatus_DEPLOYMENT_CANCELLED)))
+	assert.Equal(t, 1, c.Observe("app-2", newDeployment("deployment-4", "commit-1", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+
+	// The count is reset when a new commit arrived.
+	assert.Equal(t, 1, c.Observe("app-1", newDeployment("deployment-5", "commit-2", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+
+	// The count is reset once a deployment succeeded.
+	assert.Equal(t, 0, c.Observe("app-2", newDeployment("deployment-6", "commit-1", model.DeploymentStatus_DEPLOYMENT_SUCCESS)))
+	assert.Equal(t, 1, c.Observe("app-2", newDeployment("deployment-7", "commit-1", model.DeploymentStatus_DEPLOYMENT_FAILURE)))
+}
@@ -0,0 +1,422 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// This file contains the fakes of the interfaces the trigger depends on.
+// They record what the trigger did to let the tests assert
+// which deployments were created and which commands were reported.
+
+// recordingAPIClient is a fake control-plane keeping the most recent deployment of each application.
+type recordingAPIClient struct {
+	mu sync.Mutex
+	// The most recent deployments keyed by application ID.
+	mostRecent map[string]*model.ApplicationDeploymentReference
+	// The reported last handled commits keyed by application ID.
+	lastHandled map[string]*pipedservice.ReportApplicationLastHandledCommitRequest
+	// The error returned by CreateDeployment.
+	createDeploymentErr error
+	created             []*model.Deployment
+	createdChains       []*model.Deployment
+	// The reported sync states keyed by application ID.
+	syncStates map[string][]*model.ApplicationSyncState
+	// The branches of the registered branch applications keyed by application ID.
+	branchApps   map[string]string
+	unregistered []string
+	// Whether triggering is paused from the control-plane.
+	paused bool
+}
+
+func (c *recordingAPIClient) GetApplicationMostRecentDeployment(_ context.Context, req *pipedservice.GetApplicationMostRecentDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.GetApplicationMostRecentDeploymentResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.mostRecent[req.ApplicationId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "deployment is not found")
+	}
+	return &pipedservice.GetApplicationMostRecentDeploymentResponse{Deployment: d}, nil
+}
+
+func (c *recordingAPIClient) ListApplicationMostRecentDeployments(_ context.Context, req *pipedservice.ListApplicationMostRecentDeploymentsRequest, _ ...grpc.CallOption) (*pipedservice.ListApplicationMostRecentDeploymentsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deployments := make(map[string]*model.ApplicationDeploymentReference, len(req.ApplicationIds))
+	for _, id := range req.ApplicationIds {
+		if d, ok := c.mostRecent[id]; ok {
+			deployments[id] = d
+		}
+	}
+	return &pipedservice.ListApplicationMostRecentDeploymentsResponse{Deployments: deployments}, nil
+}
+
+func (c *recordingAPIClient) CreateDeployment(_ context.Context, req *pipedservice.CreateDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.CreateDeploymentResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.createDeploymentErr != nil {
+		return nil, c.createDeploymentErr
+	}
+	c.created = append(c.created, req.Deployment)
+	return &pipedservice.CreateDeploymentResponse{}, nil
+}
+
+func (c *recordingAPIClient) GetDeployment(_ context.Context, _ *pipedservice.GetDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.GetDeploymentResponse, error) {
+	return nil, status.Error(codes.NotFound, "deployment is not found")
+}
+
+func (c *recordingAPIClient) ReportApplicationMostRecentDeployment(_ context.Context, req *pipedservice.ReportApplicationMostRecentDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.ReportApplicationMostRecentDeploymentResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mostRecent == nil {
+		c.mostRecent = make(map[string]*model.ApplicationDeploymentReference)
+	}
+	c.mostRecent[req.ApplicationId] = req.Deployment
+	return &pipedservice.ReportApplicationMostRecentDeploymentResponse{}, nil
+}
+
+func (c *recordingAPIClient) ReportApplicationLastHandledCommit(_ context.Context, req *pipedservice.ReportApplicationLastHandledCommitRequest, _ ...grpc.CallOption) (*pipedservice.ReportApplicationLastHandledCommitResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lastHandled == nil {
+		c.lastHandled = make(map[string]*pipedservice.ReportApplicationLastHandledCommitRequest)
+	}
+	c.lastHandled[req.ApplicationId] = req
+	return &pipedservice.ReportApplicationLastHandledCommitResponse{}, nil
+}
+
+func (c *recordingAPIClient) CreateDeploymentChain(_ context.Context, req *pipedservice.CreateDeploymentChainRequest, _ ...grpc.CallOption) (*pipedservice.CreateDeploymentChainResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.createdChains = append(c.createdChains, req.FirstDeployment)
+	return &pipedservice.CreateDeploymentChainResponse{}, nil
+}
+
+func (c *recordingAPIClient) ReportApplicationSyncState(_ context.Context, req *pipedservice.ReportApplicationSyncStateRequest, _ ...grpc.CallOption) (*pipedservice.ReportApplicationSyncStateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.syncStates == nil {
+		c.syncStates = make(map[string][]*model.ApplicationSyncState)
+	}
+	c.syncStates[req.ApplicationId] = append(c.syncStates[req.ApplicationId], req.State)
+	return &pipedservice.ReportApplicationSyncStateResponse{}, nil
+}
+
+func (c *recordingAPIClient) RegisterBranchApplication(_ context.Context, req *pipedservice.RegisterBranchApplicationRequest, _ ...grpc.CallOption) (*pipedservice.RegisterBranchApplicationResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.branchApps == nil {
+		c.branchApps = make(map[string]string)
+	}
+	id := req.TemplateApplicationId + "-" + req.Branch
+	c.branchApps[id] = req.Branch
+	return &pipedservice.RegisterBranchApplicationResponse{ApplicationId: id}, nil
+}
+
+func (c *recordingAPIClient) UnregisterBranchApplication(_ context.Context, req *pipedservice.UnregisterBranchApplicationRequest, _ ...grpc.CallOption) (*pipedservice.UnregisterBranchApplicationResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.unregistered = append(c.unregistered, req.ApplicationId)
+	return &pipedservice.UnregisterBranchApplicationResponse{}, nil
+}
+
+func (c *recordingAPIClient) GetTriggeringPaused(_ context.Context, _ *pipedservice.GetTriggeringPausedRequest, _ ...grpc.CallOption) (*pipedservice.GetTriggeringPausedResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &pipedservice.GetTriggeringPausedResponse{Paused: c.paused}, nil
+}
+
+// SetPaused changes whether triggering is paused from the control-plane.
+func (c *recordingAPIClient) SetPaused(paused bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.paused = paused
+}
+
+// SyncStatuses returns the statuses reported for the given application so far.
+func (c *recordingAPIClient) SyncStatuses(appID string) []model.ApplicationSyncStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	statuses := make([]model.ApplicationSyncStatus, 0, len(c.syncStates[appID]))
+	for _, s := range c.syncStates[appID] {
+		statuses = append(statuses, s.Status)
+	}
+	return statuses
+}
+
+// Created returns the deployments created so far.
+func (c *recordingAPIClient) Created() []*model.Deployment {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*model.Deployment(nil), c.created...)
+}
+
+type fakeGitClient struct {
+	mu     sync.Mutex
+	cloned []gitRepoKey
+	// The repositories failing to clone.
+	failures map[string]struct{}
+	// The repositories returned by cloning keyed by repository ID.
+	repos map[string]git.Repo
+	// The branches of the remote repositories keyed by remote.
+	branches map[string][]string
+}
+
+func (c *fakeGitClient) Clone(_ context.Context, repoID, _, branch, _ string) (git.Repo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.failures[repoID]; ok {
+		return nil, errors.New("failed to clone")
+	}
+	c.cloned = append(c.cloned, gitRepoKey{repoID: repoID, branch: branch})
+	return c.repos[repoID], nil
+}
+
+func (c *fakeGitClient) ListRemoteBranches(_ context.Context, remote string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.branches[remote], nil
+}
+
+func (c *fakeGitClient) SparseClone(_ context.Context, repoID, _, branch, _ string, dirs []string) (git.Repo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cloned = append(c.cloned, gitRepoKey{repoID: repoID, branch: branch})
+	return &fakeSparseRepo{dirs: [][]string{dirs}}, nil
+}
+
+func (c *fakeGitClient) ShallowClone(_ context.Context, repoID, _, branch, _ string, _ int) (git.Repo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cloned = append(c.cloned, gitRepoKey{repoID: repoID, branch: branch})
+	return c.repos[repoID], nil
+}
+
+// fakeRepo is a Git repository checked out at path.
+type fakeRepo struct {
+	git.Repo
+	path string
+	head git.Commit
+	// The hashes of the commits before the head one in the history.
+	ancestors []string
+	// The files changed since any commit.
+	changedFiles []string
+	// The signature of the head commit.
+	signature git.CommitSignature
+	// The contents of the files keyed by the commit hash and then the file path.
+	contents map[string]map[string]string
+}
+
+func (r *fakeRepo) GetPath() string {
+	return r.path
+}
+
+func (r *fakeRepo) Pull(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *fakeRepo) GetLatestCommit(_ context.Context) (git.Commit, error) {
+	return r.head, nil
+}
+
+func (r *fakeRepo) GetCommitForRev(_ context.Context, rev string) (git.Commit, error) {
+	if rev == r.head.Hash {
+		return r.head, nil
+	}
+	for _, hash := range r.ancestors {
+		if hash == rev {
+			return git.Commit{Hash: hash}, nil
+		}
+	}
+	return git.Commit{}, errors.New("commit is not found")
+}
+
+func (r *fakeRepo) GetCommitSignature(_ context.Context, rev string) (git.CommitSignature, error) {
+	if rev != r.head.Hash {
+		return git.CommitSignature{}, errors.New("commit is not found")
+	}
+	return r.signature, nil
+}
+
+func (r *fakeRepo) ChangedFiles(_ context.Context, _, _ string) ([]string, error) {
+	return r.changedFiles, nil
+}
+
+func (r *fakeRepo) ReadFileAt(_ context.Context, rev, path string) ([]byte, error) {
+	content, ok := r.contents[rev][path]
+	if !ok {
+		return nil, git.ErrNotFound
+	}
+	return []byte(content), nil
+}
+
+// fakeHistoryRepo is a Git repository having the given commits after the first one in order.
+type fakeHistoryRepo struct {
+	*fakeRepo
+	// The hash of the commit before all of the commits.
+	first   string
+	commits []git.Commit
+	// The files changed by each commit keyed by its hash.
+	changes map[string][]string
+}
+
+func (r *fakeHistoryRepo) indexOf(hash string) int {
+	if hash == r.first {
+		return -1
+	}
+	for i, c := range r.commits {
+		if c.Hash == hash {
+			return i
+		}
+	}
+	return len(r.commits)
+}
+
+func (r *fakeHistoryRepo) ListCommitRange(_ context.Context, from, to string) ([]git.Commit, error) {
+	return r.commits[r.indexOf(from)+1 : r.indexOf(to)+1], nil
+}
+
+func (r *fakeHistoryRepo) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
+	commits, _ := r.ListCommitRange(ctx, from, to)
+	files := make([]string, 0)
+	for _, c := range commits {
+		files = append(files, r.changes[c.Hash]...)
+	}
+	return files, nil
+}
+
+type fakeApplicationLister struct {
+	apps []*model.Application
+}
+
+func (l *fakeApplicationLister) Get(id string) (*model.Application, bool) {
+	for _, app := range l.apps {
+		if app.Id == id {
+			return app, true
+		}
+	}
+	return nil, false
+}
+
+func (l *fakeApplicationLister) List() []*model.Application {
+	return l.apps
+}
+
+type fakeCommandLister struct {
+	commands []model.ReportableCommand
+}
+
+func (l *fakeCommandLister) ListApplicationCommands() []model.ReportableCommand {
+	return l.commands
+}
+
+// commandRecorder records the statuses reported by the commands it made.
+type commandRecorder struct {
+	mu sync.Mutex
+	// The reported statuses keyed by command ID.
+	reported map[string][]model.CommandStatus
+}
+
+func (r *commandRecorder) Command(cmd *model.Command) model.ReportableCommand {
+	return model.ReportableCommand{
+		Command: cmd,
+		Report: func(_ context.Context, status model.CommandStatus, _ map[string]string, _ []byte) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			if r.reported == nil {
+				r.reported = make(map[string][]model.CommandStatus)
+			}
+			r.reported[cmd.Id] = append(r.reported[cmd.Id], status)
+			return nil
+		},
+	}
+}
+
+// Reported returns the statuses reported by the given command.
+func (r *commandRecorder) Reported(id string) []model.CommandStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reported[id]
+}
+
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
+
+// testAppConfig is the minimal configuration of a KUBERNETES application.
+const testAppConfig = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+
+// newTestApplication writes the given configuration into the directory named by the given ID
+// under the repository path and returns the application registered at it in repo-1.
+func newTestApplication(t *testing.T, repoPath, id, cfg string) *model.Application {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, id), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, id, "app.pipecd.yaml"), []byte(cfg), 0600))
+	return &model.Application{
+		Id:        id,
+		Name:      id,
+		Kind:      model.ApplicationKind_KUBERNETES,
+		ProjectId: "project-1",
+		PipedId:   "piped-1",
+		GitPath: &model.ApplicationGitPath{
+			Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+			Path:           id,
+			ConfigFilename: "app.pipecd.yaml",
+		},
+	}
+}
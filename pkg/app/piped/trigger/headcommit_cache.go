@@ -0,0 +1,100 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+// headCommitCache keeps the head commit of each branch fetched recently
+// to avoid pulling the same repository again within a short period.
+// Nothing is cached when ttl is zero.
+type headCommitCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	commits map[string]cachedHeadCommit
+}
+
+type cachedHeadCommit struct {
+	commit    git.Commit
+	fetchedAt time.Time
+}
+
+func newHeadCommitCache(ttl time.Duration) *headCommitCache {
+	return &headCommitCache{
+		ttl:     ttl,
+		commits: make(map[string]cachedHeadCommit),
+	}
+}
+
+// Get returns the head commit of the given branch
+// if it was fetched within the ttl before the given time.
+func (c *headCommitCache) Get(repoID, branch string, now time.Time) (git.Commit, bool) {
+	if c.ttl <= 0 {
+		return git.Commit{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hc, ok := c.commits[headCommitCacheKey(repoID, branch)]
+	if !ok || now.Sub(hc.fetchedAt) >= c.ttl {
+		return git.Commit{}, false
+	}
+	return hc.commit, true
+}
+
+// Put records the head commit of the given branch fetched at the given time.
+func (c *headCommitCache) Put(repoID, branch string, commit git.Commit, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.commits[headCommitCacheKey(repoID, branch)] = cachedHeadCommit{
+		commit:    commit,
+		fetchedAt: now,
+	}
+}
+
+// Invalidate removes the head commit of the given branch
+// to let it be fetched again at the next time.
+func (c *headCommitCache) Invalidate(repoID, branch string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.commits, headCommitCacheKey(repoID, branch))
+}
+
+// InvalidateRepo removes the head commits of all branches of the given repository.
+func (c *headCommitCache) InvalidateRepo(repoID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	prefix := headCommitCacheKey(repoID, "")
+	for key := range c.commits {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.commits, key)
+		}
+	}
+}
+
+func headCommitCacheKey(repoID, branch string) string {
+	return repoID + "/" + branch
+}
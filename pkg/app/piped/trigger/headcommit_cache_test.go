@@ -0,0 +1,71 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+func TestHeadCommitCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Now()
+		commit = git.Commit{Hash: "hash-1"}
+	)
+
+	c := newHeadCommitCache(time.Minute)
+	_, ok := c.Get("repo-1", "main", now)
+	assert.False(t, ok)
+
+	c.Put("repo-1", "main", commit, now)
+	got, ok := c.Get("repo-1", "main", now.Add(59*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, commit, got)
+
+	// Expired.
+	_, ok = c.Get("repo-1", "main", now.Add(time.Minute))
+	assert.False(t, ok)
+
+	// Different branch of the same repository.
+	_, ok = c.Get("repo-1", "release", now)
+	assert.False(t, ok)
+
+	c.Invalidate("repo-1", "main")
+	_, ok = c.Get("repo-1", "main", now)
+	assert.False(t, ok)
+
+	c.Put("repo-1", "main", commit, now)
+	c.Put("repo-1", "release", commit, now)
+	c.Put("repo-2", "main", commit, now)
+	c.InvalidateRepo("repo-1")
+	_, ok = c.Get("repo-1", "main", now)
+	assert.False(t, ok)
+	_, ok = c.Get("repo-1", "release", now)
+	assert.False(t, ok)
+	_, ok = c.Get("repo-2", "main", now)
+	assert.True(t, ok)
+
+	// Nothing is cached when the ttl is zero.
+	c = newHeadCommitCache(0)
+	c.Put("repo-1", "main", commit, now)
+	_, ok = c.Get("repo-1", "main", now)
+	assert.False(t, ok)
+}
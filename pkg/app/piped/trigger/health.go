@@ -0,0 +1,98 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// The trigger is considered unhealthy when no tick has completed
+	// within this number of sync intervals, e.g. while a git operation hangs.
+	unhealthyTickIntervals = 5
+	// A warning is logged when a tick has been running longer than this number of sync intervals.
+	overrunTickIntervals = 2
+)
+
+// tickHealth records the time when the last tick of the trigger loop completed.
+type tickHealth struct {
+	mu       sync.RWMutex
+	lastTick time.Time
+}
+
+func (h *tickHealth) Done(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lastTick = now
+}
+
+func (h *tickHealth) LastTick() time.Time {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.lastTick
+}
+
+// LastTickTime returns the time when the last tick of the trigger loop completed.
+// Zero is returned until the loop has started.
+func (t *Trigger) LastTickTime() time.Time {
+	return t.health.LastTick()
+}
+
+// CheckHealth returns an error when no tick of the trigger loop has completed
+// within unhealthyTickIntervals times of the sync interval.
+// The trigger is considered healthy while it is cloning the repositories before starting the loop.
+func (t *Trigger) CheckHealth() error {
+	return t.checkHealth(time.Now())
+}
+
+func (t *Trigger) checkHealth(now time.Time) error {
+	last := t.health.LastTick()
+	interval := t.config.SyncInterval.Duration()
+	if last.IsZero() || interval <= 0 {
+		return nil
+	}
+	timeout := unhealthyTickIntervals * interval
+	if elapsed := now.Sub(last); elapsed > timeout {
+		return fmt.Errorf("no tick of the trigger loop has completed for %v since %s", elapsed.Round(time.Second), last.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// runTick runs the given function as one tick of the trigger loop
+// while watching it to log a warning when it overruns significantly.
+func (t *Trigger) runTick(name string, f func()) {
+	defer func() {
+		t.health.Done(time.Now())
+	}()
+	interval := t.config.SyncInterval.Duration()
+	if interval <= 0 {
+		f()
+		return
+	}
+
+	start := time.Now()
+	watchdog := time.AfterFunc(overrunTickIntervals*interval, func() {
+		t.logger.Warn(fmt.Sprintf("%s tick has been running for %v, some git operations may hang", name, time.Since(start).Round(time.Second)),
+			zap.Time("started-at", start),
+		)
+	})
+	defer watchdog.Stop()
+
+	f()
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestCheckHealth(t *testing.T) {
+	t.Parallel()
+
+	tr := &Trigger{
+		config: &config.PipedSpec{SyncInterval: config.Duration(time.Minute)},
+		health: &tickHealth{},
+		logger: zap.NewNop(),
+	}
+	now := time.Now()
+
+	// Healthy until the loop has started.
+	assert.True(t, tr.LastTickTime().IsZero())
+	assert.NoError(t, tr.checkHealth(now))
+
+	tr.runTick("test", func() {})
+	last := tr.LastTickTime()
+	assert.False(t, last.IsZero())
+	assert.NoError(t, tr.checkHealth(last.Add(5*time.Minute)))
+	assert.Error(t, tr.checkHealth(last.Add(5*time.Minute+time.Second)))
+}
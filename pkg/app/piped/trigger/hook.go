@@ -0,0 +1,133 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	defaultPreTriggerHookTimeout = 30 * time.Second
+	// The maximum length of the hook output included in the denial reason.
+	maxPreTriggerHookOutput = 512
+)
+
+// preTriggerHookRequest is the body sent to the HTTP hook.
+// The same values are passed to the command hook as the environment variables.
+type preTriggerHookRequest struct {
+	ApplicationID   string `json:"applicationId"`
+	ApplicationName string `json:"applicationName"`
+	CommitHash      string `json:"commitHash"`
+	TriggerKind     string `json:"triggerKind"`
+}
+
+// runPreTriggerHook runs the given hook and returns an error describing why the triggering was denied.
+// The hook is given up after its timeout, so a hanging hook does not block the other applications.
+func runPreTriggerHook(ctx context.Context, hook *config.PreTriggerHook, appDir string, c candidate, commit string) error {
+	timeout := hook.Timeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultPreTriggerHookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req := preTriggerHookRequest{
+		ApplicationID:   c.application.Id,
+		ApplicationName: c.application.Name,
+		CommitHash:      commit,
+		TriggerKind:     c.kind.String(),
+	}
+	if hook.URL != "" {
+		return callPreTriggerHookURL(ctx, hook.URL, req)
+	}
+	return runPreTriggerHookCommand(ctx, hook.Command, appDir, req)
+}
+
+func runPreTriggerHookCommand(ctx context.Context, command, dir string, req preTriggerHookRequest) error {
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"PIPECD_APPLICATION_ID="+req.ApplicationID,
+		"PIPECD_APPLICATION_NAME="+req.ApplicationName,
+		"PIPECD_COMMIT_HASH="+req.CommitHash,
+		"PIPECD_TRIGGER_KIND="+req.TriggerKind,
+	)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start pre-trigger hook command: %w", err)
+	}
+
+	// Waiting is done in another goroutine since the processes spawned by the command
+	// may keep running even after the command itself was killed.
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("pre-trigger hook command failed: %v, output: %s", err, truncateHookOutput(out.String()))
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("pre-trigger hook command did not finish: %w", ctx.Err())
+	}
+}
+
+func callPreTriggerHookURL(ctx context.Context, url string, req preTriggerHookRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create pre-trigger hook request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call pre-trigger hook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		out, _ := io.ReadAll(io.LimitReader(resp.Body, maxPreTriggerHookOutput))
+		return fmt.Errorf("pre-trigger hook responded with status %d: %s", resp.StatusCode, truncateHookOutput(string(out)))
+	}
+	return nil
+}
+
+func truncateHookOutput(out string) string {
+	out = strings.TrimSpace(out)
+	if len(out) > maxPreTriggerHookOutput {
+		return out[:maxPreTriggerHookOutput] + "..."
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestRunPreTriggerHookCommand(t *testing.T) {
+	c := candidate{
+		application: &model.Application{Id: "app-id", Name: "app-name"},
+		kind:        model.TriggerKind_ON_COMMIT,
+	}
+	testcases := []struct {
+		name    string
+		hook    config.PreTriggerHook
+		wantErr bool
+	}{
+		{
+			name: "allowed",
+			hook: config.PreTriggerHook{
+				Command: `test "$PIPECD_APPLICATION_ID" = app-id && test "$PIPECD_COMMIT_HASH" = hash && test "$PIPECD_TRIGGER_KIND" = ON_COMMIT`,
+			},
+		},
+		{
+			name:    "denied by non-zero exit code",
+			hook:    config.PreTriggerHook{Command: "echo frozen; exit 1"},
+			wantErr: true,
+		},
+		{
+			name: "denied by timeout",
+			hook: config.PreTriggerHook{
+				Command: "sleep 10",
+				Timeout: config.Duration(100 * time.Millisecond),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			err := runPreTriggerHook(context.Background(), &tc.hook, t.TempDir(), c, "hash")
+			assert.Equal(t, tc.wantErr, err != nil, err)
+			assert.Less(t, time.Since(start), 5*time.Second)
+		})
+	}
+}
+
+func TestRunPreTriggerHookURL(t *testing.T) {
+	c := candidate{
+		application: &model.Application{Id: "app-id", Name: "app-name"},
+		kind:        model.TriggerKind_ON_OUT_OF_SYNC,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req preTriggerHookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.ApplicationID != "app-id" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("change freeze"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := runPreTriggerHook(context.Background(), &config.PreTriggerHook{URL: server.URL}, "", c, "hash")
+	require.NoError(t, err)
+
+	c.application.Id = "frozen-app-id"
+	err = runPreTriggerHook(context.Background(), &config.PreTriggerHook{URL: server.URL}, "", c, "hash")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "change freeze")
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// identicalManifestChecker compares the manifests rendered at the head commit
+// with the live state by the drift detector of each cloud provider
+// to find out the false configuration drifts.
+type identicalManifestChecker struct {
+	detector driftDetector
+	repo     git.Repo
+	commit   git.Commit
+	logger   *zap.Logger
+}
+
+// IsIdentical reports whether no difference was found between the manifests of the given application
+// and its live state. The failures of comparing are reported as non-identical to trigger as usual.
+func (c *identicalManifestChecker) IsIdentical(ctx context.Context, app *model.Application) bool {
+	synced, err := c.detector.IsSynced(ctx, app, c.repo, c.commit)
+	if err != nil {
+		c.logger.Warn(fmt.Sprintf("failed to compare the manifests of application %s with its live state", app.Id),
+			zap.String("commit", c.commit.Hash),
+			zap.Error(err),
+		)
+		return false
+	}
+	if !synced {
+		return false
+	}
+
+	c.logger.Info(fmt.Sprintf("suppressed triggering application %s by a false configuration drift since its manifests are identical to the live state", app.Id),
+		zap.String("commit", c.commit.Hash),
+	)
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDriftDetector struct {
+	synced map[string]bool
+	err    error
+}
+
+func (d *fakeDriftDetector) IsSynced(_ context.Context, app *model.Application, _ git.Repo, _ git.Commit) (bool, error) {
+	return d.synced[app.Id], d.err
+}
+
+func TestIdenticalManifestChecker(t *testing.T) {
+	t.Parallel()
+
+	c := &identicalManifestChecker{
+		detector: &fakeDriftDetector{synced: map[string]bool{"app-1": true}},
+		commit:   git.Commit{Hash: "commit-1"},
+		logger:   zap.NewNop(),
+	}
+	assert.True(t, c.IsIdentical(context.Background(), &model.Application{Id: "app-1"}))
+	assert.False(t, c.IsIdentical(context.Background(), &model.Application{Id: "app-2"}))
+
+	// The failures of comparing must not suppress the triggering.
+	c.detector = &fakeDriftDetector{synced: map[string]bool{"app-1": true}, err: errors.New("unsupported")}
+	assert.False(t, c.IsIdentical(context.Background(), &model.Application{Id: "app-1"}))
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// ConfigError is returned by the determiners when it could not be decided
+// whether to trigger an application due to its configuration, e.g. a malformed trigger path pattern.
+// The application is marked as INVALID_CONFIG instead of notifying the failure.
+type ConfigError struct {
+	Err error
+}
+
+func (e *ConfigError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// invalidConfigMark is the application marked as INVALID_CONFIG.
+type invalidConfigMark struct {
+	reason string
+	// The sync state before being marked to be restored once the configuration became valid.
+	prev *model.ApplicationSyncState
+}
+
+// invalidConfigStore keeps the applications marked as INVALID_CONFIG by the trigger.
+type invalidConfigStore struct {
+	mu    sync.Mutex
+	marks map[string]invalidConfigMark
+}
+
+func newInvalidConfigStore() *invalidConfigStore {
+	return &invalidConfigStore{
+		marks: make(map[string]invalidConfigMark),
+	}
+}
+
+// Mark records the given application as marked with the given reason.
+// False is returned when it was already marked with the same reason.
+func (s *invalidConfigStore) Mark(app *model.Application, reason string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.marks[app.Id]
+	if ok && m.reason == reason {
+		return false
+	}
+	if !ok {
+		m.prev = app.SyncState
+	}
+	m.reason = reason
+	s.marks[app.Id] = m
+	return true
+}
+
+// Unmark removes the mark of the given application and returns its sync state before being marked.
+func (s *invalidConfigStore) Unmark(appID string) (*model.ApplicationSyncState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.marks[appID]
+	if !ok {
+		return nil, false
+	}
+	delete(s.marks, appID)
+	return m.prev, true
+}
+
+// markInvalidConfig sets the sync status of the given application to INVALID_CONFIG
+// to let the users know its configuration must be fixed.
+func (t *Trigger) markInvalidConfig(ctx context.Context, app *model.Application, reason string) {
+	if !t.invalidConfigs.Mark(app, reason) {
+		return
+	}
+	state := &model.ApplicationSyncState{
+		Status:           model.ApplicationSyncStatus_INVALID_CONFIG,
+		ShortReason:      "Invalid application configuration",
+		Reason:           reason,
+		HeadDeploymentId: app.GetSyncState().GetHeadDeploymentId(),
+		Timestamp:        time.Now().Unix(),
+	}
+	t.reportSyncState(ctx, app, state)
+}
+
+// unmarkInvalidConfig restores the sync state of the given application marked as INVALID_CONFIG
+// since its configuration became valid. The unknown status is reported when the previous one is not known,
+// e.g. it was marked before restarting piped, and will be updated by the drift detector.
+func (t *Trigger) unmarkInvalidConfig(ctx context.Context, app *model.Application) {
+	prev, ok := t.invalidConfigs.Unmark(app.Id)
+	if !ok && app.GetSyncState().GetStatus() != model.ApplicationSyncStatus_INVALID_CONFIG {
+		return
+	}
+	state := &model.ApplicationSyncState{
+		Status:           model.ApplicationSyncStatus_UNKNOWN,
+		HeadDeploymentId: app.GetSyncState().GetHeadDeploymentId(),
+	}
+	if prev != nil && prev.Status != model.ApplicationSyncStatus_INVALID_CONFIG {
+		state = proto.Clone(prev).(*model.ApplicationSyncState)
+	}
+	state.Timestamp = time.Now().Unix()
+	t.reportSyncState(ctx, app, state)
+}
+
+func (t *Trigger) reportSyncState(ctx context.Context, app *model.Application, state *model.ApplicationSyncState) {
+	_, err := t.apiClient.ReportApplicationSyncState(ctx, &pipedservice.ReportApplicationSyncStateRequest{
+		ApplicationId: app.Id,
+		State:         state,
+	})
+	if err != nil {
+		t.logger.Error("failed to report application sync state",
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.String("status", state.Status.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestConfigError(t *testing.T) {
+	t.Parallel()
+
+	inner := fmt.Errorf("malformed pattern")
+	err := fmt.Errorf("failed to determine: %w", &ConfigError{Err: inner})
+
+	var cfgErr *ConfigError
+	assert.ErrorAs(t, err, &cfgErr)
+	assert.ErrorIs(t, err, inner)
+	assert.Equal(t, "malformed pattern", cfgErr.Error())
+}
+
+func TestInvalidConfigStore(t *testing.T) {
+	t.Parallel()
+
+	s := newInvalidConfigStore()
+	app := &model.Application{
+		Id:        "app-1",
+		SyncState: &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_SYNCED},
+	}
+
+	_, ok := s.Unmark("app-1")
+	assert.False(t, ok)
+
+	assert.True(t, s.Mark(app, "reason-1"))
+	assert.False(t, s.Mark(app, "reason-1"))
+	// The sync state before being marked first is kept.
+	app.SyncState = &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_INVALID_CONFIG}
+	assert.True(t, s.Mark(app, "reason-2"))
+
+	prev, ok := s.Unmark("app-1")
+	assert.True(t, ok)
+	assert.Equal(t, model.ApplicationSyncStatus_SYNCED, prev.Status)
+
+	_, ok = s.Unmark("app-1")
+	assert.False(t, ok)
+}
+
+func TestMarkInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		syncState        *model.ApplicationSyncState
+		expectedStatuses []model.ApplicationSyncStatus
+	}{
+		{
+			name:      "previous status is restored",
+			syncState: &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_OUT_OF_SYNC},
+			expectedStatuses: []model.ApplicationSyncStatus{
+				model.ApplicationSyncStatus_INVALID_CONFIG,
+				model.ApplicationSyncStatus_OUT_OF_SYNC,
+			},
+		},
+		{
+			name: "unknown status is reported when previous status is not known",
+			expectedStatuses: []model.ApplicationSyncStatus{
+				model.ApplicationSyncStatus_INVALID_CONFIG,
+				model.ApplicationSyncStatus_UNKNOWN,
+			},
+		},
+		{
+			name:      "unknown status is reported when marked before restarting",
+			syncState: &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_INVALID_CONFIG},
+			expectedStatuses: []model.ApplicationSyncStatus{
+				model.ApplicationSyncStatus_INVALID_CONFIG,
+				model.ApplicationSyncStatus_UNKNOWN,
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx       = context.Background()
+				apiClient = &recordingAPIClient{}
+				tr        = &Trigger{
+					apiClient:      apiClient,
+					invalidConfigs: newInvalidConfigStore(),
+					logger:         zap.NewNop(),
+				}
+				app = &model.Application{Id: "app-1", SyncState: tc.syncState}
+			)
+
+			tr.markInvalidConfig(ctx, app, "invalid trigger.onCommit.paths")
+			// The same failure is reported once.
+			tr.markInvalidConfig(ctx, app, "invalid trigger.onCommit.paths")
+			tr.unmarkInvalidConfig(ctx, app)
+			// Nothing is reported for the application not marked.
+			tr.unmarkInvalidConfig(ctx, &model.Application{Id: "app-2"})
+
+			assert.Equal(t, tc.expectedStatuses, apiClient.SyncStatuses("app-1"))
+			assert.Empty(t, apiClient.SyncStatuses("app-2"))
+		})
+	}
+}
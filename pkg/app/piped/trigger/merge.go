@@ -0,0 +1,117 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// candidatePriority returns the priority of the given candidate while merging, lower is higher.
+// The candidates having a command take priority so that their command can be reported
+// and the sync strategy specified by the user is used.
+// The commit ones come next to keep using the configured pipeline for the new changes,
+// since an application is usually at OUT_OF_SYNC state until its new commit is deployed.
+func candidatePriority(c candidate) int {
+	switch {
+	case c.HasCommand():
+		return 0
+	case c.kind == model.TriggerKind_ON_COMMIT:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// mergeCandidates merges the candidates of the same application into one
+// to avoid triggering multiple deployments for that application.
+// The candidate with the highest priority is kept and carries the kinds of the others.
+// The candidates having a command are never merged into each other
+// since only one command can be carried by a candidate.
+func mergeCandidates(cs []candidate) []candidate {
+	var (
+		groups = make(map[string][]candidate, len(cs))
+		appIDs = make([]string, 0, len(cs))
+	)
+	for _, c := range cs {
+		id := c.application.Id
+		if _, ok := groups[id]; !ok {
+			appIDs = append(appIDs, id)
+		}
+		groups[id] = append(groups[id], c)
+	}
+
+	merged := make([]candidate, 0, len(appIDs))
+	for _, id := range appIDs {
+		group := groups[id]
+		if len(group) == 1 {
+			merged = append(merged, group[0])
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return candidatePriority(group[i]) < candidatePriority(group[j])
+		})
+
+		var (
+			primary = group[0]
+			kinds   = map[model.TriggerKind]struct{}{primary.kind: {}}
+			extras  []candidate
+		)
+		for _, c := range group[1:] {
+			if c.HasCommand() {
+				extras = append(extras, c)
+				continue
+			}
+			if _, ok := kinds[c.kind]; ok {
+				continue
+			}
+			kinds[c.kind] = struct{}{}
+			primary.mergedKinds = append(primary.mergedKinds, c.kind)
+		}
+		merged = append(merged, primary)
+		merged = append(merged, extras...)
+	}
+	return merged
+}
+
+// determineCandidate checks the kinds of the given candidate in order
+// and returns the candidate of the first kind that should be triggered
+// with the reason to trigger it.
+// When no kind should be triggered, the reasons of all kinds are returned instead.
+func determineCandidate(ctx context.Context, ds *determiners, c candidate, appCfg *config.GenericApplicationSpec) (bool, candidate, error) {
+	reasons := make([]string, 0, len(c.mergedKinds)+1)
+	for _, k := range c.Kinds() {
+		ok, reason, err := ds.Determiner(k).ShouldTrigger(ctx, c.application, appCfg)
+		if err != nil {
+			return false, c, err
+		}
+		if ok {
+			c.kind = k
+			c.reason = reason
+			c.shouldTrigger = true
+			return true, c, nil
+		}
+		if reason != "" {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", k, reason))
+		}
+	}
+	c.reason = strings.Join(reasons, "; ")
+	return false, c, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMergeCandidates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app1 = &model.Application{Id: "app-1"}
+		app2 = &model.Application{Id: "app-2"}
+		cmd1 = model.ReportableCommand{Command: &model.Command{Id: "cmd-1"}}
+		cmd2 = model.ReportableCommand{Command: &model.Command{Id: "cmd-2"}}
+	)
+
+	got := mergeCandidates([]candidate{
+		{application: app1, kind: model.TriggerKind_ON_COMMIT},
+		{application: app2, kind: model.TriggerKind_ON_COMMIT},
+		{application: app1, kind: model.TriggerKind_ON_OUT_OF_SYNC},
+		{application: app2, kind: model.TriggerKind_ON_OUT_OF_SYNC},
+		{application: app1, kind: model.TriggerKind_ON_COMMAND, command: cmd1},
+		{application: app1, kind: model.TriggerKind_ON_CHAIN, command: cmd2},
+	})
+
+	expected := []candidate{
+		{
+			application: app1,
+			kind:        model.TriggerKind_ON_COMMAND,
+			command:     cmd1,
+			mergedKinds: []model.TriggerKind{model.TriggerKind_ON_COMMIT, model.TriggerKind_ON_OUT_OF_SYNC},
+		},
+		{application: app1, kind: model.TriggerKind_ON_CHAIN, command: cmd2},
+		{
+			application: app2,
+			kind:        model.TriggerKind_ON_COMMIT,
+			mergedKinds: []model.TriggerKind{model.TriggerKind_ON_OUT_OF_SYNC},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestDetermineCandidate(t *testing.T) {
+	t.Parallel()
+
+	c := candidate{
+		application: &model.Application{Id: "app-1"},
+		kind:        model.TriggerKind_ON_COMMIT,
+		mergedKinds: []model.TriggerKind{model.TriggerKind_ON_OUT_OF_SYNC},
+	}
+
+	ds := &determiners{
+		onCommit:    &fakeDeterminer{result: false},
+		onOutOfSync: &fakeDeterminer{result: true, reason: "detected a configuration drift"},
+	}
+	ok, got, err := determineCandidate(context.Background(), ds, c, &config.GenericApplicationSpec{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, model.TriggerKind_ON_OUT_OF_SYNC, got.kind)
+	assert.Equal(t, "detected a configuration drift", got.Reason())
+	assert.True(t, got.shouldTrigger)
+
+	// The reasons of all kinds are returned when no kind should be triggered.
+	ds = &determiners{
+		onCommit:    &fakeDeterminer{result: false, reason: "no new commit"},
+		onOutOfSync: &fakeDeterminer{result: false, reason: "the application is pinned"},
+	}
+	ok, got, err = determineCandidate(context.Background(), ds, c, &config.GenericApplicationSpec{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, got.shouldTrigger)
+	assert.Equal(t, "ON_COMMIT: no new commit; ON_OUT_OF_SYNC: the application is pinned", got.reason)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// applicationRepoStore keeps the repository branch where each application was found at its previous check.
+type applicationRepoStore struct {
+	mu   sync.Mutex
+	keys map[string]gitRepoKey
+}
+
+func newApplicationRepoStore() *applicationRepoStore {
+	return &applicationRepoStore{
+		keys: make(map[string]gitRepoKey),
+	}
+}
+
+// Update records the repository branch of the given application
+// and returns the previous one if the application was moved from it.
+func (s *applicationRepoStore) Update(appID string, key gitRepoKey) (gitRepoKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.keys[appID]
+	s.keys[appID] = key
+	return prev, ok && prev.repoID != key.repoID
+}
+
+// resetMovedApplications detects the applications moved from another repository since their previous check
+// and resets their last triggered commits not found in the new repository,
+// to let them be evaluated again from scratch instead of comparing with the commit of the old repository.
+func (t *Trigger) resetMovedApplications(ctx context.Context, key gitRepoKey, repo git.Repo, cs []candidate) {
+	for _, c := range cs {
+		app := c.application
+		prev, moved := t.appRepos.Update(app.Id, key)
+		if !moved || c.kind != model.TriggerKind_ON_COMMIT {
+			continue
+		}
+		logger := t.logger.With(
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.String("from-repo", prev.repoID),
+			zap.String("to-repo", key.repoID),
+		)
+		logger.Info(fmt.Sprintf("application %s was moved from repo %s to repo %s", app.Name, prev.repoID, key.repoID))
+
+		commit, err := t.commitStore.Get(ctx, app.Id)
+		if err != nil {
+			logger.Warn("failed to get last triggered commit of the moved application", zap.Error(err))
+			continue
+		}
+		if commit == "" {
+			continue
+		}
+		if _, err := repo.GetCommitForRev(ctx, commit); err == nil {
+			continue
+		}
+		if err := t.commitStore.Put(app.Id, ""); err != nil {
+			logger.Error("failed to reset last triggered commit of the moved application", zap.Error(err))
+			continue
+		}
+		logger.Info(fmt.Sprintf("reset the last triggered commit %s of application %s since it was not found in the new repository", commit, app.Name))
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// fakeUnrelatedHistoryRepo is a Git repository unable to list the changes since the commits not in its history.
+type fakeUnrelatedHistoryRepo struct {
+	*fakeRepo
+}
+
+func (r *fakeUnrelatedHistoryRepo) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
+	if _, err := r.GetCommitForRev(ctx, from); err != nil {
+		return nil, errors.New("bad object")
+	}
+	return r.fakeRepo.ChangedFiles(ctx, from, to)
+}
+
+func TestApplicationRepoStoreUpdate(t *testing.T) {
+	t.Parallel()
+
+	s := newApplicationRepoStore()
+	_, moved := s.Update("app-1", gitRepoKey{repoID: "repo-1", branch: "main"})
+	assert.False(t, moved)
+
+	// Switching the branch in the same repository is not a move.
+	_, moved = s.Update("app-1", gitRepoKey{repoID: "repo-1", branch: "release"})
+	assert.False(t, moved)
+
+	prev, moved := s.Update("app-1", gitRepoKey{repoID: "repo-2", branch: "main"})
+	assert.True(t, moved)
+	assert.Equal(t, gitRepoKey{repoID: "repo-1", branch: "release"}, prev)
+
+	_, moved = s.Update("app-1", gitRepoKey{repoID: "repo-2", branch: "main"})
+	assert.False(t, moved)
+}
+
+func TestOnCommitDeterminerUnknownLastTriggeredCommit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeUnrelatedHistoryRepo{&fakeRepo{
+			head:         git.Commit{Hash: "commit-3"},
+			ancestors:    []string{"commit-2"},
+			changedFiles: []string{"another/deployment.yaml"},
+		}}
+		cg = fakeLastTriggeredCommitGetter{
+			"app-1": "commit-2",
+			// This was triggered in the repository where the application was moved from.
+			"app-2": "other-commit-1",
+		}
+		appCfg = &config.GenericApplicationSpec{}
+		ctx    = context.Background()
+		d      = NewOnCommitDeterminer(repo, "commit-3", false, false, cg, zap.NewNop())
+	)
+	app := func(id string) *model.Application {
+		return &model.Application{Id: id, GitPath: &model.ApplicationGitPath{Path: "app"}}
+	}
+
+	ok, _, err := d.ShouldTrigger(ctx, app("app-1"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, reason, err := d.ShouldTrigger(ctx, app("app-2"), appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "the last triggered commit other-commit-1 was not found in the repository, commit: commit-3", reason)
+}
+
+func TestCheckCandidatesOnMovedApplication(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app-1"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app-1", "app.pipecd.yaml"), []byte(appCfg), 0600))
+
+	var (
+		app = &model.Application{
+			Id:        "app-1",
+			Name:      "app-1",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app-1",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		ac = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+			"app-1": newDeploymentReference("deployment-1", "commit-1"),
+		}}
+		gc = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{
+				path:      repoPath,
+				head:      git.Commit{Hash: "commit-1"},
+				ancestors: []string{"commit-0"},
+			},
+			// The new repository does not have the history of the old one.
+			"repo-2": &fakeRepo{
+				path:         repoPath,
+				head:         git.Commit{Hash: "other-commit-2"},
+				ancestors:    []string{"other-commit-1"},
+				changedFiles: []string{"another/deployment.yaml"},
+			},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID: "project-1",
+			PipedID:   "piped-1",
+			Repositories: []config.PipedRepository{
+				{RepoID: "repo-1", Branch: "main"},
+				{RepoID: "repo-2", Branch: "main"},
+			},
+		}
+		ctx = context.Background()
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// Nothing to deploy in the old repository.
+	require.NoError(t, tr.checkCandidates(ctx, []candidate{{application: app, kind: model.TriggerKind_ON_COMMIT}}))
+	assert.Empty(t, ac.Created())
+
+	// The moved application is triggered at the head commit of the new repository
+	// even though no file was changed since its last triggered commit.
+	moved := proto.Clone(app).(*model.Application)
+	moved.GitPath = &model.ApplicationGitPath{
+		Repo:           &model.ApplicationGitRepository{Id: "repo-2", Remote: "git@github.com:org/repo-2.git", Branch: "main"},
+		Path:           "app-1",
+		ConfigFilename: "app.pipecd.yaml",
+	}
+	require.NoError(t, tr.checkCandidates(ctx, []candidate{{application: moved, kind: model.TriggerKind_ON_COMMIT}}))
+	require.Len(t, ac.Created(), 1)
+	assert.Equal(t, "other-commit-2", ac.Created()[0].Trigger.Commit.Hash)
+}
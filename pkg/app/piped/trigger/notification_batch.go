@@ -0,0 +1,123 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// triggeredNotificationBatch collects the deployments triggered while checking each repository branch
+// to notify them at once after the check.
+type triggeredNotificationBatch struct {
+	mu      sync.Mutex
+	entries map[gitRepoKey][]batchedDeployment
+}
+
+type batchedDeployment struct {
+	deployment *model.Deployment
+	mentions   []string
+	changes    string
+}
+
+func newTriggeredNotificationBatch() *triggeredNotificationBatch {
+	return &triggeredNotificationBatch{
+		entries: make(map[gitRepoKey][]batchedDeployment),
+	}
+}
+
+// Add collects the given triggered deployment of the given repository branch.
+func (b *triggeredNotificationBatch) Add(key gitRepoKey, d batchedDeployment) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries[key] = append(b.entries[key], d)
+}
+
+// Take returns the deployments collected for the given repository branch in order
+// and removes them from the batch.
+func (b *triggeredNotificationBatch) Take(key gitRepoKey) []batchedDeployment {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ds := b.entries[key]
+	delete(b.entries, key)
+	return ds
+}
+
+type deploymentGroupKey struct {
+	commit string
+	value  string
+}
+
+// flushDeploymentTriggeredNotifications notifies the deployments collected for the given repository branch
+// grouped by their commit and the value of the configured label.
+// The group having only one deployment is notified as usual to keep its changes summary.
+func (t *Trigger) flushDeploymentTriggeredNotifications(key gitRepoKey) {
+	ds := t.triggeredBatch.Take(key)
+	if len(ds) == 0 {
+		return
+	}
+
+	var label string
+	if b := t.config.Notifications.DeploymentTriggeredBatch; b != nil {
+		label = b.GroupByLabel
+	}
+
+	var (
+		groups = make(map[deploymentGroupKey][]batchedDeployment)
+		keys   = make([]deploymentGroupKey, 0)
+	)
+	for _, d := range ds {
+		k := deploymentGroupKey{commit: d.deployment.Trigger.Commit.Hash}
+		if label != "" {
+			k.value = d.deployment.Labels[label]
+		}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], d)
+	}
+
+	for _, k := range keys {
+		g := groups[k]
+		if len(g) == 1 {
+			t.notifySingleDeploymentTriggered(g[0].deployment, g[0].mentions, g[0].changes)
+			continue
+		}
+		md := &model.NotificationEventDeploymentsTriggered{
+			Deployments: make([]*model.Deployment, 0, len(g)),
+			CommitHash:  k.commit,
+			GroupLabel:  label,
+			GroupValue:  k.value,
+		}
+		mentioned := make(map[string]struct{})
+		for _, d := range g {
+			md.Deployments = append(md.Deployments, d.deployment)
+			for _, m := range d.mentions {
+				if _, ok := mentioned[m]; ok {
+					continue
+				}
+				mentioned[m] = struct{}{}
+				md.MentionedAccounts = append(md.MentionedAccounts, m)
+			}
+		}
+		t.notifier.Notify(model.NotificationEvent{
+			Type:     model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+			Metadata: md,
+		})
+	}
+}
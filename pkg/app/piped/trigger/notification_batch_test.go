@@ -0,0 +1,188 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestFlushDeploymentTriggeredNotifications(t *testing.T) {
+	t.Parallel()
+
+	newDeployment := func(id, commit, team string) *model.Deployment {
+		return &model.Deployment{
+			Id:      id,
+			Labels:  map[string]string{"team": team},
+			Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: commit}},
+		}
+	}
+	var (
+		key = gitRepoKey{repoID: "repo-1", branch: "main"}
+		d1  = newDeployment("deployment-1", "commit-1", "a")
+		d2  = newDeployment("deployment-2", "commit-1", "b")
+		d3  = newDeployment("deployment-3", "commit-1", "a")
+		d4  = newDeployment("deployment-4", "commit-2", "a")
+	)
+	testcases := []struct {
+		name     string
+		batch    *config.NotificationDeploymentTriggeredBatch
+		expected []model.NotificationEvent
+	}{
+		{
+			name:  "grouped by the commit",
+			batch: &config.NotificationDeploymentTriggeredBatch{},
+			expected: []model.NotificationEvent{
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentsTriggered{
+						Deployments:       []*model.Deployment{d1, d2, d3},
+						CommitHash:        "commit-1",
+						MentionedAccounts: []string{"user-1", "user-2"},
+					},
+				},
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentTriggered{
+						Deployment:     d4,
+						ChangesSummary: "changes-4",
+					},
+				},
+			},
+		},
+		{
+			name:  "grouped by the commit and the label",
+			batch: &config.NotificationDeploymentTriggeredBatch{GroupByLabel: "team"},
+			expected: []model.NotificationEvent{
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentsTriggered{
+						Deployments:       []*model.Deployment{d1, d3},
+						CommitHash:        "commit-1",
+						GroupLabel:        "team",
+						GroupValue:        "a",
+						MentionedAccounts: []string{"user-1"},
+					},
+				},
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentTriggered{
+						Deployment:        d2,
+						MentionedAccounts: []string{"user-2"},
+						ChangesSummary:    "changes-2",
+					},
+				},
+				{
+					Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+					Metadata: &model.NotificationEventDeploymentTriggered{
+						Deployment:     d4,
+						ChangesSummary: "changes-4",
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := &fakeNotifier{}
+			tr := &Trigger{
+				config: &config.PipedSpec{
+					Notifications: config.Notifications{DeploymentTriggeredBatch: tc.batch},
+				},
+				notifier:       n,
+				triggeredBatch: newTriggeredNotificationBatch(),
+				logger:         zap.NewNop(),
+			}
+			tr.triggeredBatch.Add(key, batchedDeployment{deployment: d1, mentions: []string{"user-1"}, changes: "changes-1"})
+			tr.triggeredBatch.Add(key, batchedDeployment{deployment: d2, mentions: []string{"user-2"}, changes: "changes-2"})
+			tr.triggeredBatch.Add(key, batchedDeployment{deployment: d3, mentions: []string{"user-1"}, changes: "changes-3"})
+			tr.triggeredBatch.Add(key, batchedDeployment{deployment: d4, changes: "changes-4"})
+
+			tr.flushDeploymentTriggeredNotifications(key)
+			assert.Equal(t, tc.expected, n.events)
+
+			// The flushed deployments are not notified again.
+			tr.flushDeploymentTriggeredNotifications(key)
+			assert.Len(t, n.events, len(tc.expected))
+		})
+	}
+}
+
+func TestCheckCandidatesBatchingDeploymentTriggeredNotifications(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id, team string) *model.Application {
+		app := newTestApplication(t, repoPath, id, testAppConfig)
+		app.Labels = map[string]string{"team": team}
+		return app
+	}
+	var (
+		apps = []*model.Application{newApp("app-1", "a"), newApp("app-2", "a"), newApp("app-3", "b")}
+		cs   = []candidate{
+			{application: apps[0], kind: model.TriggerKind_ON_COMMIT},
+			{application: apps[1], kind: model.TriggerKind_ON_COMMIT},
+			{application: apps[2], kind: model.TriggerKind_ON_COMMIT},
+		}
+		ac   = &recordingAPIClient{}
+		repo = &fakeRepo{
+			path:         repoPath,
+			head:         git.Commit{Hash: "commit-1"},
+			changedFiles: []string{"app-1/deployment.yaml", "app-2/deployment.yaml", "app-3/deployment.yaml"},
+		}
+		gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+		n   = &fakeNotifier{}
+		cfg = &config.PipedSpec{
+			ProjectID:              "project-1",
+			PipedID:                "piped-1",
+			Repositories:           []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			MaxApplicationsPerRepo: 1000,
+			Notifications: config.Notifications{
+				DeploymentTriggeredBatch: &config.NotificationDeploymentTriggeredBatch{GroupByLabel: "team"},
+			},
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: apps}, nil, n, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+	require.Len(t, ac.Created(), 3)
+	require.Len(t, n.events, 2)
+
+	assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENTS_TRIGGERED, n.events[0].Type)
+	md, ok := n.events[0].Metadata.(*model.NotificationEventDeploymentsTriggered)
+	require.True(t, ok)
+	assert.Equal(t, "commit-1", md.CommitHash)
+	assert.Equal(t, map[string]string{"team": "a"}, md.GetLabels())
+	require.Len(t, md.Deployments, 2)
+	assert.Equal(t, "app-1", md.Deployments[0].ApplicationId)
+	assert.Equal(t, "app-2", md.Deployments[1].ApplicationId)
+
+	assert.Equal(t, model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED, n.events[1].Type)
+	single, ok := n.events[1].Metadata.(*model.NotificationEventDeploymentTriggered)
+	require.True(t, ok)
+	assert.Equal(t, "app-3", single.Deployment.ApplicationId)
+}
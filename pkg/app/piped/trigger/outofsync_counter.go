@@ -0,0 +1,55 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// outOfSyncCounter counts how many consecutive checks each application has been at OUT_OF_SYNC state.
+type outOfSyncCounter struct {
+	mu     sync.RWMutex
+	counts map[string]int
+}
+
+func newOutOfSyncCounter() *outOfSyncCounter {
+	return &outOfSyncCounter{
+		counts: make(map[string]int),
+	}
+}
+
+// Observe counts up the given applications staying at OUT_OF_SYNC state
+// and resets the count of the other ones.
+func (c *outOfSyncCounter) Observe(apps []*model.Application) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, app := range apps {
+		if app.SyncState != nil && app.SyncState.Status == model.ApplicationSyncStatus_OUT_OF_SYNC {
+			c.counts[app.Id]++
+			continue
+		}
+		delete(c.counts, app.Id)
+	}
+}
+
+// Get returns the number of consecutive checks the given application has been at OUT_OF_SYNC state.
+func (c *outOfSyncCounter) Get(applicationID string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.counts[applicationID]
+}
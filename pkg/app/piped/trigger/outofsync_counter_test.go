@@ -0,0 +1,54 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestOutOfSyncCounter(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id string, status model.ApplicationSyncStatus) *model.Application {
+		return &model.Application{
+			Id:        id,
+			SyncState: &model.ApplicationSyncState{Status: status},
+		}
+	}
+
+	c := newOutOfSyncCounter()
+	c.Observe([]*model.Application{
+		newApp("app-1", model.ApplicationSyncStatus_OUT_OF_SYNC),
+		newApp("app-2", model.ApplicationSyncStatus_SYNCED),
+	})
+	c.Observe([]*model.Application{
+		newApp("app-1", model.ApplicationSyncStatus_OUT_OF_SYNC),
+		newApp("app-2", model.ApplicationSyncStatus_OUT_OF_SYNC),
+	})
+	assert.Equal(t, 2, c.Get("app-1"))
+	assert.Equal(t, 1, c.Get("app-2"))
+	assert.Equal(t, 0, c.Get("app-3"))
+
+	// The count is reset once the application became synced.
+	c.Observe([]*model.Application{
+		newApp("app-1", model.ApplicationSyncStatus_SYNCED),
+	})
+	assert.Equal(t, 0, c.Get("app-1"))
+	assert.Equal(t, 1, c.Get("app-2"))
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
+)
+
+// recoverCandidatePanic recovers from the panic raised while handling the given candidate,
+// e.g. by a bug of a determiner or the Git operations for an unusual repository,
+// so that one bad application does not stop triggering the others.
+// This must be called directly by defer.
+func (t *Trigger) recoverCandidatePanic(ctx context.Context, key gitRepoKey, c candidate) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	app := c.application
+	msg := fmt.Sprintf("recovered from a panic while handling application %s: %v", app.Name, r)
+	t.logger.Error(msg,
+		zap.String("app-id", app.Id),
+		zap.String("repo-id", key.repoID),
+		zap.String("branch", key.branch),
+		zap.String("kind", c.kind.String()),
+		zap.Stack("stack"),
+	)
+	t.reportCommandFailed(ctx, c, msg)
+	t.auditDecision(c, key, "", "", msg)
+	triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonPanic)
+}
+
+// recoverRepoPanic recovers from the panic raised while checking the given repository branch
+// outside of handling each candidate, e.g. while pulling it, and returns it via err
+// so that the worker keeps checking the other repositories.
+// This must be called directly by defer.
+func (t *Trigger) recoverRepoPanic(key gitRepoKey, cs []candidate, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	msg := fmt.Sprintf("recovered from a panic while checking applications in repo %s: %v", key.repoID, r)
+	t.logger.Error(msg,
+		zap.String("repo-id", key.repoID),
+		zap.String("branch", key.branch),
+		zap.Stack("stack"),
+	)
+	t.reportDeploymentCreationFailures(cs, triggermetrics.FailureReasonPanic)
+	*err = errors.New(msg)
+}
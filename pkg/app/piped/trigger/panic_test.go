@@ -0,0 +1,143 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// panickingRepo is a Git repository panicking when looking up any commit.
+type panickingRepo struct {
+	*fakeRepo
+}
+
+func (r *panickingRepo) GetCommitForRev(_ context.Context, _ string) (git.Commit, error) {
+	panic("unexpected lookup")
+}
+
+// panickingPullRepo is a Git repository panicking when pulled.
+type panickingPullRepo struct {
+	*fakeRepo
+}
+
+func (r *panickingPullRepo) Pull(_ context.Context, _ string) error {
+	panic("unexpected pull")
+}
+
+func TestCheckCandidatesRecoverPanic(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id, cfg string) *model.Application {
+		return newTestApplication(t, repoPath, id, cfg)
+	}
+	var (
+		// The determiner of the first application panics by looking up the head commit.
+		apps = []*model.Application{
+			newApp("app-1", `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app-1
+  trigger:
+    onCommit:
+      mergeCommitsOnly: true
+`),
+			newApp("app-2", `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app-2
+`),
+		}
+		cs = []candidate{
+			{application: apps[0], kind: model.TriggerKind_ON_COMMIT},
+			{application: apps[1], kind: model.TriggerKind_ON_COMMIT},
+		}
+		ac   = &recordingAPIClient{}
+		repo = &panickingRepo{fakeRepo: &fakeRepo{
+			path: repoPath,
+			head: git.Commit{Hash: "commit-1"},
+		}}
+		gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+		cfg = &config.PipedSpec{
+			ProjectID:              "project-1",
+			PipedID:                "piped-1",
+			Repositories:           []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			MaxApplicationsPerRepo: 1000,
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: apps}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// The other application is still triggered after the panic.
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+	require.Len(t, ac.Created(), 1)
+	assert.Equal(t, "app-2", ac.Created()[0].ApplicationId)
+
+	d, ok := tr.decisions.Get("app-1")
+	require.True(t, ok)
+	assert.Contains(t, d.Reason, "recovered from a panic while handling application app-1: unexpected lookup")
+}
+
+func TestCheckCandidatesRecoverRepoPanic(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repoPath1 = t.TempDir()
+		repoPath2 = t.TempDir()
+		app1      = newTestApplication(t, repoPath1, "app-1", testAppConfig)
+		app2      = newTestApplication(t, repoPath2, "app-2", testAppConfig)
+	)
+	app2.GitPath.Repo = &model.ApplicationGitRepository{Id: "repo-2", Remote: "git@github.com:org/repo-2.git", Branch: "main"}
+	var (
+		cs = []candidate{
+			{application: app2, kind: model.TriggerKind_ON_COMMIT},
+			{application: app1, kind: model.TriggerKind_ON_COMMIT},
+		}
+		ac = &recordingAPIClient{}
+		gc = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath1, head: git.Commit{Hash: "commit-1"}},
+			"repo-2": &panickingPullRepo{fakeRepo: &fakeRepo{path: repoPath2, head: git.Commit{Hash: "commit-1"}}},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID: "project-1",
+			PipedID:   "piped-1",
+			Repositories: []config.PipedRepository{
+				{RepoID: "repo-1", Branch: "main"},
+				{RepoID: "repo-2", Branch: "main"},
+			},
+			MaxApplicationsPerRepo: 1000,
+			// The panicking repository is checked by the same worker as the other one.
+			TriggerConcurrency: 1,
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app1, app2}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// The other repository is still checked after the panic, which is returned as an error.
+	err = tr.checkCandidates(context.Background(), cs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "recovered from a panic while checking applications in repo repo-2: unexpected pull")
+	require.Len(t, ac.Created(), 1)
+	assert.Equal(t, "app-1", ac.Created()[0].ApplicationId)
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+)
+
+// triggeringPause keeps the most recently fetched state of whether triggering is paused
+// to be used while the control-plane is unreachable.
+type triggeringPause struct {
+	mu     sync.Mutex
+	paused bool
+	// Whether the control-plane does not support pausing.
+	unsupported bool
+}
+
+// Get returns the most recently fetched state.
+func (p *triggeringPause) Get() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// Set updates the state and reports whether it was changed.
+func (p *triggeringPause) Set(paused bool) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	changed := p.paused != paused
+	p.paused = paused
+	return changed
+}
+
+// Unsupported reports whether the control-plane was found not to support pausing.
+func (p *triggeringPause) Unsupported() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.unsupported
+}
+
+// SetUnsupported records that the control-plane does not support pausing.
+func (p *triggeringPause) SetUnsupported() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.unsupported = true
+}
+
+// isTriggeringPaused asks the control-plane whether triggering is paused in all pipeds of the project.
+// The most recently fetched state is used when it could not be fetched.
+// Triggering is never paused when the control-plane does not support pausing,
+// and it is not asked again until piped is restarted.
+func (t *Trigger) isTriggeringPaused(ctx context.Context) bool {
+	if t.pause.Unsupported() {
+		return false
+	}
+	resp, err := t.apiClient.GetTriggeringPaused(ctx, &pipedservice.GetTriggeringPausedRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		t.pause.SetUnsupported()
+		t.logger.Info("the control-plane does not support pausing triggering, so it is never paused")
+		return false
+	}
+	if err != nil {
+		paused := t.pause.Get()
+		t.logger.Warn("failed to check whether triggering is paused, the last known state is used",
+			zap.Bool("paused", paused),
+			zap.Error(err),
+		)
+		return paused
+	}
+
+	if t.pause.Set(resp.Paused) {
+		if resp.Paused {
+			t.logger.Info("triggering was paused from the control-plane")
+		} else {
+			t.logger.Info("triggering was resumed from the control-plane")
+		}
+	}
+	return resp.Paused
+}
+
+// skipPausedCandidates removes the candidates by new commits and configuration drift,
+// and the ones having a command too if configured, while triggering is paused.
+// They are left unhandled to be found again once it was resumed.
+func (t *Trigger) skipPausedCandidates(ctx context.Context, cs []candidate) []candidate {
+	if len(cs) == 0 || !t.isTriggeringPaused(ctx) {
+		return cs
+	}
+
+	filtered := make([]candidate, 0, len(cs))
+	for _, c := range cs {
+		if c.HasCommand() && !t.config.QueueCommandsWhilePaused {
+			filtered = append(filtered, c)
+		}
+	}
+	if n := len(cs) - len(filtered); n > 0 {
+		t.logger.Info(fmt.Sprintf("skipped %d candidates since triggering is paused from the control-plane", n))
+	}
+	return filtered
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type failingPauseAPIClient struct {
+	apiClient
+}
+
+func (c *failingPauseAPIClient) GetTriggeringPaused(_ context.Context, _ *pipedservice.GetTriggeringPausedRequest, _ ...grpc.CallOption) (*pipedservice.GetTriggeringPausedResponse, error) {
+	return nil, errors.New("unavailable")
+}
+
+type unimplementedPauseAPIClient struct {
+	apiClient
+	calls int
+}
+
+func (c *unimplementedPauseAPIClient) GetTriggeringPaused(_ context.Context, _ *pipedservice.GetTriggeringPausedRequest, _ ...grpc.CallOption) (*pipedservice.GetTriggeringPausedResponse, error) {
+	c.calls++
+	return nil, status.Error(codes.Unimplemented, "unimplemented")
+}
+
+func TestSkipPausedCandidates(t *testing.T) {
+	t.Parallel()
+
+	cs := []candidate{
+		{application: &model.Application{Id: "app-1"}, kind: model.TriggerKind_ON_COMMIT},
+		{application: &model.Application{Id: "app-2"}, kind: model.TriggerKind_ON_OUT_OF_SYNC},
+		{application: &model.Application{Id: "app-3"}, kind: model.TriggerKind_ON_COMMAND},
+	}
+	appIDs := func(cs []candidate) []string {
+		ids := make([]string, 0, len(cs))
+		for _, c := range cs {
+			ids = append(ids, c.application.Id)
+		}
+		return ids
+	}
+
+	testcases := []struct {
+		name          string
+		paused        bool
+		queueCommands bool
+		expected      []string
+	}{
+		{
+			name:     "not paused",
+			expected: []string{"app-1", "app-2", "app-3"},
+		},
+		{
+			name:     "paused",
+			paused:   true,
+			expected: []string{"app-3"},
+		},
+		{
+			name:          "paused with queueing commands",
+			paused:        true,
+			queueCommands: true,
+			expected:      []string{},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &Trigger{
+				apiClient: &recordingAPIClient{paused: tc.paused},
+				config:    &config.PipedSpec{QueueCommandsWhilePaused: tc.queueCommands},
+				pause:     &triggeringPause{},
+				logger:    zap.NewNop(),
+			}
+			assert.Equal(t, tc.expected, appIDs(tr.skipPausedCandidates(context.Background(), cs)))
+		})
+	}
+}
+
+func TestIsTriggeringPaused(t *testing.T) {
+	t.Parallel()
+
+	client := &recordingAPIClient{}
+	tr := &Trigger{
+		apiClient: client,
+		pause:     &triggeringPause{},
+		logger:    zap.NewNop(),
+	}
+	assert.False(t, tr.isTriggeringPaused(context.Background()))
+
+	client.SetPaused(true)
+	assert.True(t, tr.isTriggeringPaused(context.Background()))
+
+	// The last known state is kept while the control-plane is unreachable.
+	tr.apiClient = &failingPauseAPIClient{}
+	assert.True(t, tr.isTriggeringPaused(context.Background()))
+
+	// Resumed once the control-plane is reachable again.
+	client.SetPaused(false)
+	tr.apiClient = client
+	assert.False(t, tr.isTriggeringPaused(context.Background()))
+}
+
+func TestIsTriggeringPausedUnsupported(t *testing.T) {
+	t.Parallel()
+
+	client := &unimplementedPauseAPIClient{}
+	tr := &Trigger{
+		apiClient: client,
+		pause:     &triggeringPause{},
+		logger:    zap.NewNop(),
+	}
+	assert.False(t, tr.isTriggeringPaused(context.Background()))
+	assert.False(t, tr.isTriggeringPaused(context.Background()))
+	// The control-plane not supporting pausing is asked only once.
+	assert.Equal(t, 1, client.calls)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import "sync"
+
+// repoPullFailureCounter counts how many consecutive times
+// pulling each Git repository branch has failed.
+type repoPullFailureCounter struct {
+	mu     sync.Mutex
+	counts map[gitRepoKey]int
+}
+
+func newRepoPullFailureCounter() *repoPullFailureCounter {
+	return &repoPullFailureCounter{
+		counts: make(map[gitRepoKey]int),
+	}
+}
+
+// Fail records a failure of pulling the given repository branch
+// and returns the number of consecutive failures including it.
+func (c *repoPullFailureCounter) Fail(key gitRepoKey) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[key]++
+	return c.counts[key]
+}
+
+// Reset clears the failures of the given repository branch
+// since it was pulled successfully.
+func (c *repoPullFailureCounter) Reset(key gitRepoKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.counts, key)
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoPullFailureCounter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		main    = gitRepoKey{repoID: "repo-1", branch: "main"}
+		release = gitRepoKey{repoID: "repo-1", branch: "release"}
+	)
+	c := newRepoPullFailureCounter()
+	assert.Equal(t, 1, c.Fail(main))
+	assert.Equal(t, 2, c.Fail(main))
+	assert.Equal(t, 1, c.Fail(release))
+
+	// The count is reset once the repository was pulled successfully.
+	c.Reset(main)
+	assert.Equal(t, 1, c.Fail(main))
+	assert.Equal(t, 2, c.Fail(release))
+}
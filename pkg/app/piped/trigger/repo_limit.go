@@ -0,0 +1,103 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// repoApplicationLimit keeps the repositories found having more applications than their limit
+// to notify each of them only once until the number of its applications goes back under the limit.
+type repoApplicationLimit struct {
+	mu       sync.Mutex
+	exceeded map[string]struct{}
+}
+
+func newRepoApplicationLimit() *repoApplicationLimit {
+	return &repoApplicationLimit{
+		exceeded: make(map[string]struct{}),
+	}
+}
+
+// Exceed records the given repository as exceeding its limit
+// and reports whether it was not recorded yet.
+func (l *repoApplicationLimit) Exceed(repoID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.exceeded[repoID]; ok {
+		return false
+	}
+	l.exceeded[repoID] = struct{}{}
+	return true
+}
+
+// Recover removes the given repository from the exceeding ones
+// and reports whether it was recorded.
+func (l *repoApplicationLimit) Recover(repoID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.exceeded[repoID]; !ok {
+		return false
+	}
+	delete(l.exceeded, repoID)
+	return true
+}
+
+// countRepoApplications returns the number of applications registered against the given repository.
+func (t *Trigger) countRepoApplications(repoID string) int {
+	n := 0
+	for _, app := range t.applicationLister.List() {
+		if t.repoIDOf(app) == repoID {
+			n++
+		}
+	}
+	return n
+}
+
+// exceedApplicationLimit reports whether the given repository has more applications than its limit
+// with the reason, and notifies it once until the number of its applications goes back under the limit.
+func (t *Trigger) exceedApplicationLimit(repoID string) (string, bool) {
+	limit := t.config.MaxApplications(repoID)
+	if limit <= 0 {
+		return "", false
+	}
+	n := t.countRepoApplications(repoID)
+	if n <= limit {
+		if t.repoAppLimit.Recover(repoID) {
+			t.logger.Info(fmt.Sprintf("resumed checking repo %s since its %d applications are within the limit of %d", repoID, n, limit))
+		}
+		return "", false
+	}
+
+	reason := fmt.Sprintf("the repository %s has %d applications exceeding the limit of %d", repoID, n, limit)
+	if !t.repoAppLimit.Exceed(repoID) {
+		return reason, true
+	}
+	t.logger.Error(fmt.Sprintf("refused to check repo %s since %s, check whether the applications were registered by mistake", repoID, reason))
+	t.notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED,
+		Metadata: &model.NotificationEventGitRepoApplicationLimitExceeded{
+			PipedId:      t.config.PipedID,
+			ProjectId:    t.config.ProjectID,
+			RepoId:       repoID,
+			Applications: int32(n),
+			Limit:        int32(limit),
+		},
+	})
+	return reason, true
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestExceedApplicationLimit(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id, repoID string) *model.Application {
+		return &model.Application{
+			Id:      id,
+			GitPath: &model.ApplicationGitPath{Repo: &model.ApplicationGitRepository{Id: repoID}},
+		}
+	}
+	var (
+		n   = &fakeNotifier{}
+		cfg = &config.PipedSpec{
+			ProjectID:              "project-1",
+			PipedID:                "piped-1",
+			MaxApplicationsPerRepo: 2,
+			Repositories: []config.PipedRepository{
+				{RepoID: "repo-1"},
+				{RepoID: "repo-2", MaxApplications: 3},
+			},
+		}
+		lister = &fakeApplicationLister{apps: []*model.Application{
+			newApp("app-1", "repo-1"),
+			newApp("app-2", "repo-1"),
+			newApp("app-3", "repo-1"),
+			newApp("app-4", "repo-2"),
+			newApp("app-5", "repo-2"),
+			newApp("app-6", "repo-2"),
+		}}
+		tr = &Trigger{
+			config:            cfg,
+			applicationLister: lister,
+			notifier:          n,
+			repoAppLimit:      newRepoApplicationLimit(),
+			logger:            zap.NewNop(),
+		}
+	)
+
+	reason, exceeded := tr.exceedApplicationLimit("repo-1")
+	assert.True(t, exceeded)
+	assert.Equal(t, "the repository repo-1 has 3 applications exceeding the limit of 2", reason)
+
+	// The limit of the repository takes precedence over the one of the piped.
+	_, exceeded = tr.exceedApplicationLimit("repo-2")
+	assert.False(t, exceeded)
+
+	// The same repository is notified only once while exceeding.
+	_, exceeded = tr.exceedApplicationLimit("repo-1")
+	assert.True(t, exceeded)
+	require.Len(t, n.events, 1)
+	assert.Equal(t, model.NotificationEventType_EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED, n.events[0].Type)
+	assert.Equal(t, &model.NotificationEventGitRepoApplicationLimitExceeded{
+		PipedId:      "piped-1",
+		ProjectId:    "project-1",
+		RepoId:       "repo-1",
+		Applications: 3,
+		Limit:        2,
+	}, n.events[0].Metadata)
+
+	// It is notified again when exceeding after going back under the limit.
+	lister.apps = lister.apps[1:]
+	_, exceeded = tr.exceedApplicationLimit("repo-1")
+	assert.False(t, exceeded)
+	lister.apps = append(lister.apps, newApp("app-7", "repo-1"))
+	_, exceeded = tr.exceedApplicationLimit("repo-1")
+	assert.True(t, exceeded)
+	assert.Len(t, n.events, 2)
+}
+
+func TestCheckCandidatesOnApplicationLimitExceeded(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id string) *model.Application {
+		return newTestApplication(t, repoPath, id, testAppConfig)
+	}
+	var (
+		apps = []*model.Application{newApp("app-1"), newApp("app-2")}
+		cr   = &commandRecorder{}
+		cs   = []candidate{
+			{application: apps[0], kind: model.TriggerKind_ON_COMMIT},
+			{application: apps[1], kind: model.TriggerKind_ON_COMMIT},
+			{
+				application: apps[1],
+				kind:        model.TriggerKind_ON_COMMAND,
+				command: cr.Command(&model.Command{
+					Id:              "command-1",
+					ApplicationId:   apps[1].Id,
+					Commander:       "user",
+					SyncApplication: &model.Command_SyncApplication{ApplicationId: apps[1].Id},
+				}),
+			},
+		}
+		ac   = &recordingAPIClient{}
+		repo = &fakeRepo{
+			path:         repoPath,
+			head:         git.Commit{Hash: "commit-1"},
+			changedFiles: []string{"app-1/deployment.yaml", "app-2/deployment.yaml"},
+		}
+		gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+		n   = &fakeNotifier{}
+		cfg = &config.PipedSpec{
+			ProjectID:              "project-1",
+			PipedID:                "piped-1",
+			Repositories:           []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			MaxApplicationsPerRepo: 1,
+		}
+		ctx = context.Background()
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: apps}, nil, n, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// The repository is not even cloned while exceeding the limit.
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Empty(t, ac.Created())
+	assert.Empty(t, gc.cloned)
+	require.Len(t, n.events, 1)
+	assert.Equal(t, model.NotificationEventType_EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED, n.events[0].Type)
+	// The command is not left unhandled while exceeding the limit.
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, cr.Reported("command-1"))
+
+	cfg.MaxApplicationsPerRepo = 2
+	require.NoError(t, tr.checkCandidates(ctx, cs[:2]))
+	assert.Len(t, ac.Created(), 2)
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// HeldRollout represents the applications held by the rollout of a new commit of a repository branch.
+type HeldRollout struct {
+	RepoID         string    `json:"repoId"`
+	Branch         string    `json:"branch"`
+	Commit         string    `json:"commit"`
+	ApplicationIDs []string  `json:"applicationIds"`
+	HeldAt         time.Time `json:"heldAt"`
+}
+
+// fleetRollout keeps the state of the rollout of the head commit of each repository branch.
+// The applications out of the selected percentage are held until the rollout of the commit was promoted.
+type fleetRollout struct {
+	percentage int
+	mu         sync.Mutex
+	held       map[gitRepoKey]HeldRollout
+	promoted   map[gitRepoKey]string
+}
+
+func newFleetRollout(percentage int) *fleetRollout {
+	return &fleetRollout{
+		percentage: percentage,
+		held:       make(map[gitRepoKey]HeldRollout),
+		promoted:   make(map[gitRepoKey]string),
+	}
+}
+
+// Selected reports whether the given application is in the part triggered first.
+// The same applications are always selected for the same percentage.
+func (r *fleetRollout) Selected(appID string) bool {
+	h := fnv.New32a()
+	h.Write([]byte(appID))
+	return int(h.Sum32()%100) < r.percentage
+}
+
+// IsPromoted reports whether the rollout of the given commit was promoted.
+func (r *fleetRollout) IsPromoted(key gitRepoKey, commit string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.promoted[key] == commit
+}
+
+// Hold replaces the applications held by the rollout of the given repository branch.
+// Nothing is kept when no application was held.
+func (r *fleetRollout) Hold(key gitRepoKey, commit string, appIDs []string, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(appIDs) == 0 {
+		delete(r.held, key)
+		return
+	}
+	r.held[key] = HeldRollout{
+		RepoID:         key.repoID,
+		Branch:         key.branch,
+		Commit:         commit,
+		ApplicationIDs: appIDs,
+		HeldAt:         now,
+	}
+}
+
+// Promote releases the held applications of the given repository, or of all repositories if empty,
+// and returns the promoted rollouts.
+func (r *fleetRollout) Promote(repoID string) []HeldRollout {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promoted := make([]HeldRollout, 0, len(r.held))
+	for key, h := range r.held {
+		if repoID != "" && key.repoID != repoID {
+			continue
+		}
+		r.promoted[key] = h.Commit
+		delete(r.held, key)
+		promoted = append(promoted, h)
+	}
+	sortHeldRollouts(promoted)
+	return promoted
+}
+
+// List returns the rollouts holding applications.
+func (r *fleetRollout) List() []HeldRollout {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]HeldRollout, 0, len(r.held))
+	for _, h := range r.held {
+		list = append(list, h)
+	}
+	sortHeldRollouts(list)
+	return list
+}
+
+func sortHeldRollouts(list []HeldRollout) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].RepoID != list[j].RepoID {
+			return list[i].RepoID < list[j].RepoID
+		}
+		return list[i].Branch < list[j].Branch
+	})
+}
+
+// holdsFleetRollout reports whether the given candidate decided to be triggered is held
+// until the rollout of the head commit of the given repository branch was promoted, if enabled.
+// The candidates of the commands are never held since they were requested explicitly.
+func (t *Trigger) holdsFleetRollout(key gitRepoKey, headCommit string, c candidate) bool {
+	if t.rollout == nil || c.HasCommand() || t.rollout.Selected(c.application.Id) {
+		return false
+	}
+	return !t.rollout.IsPromoted(key, headCommit)
+}
+
+// recordHeldRollout keeps the applications held at this check of the given repository branch
+// to let them be listed and promoted via the admin server.
+func (t *Trigger) recordHeldRollout(key gitRepoKey, headCommit string, held []string) {
+	if t.rollout == nil || t.rollout.IsPromoted(key, headCommit) {
+		return
+	}
+	// The same application is held once even when it had multiple candidates.
+	ids := make([]string, 0, len(held))
+	seen := make(map[string]struct{}, len(held))
+	for _, id := range held {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	t.rollout.Hold(key, headCommit, ids, time.Now())
+
+	if len(ids) > 0 {
+		t.logger.Info(fmt.Sprintf("held %d applications in repo %s until the rollout of the head commit is promoted", len(ids), key.repoID),
+			zap.String("branch", key.branch),
+			zap.String("commit", headCommit),
+		)
+	}
+}
+
+// GetHeldRollouts returns the rollouts of the repository branches holding applications.
+func (t *Trigger) GetHeldRollouts() []HeldRollout {
+	if t.rollout == nil {
+		return []HeldRollout{}
+	}
+	return t.rollout.List()
+}
+
+// PromoteRollouts lets the applications held by the rollouts of the given repository,
+// or of all repositories if empty, be triggered at the next check.
+// The promoted repositories are checked without waiting for the next sync interval.
+func (t *Trigger) PromoteRollouts(repoID string) []HeldRollout {
+	if t.rollout == nil {
+		return []HeldRollout{}
+	}
+	promoted := t.rollout.Promote(repoID)
+	repoIDs := make([]string, 0, len(promoted))
+	for _, h := range promoted {
+		repoIDs = append(repoIDs, h.RepoID)
+	}
+	if len(repoIDs) > 0 {
+		t.repoEvents.Push(repoIDs...)
+	}
+	t.logger.Info(fmt.Sprintf("promoted the rollouts of %d repository branches", len(promoted)))
+	return promoted
+}
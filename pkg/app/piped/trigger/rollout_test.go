@@ -0,0 +1,126 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestFleetRolloutSelected(t *testing.T) {
+	t.Parallel()
+
+	r := newFleetRollout(50)
+	assert.False(t, r.Selected("app-1"))
+	assert.True(t, r.Selected("app-2"))
+	// The same applications are selected every time.
+	assert.False(t, r.Selected("app-1"))
+
+	r = newFleetRollout(100)
+	assert.True(t, r.Selected("app-1"))
+	assert.True(t, r.Selected("app-7"))
+}
+
+func TestHoldFleetRollout(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id string) *model.Application {
+		return newTestApplication(t, repoPath, id, testAppConfig)
+	}
+	var (
+		// app-1 and app-7 are out of the first 50 percent while app-2 is in it.
+		held      = newApp("app-1")
+		selected  = newApp("app-2")
+		untouched = newApp("app-7")
+		cs        = []candidate{
+			{application: held, kind: model.TriggerKind_ON_COMMIT},
+			{application: selected, kind: model.TriggerKind_ON_COMMIT},
+			{application: untouched, kind: model.TriggerKind_ON_COMMIT},
+		}
+		ac = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+			"app-1": newDeploymentReference("deployment-1", "commit-1"),
+			"app-2": newDeploymentReference("deployment-2", "commit-1"),
+			"app-7": newDeploymentReference("deployment-7", "commit-1"),
+		}}
+		repo = &fakeRepo{
+			path:         repoPath,
+			head:         git.Commit{Hash: "commit-2"},
+			ancestors:    []string{"commit-1"},
+			changedFiles: []string{"app-1/deployment.yaml", "app-2/deployment.yaml"},
+		}
+		gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+		cfg = &config.PipedSpec{
+			ProjectID:    "project-1",
+			PipedID:      "piped-1",
+			Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			FleetRollout: &config.PipedFleetRollout{Percentage: 50},
+		}
+		ctx = context.Background()
+	)
+	triggeredApps := func() []string {
+		out := make([]string, 0)
+		for _, d := range ac.Created() {
+			out = append(out, d.ApplicationId)
+		}
+		return out
+	}
+
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{held, selected, untouched}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"app-2"}, triggeredApps())
+	rollouts := tr.GetHeldRollouts()
+	require.Len(t, rollouts, 1)
+	assert.Equal(t, "repo-1", rollouts[0].RepoID)
+	assert.Equal(t, "commit-2", rollouts[0].Commit)
+	// The application not touched by the new commit is not held since it would not be triggered anyway.
+	assert.Equal(t, []string{"app-1"}, rollouts[0].ApplicationIDs)
+
+	// The held application is kept held at the subsequent checks.
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"app-2"}, triggeredApps())
+	rollouts = tr.GetHeldRollouts()
+	require.Len(t, rollouts, 1)
+	assert.Equal(t, []string{"app-1"}, rollouts[0].ApplicationIDs)
+
+	// Nothing is promoted for the other repositories.
+	assert.Empty(t, tr.PromoteRollouts("repo-2"))
+	promoted := tr.PromoteRollouts("")
+	require.Len(t, promoted, 1)
+	assert.Empty(t, tr.GetHeldRollouts())
+
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"app-2", "app-1"}, triggeredApps())
+
+	// The rollout of the next commit holds the same application again.
+	repo.ancestors = []string{"commit-1", "commit-2"}
+	repo.head = git.Commit{Hash: "commit-3"}
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"app-2", "app-1", "app-2"}, triggeredApps())
+	rollouts = tr.GetHeldRollouts()
+	require.Len(t, rollouts, 1)
+	assert.Equal(t, "commit-3", rollouts[0].Commit)
+	assert.Equal(t, []string{"app-1"}, rollouts[0].ApplicationIDs)
+}
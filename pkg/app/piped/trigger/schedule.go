@@ -0,0 +1,131 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type scheduleEntry struct {
+	// The schedule configured at the last loaded configuration.
+	// Nil means no schedule is configured.
+	schedule cron.Schedule
+	// Whether the configuration has been loaded at least once.
+	observed bool
+	// The time from which the next scheduled time is computed.
+	since time.Time
+}
+
+// scheduleStore keeps the cron schedules of the applications and when they were last triggered.
+// They are kept only in memory, so the schedules start over from the first check after restarting piped
+// instead of firing at once for the scheduled times passed while piped was down.
+type scheduleStore struct {
+	mu      sync.Mutex
+	entries map[string]*scheduleEntry
+}
+
+func newScheduleStore() *scheduleStore {
+	return &scheduleStore{
+		entries: make(map[string]*scheduleEntry),
+	}
+}
+
+func (s *scheduleStore) entry(appID string, now time.Time) *scheduleEntry {
+	e, ok := s.entries[appID]
+	if !ok {
+		e = &scheduleEntry{since: now}
+		s.entries[appID] = e
+	}
+	return e
+}
+
+// Get returns the time when the given application was last triggered.
+// The given time is recorded as the starting point when it is unknown.
+func (s *scheduleStore) Get(appID string, now time.Time) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entry(appID, now).since
+}
+
+// Observe records the schedule configured for the given application.
+// The invalid schedule is recorded as none since it is reported while determining.
+func (s *scheduleStore) Observe(appID string, cfg config.OnSchedule, now time.Time) {
+	sched, err := cfg.Schedule()
+	if err != nil {
+		sched = nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entry(appID, now)
+	e.schedule = sched
+	e.observed = true
+}
+
+// Triggered records that a deployment of the given application was triggered at the given time.
+// Every deployment restarts the schedule since it redeploys the application anyway.
+func (s *scheduleStore) Triggered(appID string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entry(appID, at).since = at
+}
+
+// Due reports whether the schedule of the given application may have been reached at the given time.
+// True is returned when its configuration has never been loaded since the schedule is unknown.
+func (s *scheduleStore) Due(appID string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[appID]
+	if !ok || !e.observed {
+		return true
+	}
+	if e.schedule == nil {
+		return false
+	}
+	return !now.Before(e.schedule.Next(e.since))
+}
+
+// listScheduleCandidates finds all applications in the given repositories
+// that have potentiality to be triggered by their schedule.
+// They are merged into the commit candidates of the same applications
+// since whether a schedule is configured is known only after loading their configuration.
+func (t *Trigger) listScheduleCandidates(repos map[string]struct{}) []candidate {
+	var (
+		list = t.applicationLister.List()
+		apps = make([]candidate, 0)
+	)
+	for _, app := range list {
+		if _, ok := repos[t.repoIDOf(app)]; !ok {
+			continue
+		}
+		if app.Disabled {
+			continue
+		}
+		if !app.ContainLabels(t.config.TriggerSelector) {
+			continue
+		}
+		apps = append(apps, candidate{
+			application: app,
+			kind:        model.TriggerKind_ON_SCHEDULE,
+		})
+	}
+	return apps
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestScheduleStore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store = newScheduleStore()
+		start = time.Date(2022, 1, 1, 9, 30, 0, 0, time.UTC)
+	)
+
+	// The schedule is unknown until the configuration is observed.
+	assert.True(t, store.Due("app-1", start))
+	assert.Equal(t, start, store.Get("app-1", start))
+	assert.True(t, store.Due("app-1", start))
+
+	store.Observe("app-1", config.OnSchedule{Cron: "0 * * * *"}, start.Add(time.Minute))
+	assert.Equal(t, start, store.Get("app-1", start.Add(time.Minute)))
+	assert.False(t, store.Due("app-1", start.Add(29*time.Minute)))
+	assert.True(t, store.Due("app-1", start.Add(30*time.Minute)))
+
+	// Every deployment restarts the schedule.
+	store.Triggered("app-1", start.Add(40*time.Minute))
+	assert.False(t, store.Due("app-1", start.Add(time.Hour)))
+	assert.True(t, store.Due("app-1", start.Add(90*time.Minute)))
+
+	// No schedule is never due.
+	store.Observe("app-2", config.OnSchedule{}, start)
+	assert.False(t, store.Due("app-2", start.Add(24*time.Hour)))
+
+	// The invalid schedule is reported by the determiner instead.
+	store.Observe("app-3", config.OnSchedule{Cron: "every hour"}, start)
+	assert.False(t, store.Due("app-3", start.Add(24*time.Hour)))
+}
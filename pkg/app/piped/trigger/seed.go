@@ -0,0 +1,93 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// seedNeverDeployedApplications records the head commit as the last triggered commit
+// of the applications having no deployment instead of triggering them at the first check
+// of the given repository branch containing the commit candidates since piped started, if enabled.
+// The checks having no commit candidate, e.g. the ones by the commands only, do not count as the first one
+// since nothing would be seeded by them.
+// The candidates of the seeded applications other than the commands are removed,
+// and the rest of the candidates are returned to be checked as usual.
+func (t *Trigger) seedNeverDeployedApplications(ctx context.Context, key gitRepoKey, headCommit git.Commit, cs []candidate) []candidate {
+	if !t.config.SeedNeverDeployedApplications || !hasCommitCandidate(cs) || !t.seededRepos.Add(key) {
+		return cs
+	}
+
+	seeded := make(map[string]struct{})
+	for _, c := range cs {
+		if c.kind != model.TriggerKind_ON_COMMIT {
+			continue
+		}
+		app := c.application
+		commit, err := t.commitStore.Get(ctx, app.Id)
+		if err != nil {
+			// The application is left to be checked as usual.
+			t.logger.Warn("failed to get last triggered commit to seed",
+				zap.String("app", app.Name),
+				zap.String("app-id", app.Id),
+				zap.Error(err),
+			)
+			continue
+		}
+		if commit != "" {
+			continue
+		}
+		if err := t.commitStore.Put(app.Id, headCommit.Hash); err != nil {
+			t.logger.Error("failed to seed last triggered commit",
+				zap.String("app", app.Name),
+				zap.String("app-id", app.Id),
+				zap.Error(err),
+			)
+			continue
+		}
+		seeded[app.Id] = struct{}{}
+	}
+
+	filtered := make([]candidate, 0, len(cs))
+	for _, c := range cs {
+		if _, ok := seeded[c.application.Id]; ok && !c.HasCommand() {
+			t.auditDecision(c, key, headCommit.Hash, "", "seeded the head commit as the last triggered commit of the application having no deployment")
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+
+	t.logger.Info(fmt.Sprintf("seeded the head commit of %d applications having no deployment in repo %s without triggering, %d candidates are checked as usual", len(seeded), key.repoID, len(filtered)),
+		zap.String("branch", key.branch),
+		zap.String("commit", headCommit.Hash),
+	)
+	return filtered
+}
+
+// hasCommitCandidate reports whether the given candidates contain the ones by new commits.
+func hasCommitCandidate(cs []candidate) bool {
+	for _, c := range cs {
+		if c.kind == model.TriggerKind_ON_COMMIT {
+			return true
+		}
+	}
+	return false
+}
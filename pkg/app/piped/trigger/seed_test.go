@@ -0,0 +1,145 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestSeedNeverDeployedApplications(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	newApp := func(id string) *model.Application {
+		return newTestApplication(t, repoPath, id, testAppConfig)
+	}
+	var (
+		neverDeployed = newApp("app-1")
+		deployed      = newApp("app-2")
+		cs            = []candidate{
+			{application: neverDeployed, kind: model.TriggerKind_ON_COMMIT},
+			{application: deployed, kind: model.TriggerKind_ON_COMMIT},
+		}
+		ctx = context.Background()
+	)
+	triggeredApps := func(ac *recordingAPIClient) []string {
+		out := make([]string, 0)
+		for _, d := range ac.Created() {
+			out = append(out, d.ApplicationId)
+		}
+		return out
+	}
+
+	testcases := []struct {
+		name string
+		seed bool
+		want []string
+	}{
+		{
+			name: "disabled",
+			seed: false,
+			want: []string{"app-1", "app-2"},
+		},
+		{
+			name: "enabled",
+			seed: true,
+			want: []string{"app-2"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ac = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+					"app-2": newDeploymentReference("deployment-1", "commit-1"),
+				}}
+				repo = &fakeRepo{
+					path:         repoPath,
+					head:         git.Commit{Hash: "commit-2"},
+					ancestors:    []string{"commit-1"},
+					changedFiles: []string{"app-1/deployment.yaml", "app-2/deployment.yaml"},
+				}
+				gc  = &fakeGitClient{repos: map[string]git.Repo{"repo-1": repo}}
+				cfg = &config.PipedSpec{
+					ProjectID:                     "project-1",
+					PipedID:                       "piped-1",
+					Repositories:                  []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+					SeedNeverDeployedApplications: tc.seed,
+				}
+			)
+			tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{neverDeployed, deployed}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			require.NoError(t, tr.checkCandidates(ctx, cs))
+			assert.Equal(t, tc.want, triggeredApps(ac))
+			commit, err := tr.commitStore.Get(ctx, neverDeployed.Id)
+			require.NoError(t, err)
+			assert.Equal(t, "commit-2", commit)
+
+			// The application seeded at the first check is triggered by the subsequent new commits as usual.
+			repo.ancestors = []string{"commit-1", "commit-2"}
+			repo.head = git.Commit{Hash: "commit-3"}
+			require.NoError(t, tr.checkCandidates(ctx, cs))
+			assert.Equal(t, append(tc.want, "app-1", "app-2"), triggeredApps(ac))
+		})
+	}
+}
+
+func TestSeedNeverDeployedApplicationsAfterCommandCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app = &model.Application{Id: "app-1", Name: "app-1"}
+		key = gitRepoKey{repoID: "repo-1", branch: "main"}
+		cfg = &config.PipedSpec{
+			ProjectID:                     "project-1",
+			PipedID:                       "piped-1",
+			Repositories:                  []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			SeedNeverDeployedApplications: true,
+		}
+		head = git.Commit{Hash: "commit-1"}
+		ctx  = context.Background()
+	)
+	tr, err := NewTrigger(&recordingAPIClient{}, nil, nil, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// The check by a command only, e.g. the on-demand one, reaches the repository first.
+	cmd := []candidate{{
+		application: app,
+		kind:        model.TriggerKind_ON_COMMAND,
+		command:     (&commandRecorder{}).Command(&model.Command{Id: "command-1", ApplicationId: app.Id}),
+	}}
+	assert.Equal(t, cmd, tr.seedNeverDeployedApplications(ctx, key, head, cmd))
+	commit, err := tr.commitStore.Get(ctx, app.Id)
+	require.NoError(t, err)
+	assert.Empty(t, commit)
+
+	// The seeding is still done at the first check having the commit candidates.
+	assert.Empty(t, tr.seedNeverDeployedApplications(ctx, key, head, []candidate{{application: app, kind: model.TriggerKind_ON_COMMIT}}))
+	commit, err = tr.commitStore.Get(ctx, app.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "commit-1", commit)
+}
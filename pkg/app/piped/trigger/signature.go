@@ -0,0 +1,108 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// unverifiedCommitStore keeps the head commit of each repository branch
+// whose signature was not verified to notify it only once.
+type unverifiedCommitStore struct {
+	mu      sync.Mutex
+	commits map[gitRepoKey]string
+}
+
+func newUnverifiedCommitStore() *unverifiedCommitStore {
+	return &unverifiedCommitStore{
+		commits: make(map[gitRepoKey]string),
+	}
+}
+
+// Put records the given commit as the unverified head of the given repository branch.
+// False is returned when it was already recorded.
+func (s *unverifiedCommitStore) Put(key gitRepoKey, hash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.commits[key] == hash {
+		return false
+	}
+	s.commits[key] = hash
+	return true
+}
+
+// Delete clears the unverified head of the given repository branch
+// since its head commit has been verified.
+func (s *unverifiedCommitStore) Delete(key gitRepoKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.commits, key)
+}
+
+// requiresSignedCommits reports whether only the signed commits can be deployed in the given repository.
+func (t *Trigger) requiresSignedCommits(repoID string) bool {
+	r, ok := t.config.GetRepository(repoID)
+	return ok && r.RequireSignedCommits
+}
+
+// verifyCommitSignature reports whether the given commit was signed by one of the configured keys.
+// The reason is returned when it was not.
+func verifyCommitSignature(ctx context.Context, repo git.Repo, commit git.Commit) (bool, string) {
+	sig, err := repo.GetCommitSignature(ctx, commit.Hash)
+	if err != nil {
+		return false, fmt.Sprintf("failed to verify the signature of commit %s: %v", commit.Hash, err)
+	}
+	if !sig.IsSigned() {
+		return false, fmt.Sprintf("commit %s is not signed", commit.Hash)
+	}
+	if !sig.IsVerified() {
+		return false, fmt.Sprintf("the signature of commit %s by key %s was not verified, status: %s", commit.Hash, sig.Key, sig.Status)
+	}
+	return true, ""
+}
+
+// handleUnverifiedCommit notifies that the deployments in the given repository branch were suppressed
+// since the signature of its head commit was not verified. The same commit is notified only once.
+func (t *Trigger) handleUnverifiedCommit(key gitRepoKey, commit git.Commit, reason string) {
+	t.logger.Warn(fmt.Sprintf("suppressed triggering the deployments in repo %s since %s", key.repoID, reason),
+		zap.String("branch", key.branch),
+		zap.String("commit", commit.Hash),
+		zap.String("author", commit.AuthorEmail),
+	)
+	if !t.unverifiedCommits.Put(key, commit.Hash) {
+		return
+	}
+	t.notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_GIT_COMMIT_SIGNATURE_UNVERIFIED,
+		Metadata: &model.NotificationEventGitCommitSignatureUnverified{
+			PipedId:      t.config.PipedID,
+			ProjectId:    t.config.ProjectID,
+			RepoId:       key.repoID,
+			Branch:       key.branch,
+			CommitHash:   commit.Hash,
+			CommitAuthor: commit.AuthorEmail,
+			Reason:       reason,
+		},
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestUnverifiedCommitStore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		s    = newUnverifiedCommitStore()
+		key1 = gitRepoKey{repoID: "repo-1", branch: "main"}
+		key2 = gitRepoKey{repoID: "repo-2", branch: "main"}
+	)
+
+	assert.True(t, s.Put(key1, "commit-1"))
+	assert.False(t, s.Put(key1, "commit-1"))
+	assert.True(t, s.Put(key2, "commit-1"))
+	assert.True(t, s.Put(key1, "commit-2"))
+
+	s.Delete(key1)
+	assert.True(t, s.Put(key1, "commit-2"))
+}
+
+func TestVerifyCommitSignature(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		signature git.CommitSignature
+		expected  bool
+	}{
+		{
+			name:      "verified",
+			signature: git.CommitSignature{Status: git.SignatureStatusGood, Key: "key"},
+			expected:  true,
+		},
+		{
+			name:      "not signed",
+			signature: git.CommitSignature{Status: git.SignatureStatusNoSignature},
+			expected:  false,
+		},
+		{
+			name:      "signed by unknown key",
+			signature: git.CommitSignature{Status: git.SignatureStatusUnknownValidity, Key: "key"},
+			expected:  false,
+		},
+		{
+			name:      "bad signature",
+			signature: git.CommitSignature{Status: git.SignatureStatusBad, Key: "key"},
+			expected:  false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeRepo{head: git.Commit{Hash: "commit-1"}, signature: tc.signature}
+			ok, reason := verifyCommitSignature(context.Background(), repo, repo.head)
+			assert.Equal(t, tc.expected, ok)
+			assert.Equal(t, tc.expected, reason == "", reason)
+		})
+	}
+
+	// The commit failed to be verified is regarded as unverified.
+	ok, reason := verifyCommitSignature(context.Background(), &fakeRepo{}, git.Commit{Hash: "commit-1"})
+	assert.False(t, ok)
+	assert.NotEmpty(t, reason)
+}
+
+func TestHandleUnverifiedCommit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		n  = &fakeNotifier{}
+		tr = &Trigger{
+			config:            &config.PipedSpec{ProjectID: "project-1", PipedID: "piped-1"},
+			notifier:          n,
+			unverifiedCommits: newUnverifiedCommitStore(),
+			logger:            zap.NewNop(),
+		}
+		key    = gitRepoKey{repoID: "repo-1", branch: "main"}
+		commit = git.Commit{Hash: "commit-1", AuthorEmail: "user@example.com"}
+	)
+
+	// The same commit is notified only once.
+	tr.handleUnverifiedCommit(key, commit, "commit commit-1 is not signed")
+	tr.handleUnverifiedCommit(key, commit, "commit commit-1 is not signed")
+
+	assert.Len(t, n.events, 1)
+	assert.Equal(t, model.NotificationEventType_EVENT_GIT_COMMIT_SIGNATURE_UNVERIFIED, n.events[0].Type)
+	assert.Equal(t, &model.NotificationEventGitCommitSignatureUnverified{
+		PipedId:      "piped-1",
+		ProjectId:    "project-1",
+		RepoId:       "repo-1",
+		Branch:       "main",
+		CommitHash:   "commit-1",
+		CommitAuthor: "user@example.com",
+		Reason:       "commit commit-1 is not signed",
+	}, n.events[0].Metadata)
+}
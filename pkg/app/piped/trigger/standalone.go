@@ -0,0 +1,124 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// StaticLastTriggeredCommit is a LastTriggeredCommitGetter returning the same commit for all applications.
+// This is used to decide whether the changes since a given commit trigger the applications
+// without querying the control-plane, e.g. the base commit of a pull request in a CI check.
+type StaticLastTriggeredCommit string
+
+func (c StaticLastTriggeredCommit) Get(_ context.Context, _ string) (string, error) {
+	return string(c), nil
+}
+
+func (c StaticLastTriggeredCommit) Refresh(_ context.Context, _ string) (string, error) {
+	return string(c), nil
+}
+
+// StaticLastScheduledTime is a LastScheduledTimeGetter returning the same time for all applications.
+type StaticLastScheduledTime time.Time
+
+func (t StaticLastScheduledTime) Get(_ string, _ time.Time) time.Time {
+	return time.Time(t)
+}
+
+// DeterminerConfig contains the inputs to build a determiner outside of piped.
+type DeterminerConfig struct {
+	// The repository checked out at the target commit.
+	// Required by ON_COMMIT.
+	Repo git.Repo
+	// The commit to be deployed, usually the head commit of the repository.
+	// Required by ON_COMMIT and ON_OUT_OF_SYNC.
+	TargetCommit string
+	// The commit most recently deployed for the applications.
+	// The changes from this commit to the target commit are checked by ON_COMMIT.
+	// Empty means all applications are triggered as never deployed.
+	BaseCommit string
+	// Whether to take the changes inside the submodules into account.
+	Submodules bool
+	// Whether only the latest commits of the repository were cloned.
+	Shallow bool
+	// The client to get the most recently triggered deployment of the applications.
+	// Required by ON_OUT_OF_SYNC.
+	DeploymentGetter DeploymentGetter
+	// The time when the applications were last triggered.
+	// The next scheduled time from this time is checked by ON_SCHEDULE.
+	// Zero means the applications having a schedule are triggered at once.
+	LastScheduledTime time.Time
+	// The time at which ON_SCHEDULE is determined.
+	// Zero means the current time.
+	Now time.Time
+	// Optional. Nothing is logged when nil.
+	Logger *zap.Logger
+}
+
+// NewDeterminer returns a determiner deciding whether the applications should be triggered by the given kind,
+// so that the trigger logic can be reused without running a piped.
+// The application configuration passed to ShouldTrigger must be loaded with the default values,
+// e.g. by config.LoadFromYAML and GetGenericApplication.
+//
+// The determiners built here know nothing about the state kept by piped,
+// so the confirmation and the failure backoff of ON_OUT_OF_SYNC are not applied.
+func NewDeterminer(kind model.TriggerKind, cfg DeterminerConfig) (Determiner, error) {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	switch kind {
+	case model.TriggerKind_ON_COMMIT:
+		if cfg.Repo == nil {
+			return nil, errors.New("repo is required to determine ON_COMMIT")
+		}
+		if cfg.TargetCommit == "" {
+			return nil, errors.New("target commit is required to determine ON_COMMIT")
+		}
+		return NewOnCommitDeterminer(cfg.Repo, cfg.TargetCommit, cfg.Submodules, cfg.Shallow, StaticLastTriggeredCommit(cfg.BaseCommit), logger), nil
+	case model.TriggerKind_ON_OUT_OF_SYNC:
+		if cfg.DeploymentGetter == nil {
+			return nil, errors.New("deployment getter is required to determine ON_OUT_OF_SYNC")
+		}
+		if cfg.TargetCommit == "" {
+			return nil, errors.New("target commit is required to determine ON_OUT_OF_SYNC")
+		}
+		return NewOnOutOfSyncDeterminer(cfg.DeploymentGetter, nil, nil, nil, cfg.TargetCommit), nil
+	case model.TriggerKind_ON_COMMAND:
+		return NewOnCommandDeterminer(), nil
+	case model.TriggerKind_ON_CHAIN:
+		return NewOnChainDeterminer(), nil
+	case model.TriggerKind_ON_EXTERNAL:
+		return NewOnExternalDeterminer(), nil
+	case model.TriggerKind_ON_SCHEDULE:
+		now := cfg.Now
+		if now.IsZero() {
+			now = time.Now()
+		}
+		return NewOnScheduleDeterminer(StaticLastScheduledTime(cfg.LastScheduledTime), now), nil
+	default:
+		return nil, fmt.Errorf("unsupported trigger kind %s", kind)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestNewDeterminer(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRepo{
+		head:         git.Commit{Hash: "commit-2"},
+		ancestors:    []string{"commit-1"},
+		changedFiles: []string{"manifests/app-1/deployment.yaml"},
+	}
+
+	testcases := []struct {
+		name    string
+		kind    model.TriggerKind
+		cfg     DeterminerConfig
+		wantErr bool
+	}{
+		{
+			name: "on commit",
+			kind: model.TriggerKind_ON_COMMIT,
+			cfg:  DeterminerConfig{Repo: repo, TargetCommit: "commit-2"},
+		},
+		{
+			name:    "on commit without repo",
+			kind:    model.TriggerKind_ON_COMMIT,
+			cfg:     DeterminerConfig{TargetCommit: "commit-2"},
+			wantErr: true,
+		},
+		{
+			name:    "on commit without target commit",
+			kind:    model.TriggerKind_ON_COMMIT,
+			cfg:     DeterminerConfig{Repo: repo},
+			wantErr: true,
+		},
+		{
+			name: "on out of sync",
+			kind: model.TriggerKind_ON_OUT_OF_SYNC,
+			cfg:  DeterminerConfig{TargetCommit: "commit-2", DeploymentGetter: &fakeDependencyAPIClient{}},
+		},
+		{
+			name:    "on out of sync without deployment getter",
+			kind:    model.TriggerKind_ON_OUT_OF_SYNC,
+			cfg:     DeterminerConfig{TargetCommit: "commit-2"},
+			wantErr: true,
+		},
+		{
+			name: "on command",
+			kind: model.TriggerKind_ON_COMMAND,
+		},
+		{
+			name: "on chain",
+			kind: model.TriggerKind_ON_CHAIN,
+		},
+		{
+			name: "on external",
+			kind: model.TriggerKind_ON_EXTERNAL,
+		},
+		{
+			name: "on schedule",
+			kind: model.TriggerKind_ON_SCHEDULE,
+		},
+		{
+			name:    "unknown kind",
+			kind:    model.TriggerKind(100),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d, err := NewDeterminer(tc.kind, tc.cfg)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, d)
+		})
+	}
+}
+
+func TestNewDeterminerOnCommit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = &fakeRepo{
+			head:         git.Commit{Hash: "commit-2"},
+			ancestors:    []string{"commit-1"},
+			changedFiles: []string{"manifests/app-1/deployment.yaml"},
+		}
+		app = func(id, path string) *model.Application {
+			return &model.Application{Id: id, GitPath: &model.ApplicationGitPath{Path: path}}
+		}
+		appCfg = &config.GenericApplicationSpec{}
+		ctx    = context.Background()
+	)
+
+	d, err := NewDeterminer(model.TriggerKind_ON_COMMIT, DeterminerConfig{Repo: repo, TargetCommit: "commit-2", BaseCommit: "commit-1"})
+	require.NoError(t, err)
+
+	ok, reason, err := d.ShouldTrigger(ctx, app("app-1", "manifests/app-1"), appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "new commits from commit-1 to commit-2 touched the application, changed files: manifests/app-1/deployment.yaml", reason)
+
+	ok, _, err = d.ShouldTrigger(ctx, app("app-2", "manifests/app-2"), appCfg)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// All applications are triggered when no base commit was given.
+	d, err = NewDeterminer(model.TriggerKind_ON_COMMIT, DeterminerConfig{Repo: repo, TargetCommit: "commit-2"})
+	require.NoError(t, err)
+
+	ok, _, err = d.ShouldTrigger(ctx, app("app-2", "manifests/app-2"), appCfg)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// SuppressionReasonCooldown means the automatic triggers are suppressed
+	// since the application is cooling down from its last deployment.
+	SuppressionReasonCooldown = "cooldown"
+	// SuppressionReasonOutOfSyncThrottle means the triggers by configuration drift are suppressed
+	// since the last one was triggered within the configured interval.
+	SuppressionReasonOutOfSyncThrottle = "outOfSyncThrottle"
+)
+
+// Suppression represents an application whose triggers are currently held back.
+type Suppression struct {
+	ApplicationID   string `json:"applicationId"`
+	ApplicationName string `json:"applicationName"`
+	Reason          string `json:"reason"`
+	// The time remaining until the suppression is lifted.
+	Remaining string    `json:"remaining"`
+	Until     time.Time `json:"until"`
+}
+
+// GetSuppressions returns the applications whose triggers are currently suppressed
+// by the trigger cooldown or the throttle of the triggers by configuration drift.
+// The deferred ones by the deployment budget are listed by GetDeploymentBudgetStatus instead.
+func (t *Trigger) GetSuppressions() []Suppression {
+	return t.suppressions(t.applicationLister.List(), time.Now())
+}
+
+func (t *Trigger) suppressions(apps []*model.Application, now time.Time) []Suppression {
+	list := make([]Suppression, 0)
+	add := func(app *model.Application, reason string, remaining time.Duration) {
+		list = append(list, Suppression{
+			ApplicationID:   app.Id,
+			ApplicationName: app.Name,
+			Reason:          reason,
+			Remaining:       remaining.Round(time.Second).String(),
+			Until:           now.Add(remaining),
+		})
+	}
+
+	cooldown := t.config.TriggerCooldown.Duration()
+	for _, app := range apps {
+		if cooldown > 0 {
+			if at, ok := t.commitStore.GetTriggeredAt(app.Id); ok {
+				if remaining := cooldown - now.Sub(at); remaining > 0 {
+					add(app, SuppressionReasonCooldown, remaining)
+				}
+			}
+		}
+		if remaining := t.outOfSyncThrottle.Remaining(app.Id, now); remaining > 0 {
+			add(app, SuppressionReasonOutOfSyncThrottle, remaining)
+		}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].ApplicationID < list[j].ApplicationID
+	})
+	return list
+}
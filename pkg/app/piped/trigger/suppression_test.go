@@ -0,0 +1,69 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestSuppressions(t *testing.T) {
+	t.Parallel()
+
+	tr, err := NewTrigger(nil, nil, nil, nil, nil, nil, &config.PipedSpec{
+		TriggerCooldown:          config.Duration(10 * time.Minute),
+		OutOfSyncTriggerInterval: config.Duration(time.Hour),
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	var (
+		now  = time.Date(2021, 8, 2, 10, 0, 0, 0, time.UTC)
+		apps = []*model.Application{
+			{Id: "app-3", Name: "app-3"},
+			{Id: "app-2", Name: "app-2"},
+			{Id: "app-1", Name: "app-1"},
+		}
+	)
+	require.NoError(t, tr.commitStore.PutTriggered("app-1", "commit-1", now.Add(-4*time.Minute)))
+	require.NoError(t, tr.commitStore.PutTriggered("app-2", "commit-1", now.Add(-10*time.Minute)))
+	tr.outOfSyncThrottle.Record("app-1", now.Add(-30*time.Minute))
+	tr.outOfSyncThrottle.Record("app-3", now.Add(-time.Hour))
+
+	expected := []Suppression{
+		{
+			ApplicationID:   "app-1",
+			ApplicationName: "app-1",
+			Reason:          SuppressionReasonCooldown,
+			Remaining:       "6m0s",
+			Until:           now.Add(6 * time.Minute),
+		},
+		{
+			ApplicationID:   "app-1",
+			ApplicationName: "app-1",
+			Reason:          SuppressionReasonOutOfSyncThrottle,
+			Remaining:       "30m0s",
+			Until:           now.Add(30 * time.Minute),
+		},
+	}
+	assert.Equal(t, expected, tr.suppressions(apps, now))
+	assert.Empty(t, tr.suppressions(apps, now.Add(time.Hour)))
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+	"time"
+)
+
+// triggerThrottle limits the frequency of triggering each application
+// by remembering the last time it was triggered.
+// No limit is applied when interval is zero.
+type triggerThrottle struct {
+	interval time.Duration
+
+	mu          sync.Mutex
+	triggeredAt map[string]time.Time
+}
+
+func newTriggerThrottle(interval time.Duration) *triggerThrottle {
+	return &triggerThrottle{
+		interval:    interval,
+		triggeredAt: make(map[string]time.Time),
+	}
+}
+
+// Allow reports whether the given application can be triggered at the given time.
+func (t *triggerThrottle) Allow(appID string, now time.Time) bool {
+	if t.interval <= 0 {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	at, ok := t.triggeredAt[appID]
+	return !ok || now.Sub(at) >= t.interval
+}
+
+// Record records that the given application was triggered at the given time.
+func (t *triggerThrottle) Record(appID string, now time.Time) {
+	if t.interval <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.triggeredAt[appID] = now
+}
+
+// Remaining returns how long the given application is still throttled at the given time.
+// Zero is returned when it can be triggered.
+func (t *triggerThrottle) Remaining(appID string, now time.Time) time.Duration {
+	if t.interval <= 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	at, ok := t.triggeredAt[appID]
+	if !ok {
+		return 0
+	}
+	if d := t.interval - now.Sub(at); d > 0 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriggerThrottle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tt := newTriggerThrottle(time.Minute)
+	assert.True(t, tt.Allow("app-1", now))
+
+	tt.Record("app-1", now)
+	assert.False(t, tt.Allow("app-1", now.Add(59*time.Second)))
+	assert.True(t, tt.Allow("app-1", now.Add(time.Minute)))
+	assert.True(t, tt.Allow("app-2", now))
+	assert.Equal(t, 20*time.Second, tt.Remaining("app-1", now.Add(40*time.Second)))
+	assert.Zero(t, tt.Remaining("app-1", now.Add(time.Minute)))
+	assert.Zero(t, tt.Remaining("app-2", now))
+
+	// No limit is applied when the interval is zero.
+	tt = newTriggerThrottle(0)
+	tt.Record("app-1", now)
+	assert.True(t, tt.Allow("app-1", now))
+}
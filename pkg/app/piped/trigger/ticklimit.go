@@ -0,0 +1,86 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// tickLimit limits the number of deployments can be triggered in one check.
+// No limit is applied when limit is zero.
+type tickLimit struct {
+	limit int
+
+	mu        sync.Mutex
+	triggered int
+	deferred  int
+}
+
+func newTickLimit(limit int) *tickLimit {
+	return &tickLimit{limit: limit}
+}
+
+// TryAcquire reserves one deployment of this check.
+// False is returned and the candidate is counted as deferred when the limit was reached.
+func (l *tickLimit) TryAcquire() bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.triggered >= l.limit {
+		l.deferred++
+		return false
+	}
+	l.triggered++
+	return true
+}
+
+// Deferred returns the number of candidates deferred by the limit.
+func (l *tickLimit) Deferred() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.deferred
+}
+
+// sortCandidatesForTickLimit sorts the given candidates to let the ones having a command be checked first,
+// then the ones staying at OUT_OF_SYNC state from the oldest, and then the commit ones.
+// The order of the candidates of the same rank is kept.
+func sortCandidatesForTickLimit(cs []candidate) {
+	rank := func(c candidate) int {
+		switch {
+		case c.HasCommand():
+			return 0
+		case c.kind == model.TriggerKind_ON_OUT_OF_SYNC:
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(cs, func(i, j int) bool {
+		ri, rj := rank(cs[i]), rank(cs[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if ri != 1 {
+			return false
+		}
+		return cs[i].application.GetSyncState().GetTimestamp() < cs[j].application.GetSyncState().GetTimestamp()
+	})
+}
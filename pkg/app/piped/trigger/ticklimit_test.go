@@ -0,0 +1,79 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestTickLimit(t *testing.T) {
+	t.Parallel()
+
+	l := newTickLimit(2)
+	assert.True(t, l.TryAcquire())
+	assert.True(t, l.TryAcquire())
+	assert.False(t, l.TryAcquire())
+	assert.False(t, l.TryAcquire())
+	assert.Equal(t, 2, l.Deferred())
+
+	// No limit is applied when the limit is zero.
+	l = newTickLimit(0)
+	for i := 0; i < 10; i++ {
+		assert.True(t, l.TryAcquire())
+	}
+	assert.Equal(t, 0, l.Deferred())
+}
+
+func TestSortCandidatesForTickLimit(t *testing.T) {
+	t.Parallel()
+
+	newCandidate := func(id string, kind model.TriggerKind, outOfSyncAt int64) candidate {
+		return candidate{
+			application: &model.Application{
+				Id:        id,
+				SyncState: &model.ApplicationSyncState{Timestamp: outOfSyncAt},
+			},
+			kind: kind,
+		}
+	}
+	cs := []candidate{
+		newCandidate("commit-1", model.TriggerKind_ON_COMMIT, 0),
+		newCandidate("out-of-sync-1", model.TriggerKind_ON_OUT_OF_SYNC, 300),
+		newCandidate("command-1", model.TriggerKind_ON_COMMAND, 0),
+		newCandidate("commit-2", model.TriggerKind_ON_COMMIT, 0),
+		newCandidate("out-of-sync-2", model.TriggerKind_ON_OUT_OF_SYNC, 100),
+		newCandidate("chain-1", model.TriggerKind_ON_CHAIN, 0),
+		newCandidate("out-of-sync-3", model.TriggerKind_ON_OUT_OF_SYNC, 200),
+	}
+	sortCandidatesForTickLimit(cs)
+
+	ids := make([]string, 0, len(cs))
+	for _, c := range cs {
+		ids = append(ids, c.application.Id)
+	}
+	assert.Equal(t, []string{
+		"command-1",
+		"chain-1",
+		"out-of-sync-2",
+		"out-of-sync-3",
+		"out-of-sync-1",
+		"commit-1",
+		"commit-2",
+	}, ids)
+}
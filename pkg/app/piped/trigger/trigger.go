@@ -19,14 +19,24 @@ package trigger
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/trigger/triggermetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -37,18 +47,37 @@ import (
 const (
 	ondemandCheckInterval               = 10 * time.Second
 	defaultLastTriggeredCommitCacheSize = 500
+	// The maximum number of repository branches cloned concurrently while starting.
+	maxConcurrentClones = 5
+	// Minimum interval between two warnings about the same unregistered repository.
+	unregisteredRepoWarningInterval = time.Hour
+	// Minimum interval between two warnings about the deprecated config of the same application.
+	deprecatedConfigWarningInterval = time.Hour
+	// The commit message trailer used to specify the sync strategy of the deployments triggered by that commit.
+	syncStrategyTrailer = "Pipecd-Sync-Strategy"
+	// Timeout for reporting the command status after the trigger was stopped.
+	commandReportTimeout = 10 * time.Second
 )
 
 type apiClient interface {
 	GetApplicationMostRecentDeployment(ctx context.Context, req *pipedservice.GetApplicationMostRecentDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.GetApplicationMostRecentDeploymentResponse, error)
+	ListApplicationMostRecentDeployments(ctx context.Context, req *pipedservice.ListApplicationMostRecentDeploymentsRequest, opts ...grpc.CallOption) (*pipedservice.ListApplicationMostRecentDeploymentsResponse, error)
 	CreateDeployment(ctx context.Context, in *pipedservice.CreateDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.CreateDeploymentResponse, error)
 	GetDeployment(ctx context.Context, in *pipedservice.GetDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.GetDeploymentResponse, error)
 	ReportApplicationMostRecentDeployment(ctx context.Context, req *pipedservice.ReportApplicationMostRecentDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationMostRecentDeploymentResponse, error)
+	ReportApplicationLastHandledCommit(ctx context.Context, req *pipedservice.ReportApplicationLastHandledCommitRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationLastHandledCommitResponse, error)
+	ReportApplicationSyncState(ctx context.Context, req *pipedservice.ReportApplicationSyncStateRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationSyncStateResponse, error)
 	CreateDeploymentChain(ctx context.Context, in *pipedservice.CreateDeploymentChainRequest, opts ...grpc.CallOption) (*pipedservice.CreateDeploymentChainResponse, error)
+	RegisterBranchApplication(ctx context.Context, in *pipedservice.RegisterBranchApplicationRequest, opts ...grpc.CallOption) (*pipedservice.RegisterBranchApplicationResponse, error)
+	UnregisterBranchApplication(ctx context.Context, in *pipedservice.UnregisterBranchApplicationRequest, opts ...grpc.CallOption) (*pipedservice.UnregisterBranchApplicationResponse, error)
+	GetTriggeringPaused(ctx context.Context, in *pipedservice.GetTriggeringPausedRequest, opts ...grpc.CallOption) (*pipedservice.GetTriggeringPausedResponse, error)
 }
 
 type gitClient interface {
 	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+	SparseClone(ctx context.Context, repoID, remote, branch, destination string, dirs []string) (git.Repo, error)
+	ShallowClone(ctx context.Context, repoID, remote, branch, destination string, depth int) (git.Repo, error)
+	ListRemoteBranches(ctx context.Context, remote string) ([]string, error)
 }
 
 type applicationLister interface {
@@ -64,27 +93,127 @@ type notifier interface {
 	Notify(event model.NotificationEvent)
 }
 
+type driftDetector interface {
+	IsSynced(ctx context.Context, app *model.Application, repo git.Repo, commit git.Commit) (bool, error)
+}
+
 type candidate struct {
 	application *model.Application
 	kind        model.TriggerKind
 	command     model.ReportableCommand
+	// The kinds of the other candidates of the same application merged into this one.
+	mergedKinds []model.TriggerKind
+	// Human-readable reason why this candidate should be triggered,
+	// or why it should not be once it was determined not to be.
+	reason string
+	// Whether the determiners decided to trigger this candidate.
+	shouldTrigger bool
 }
 
 func (c *candidate) HasCommand() bool {
-	return c.kind == model.TriggerKind_ON_COMMAND || c.kind == model.TriggerKind_ON_CHAIN
+	return c.kind == model.TriggerKind_ON_COMMAND || c.kind == model.TriggerKind_ON_CHAIN || c.kind == model.TriggerKind_ON_EXTERNAL
+}
+
+// Reason returns the reason why this candidate should be triggered
+// including the ID of its command if any.
+func (c *candidate) Reason() string {
+	if !c.HasCommand() {
+		return c.reason
+	}
+	return fmt.Sprintf("%s, command: %s", c.reason, c.command.Id)
+}
+
+// targetCommitHash returns the commit requested by the SYNC command of this candidate.
+// Empty means the head commit should be deployed.
+func (c *candidate) targetCommitHash() string {
+	if c.kind != model.TriggerKind_ON_COMMAND {
+		return ""
+	}
+	return c.command.GetSyncApplication().GetTargetCommitHash()
+}
+
+// syncStrategy returns the strategy requested by the command of this candidate.
+// AUTO means the strategy should be determined while planning the deployment.
+func (c *candidate) syncStrategy() model.SyncStrategy {
+	switch c.kind {
+	case model.TriggerKind_ON_COMMAND, model.TriggerKind_ON_EXTERNAL:
+		return c.command.GetSyncApplication().GetSyncStrategy()
+	case model.TriggerKind_ON_CHAIN:
+		return c.command.GetChainSyncApplication().GetSyncStrategy()
+	default:
+		return model.SyncStrategy_AUTO
+	}
+}
+
+// externalSource returns the source outside of Git which requested this candidate.
+func (c *candidate) externalSource() string {
+	if c.kind != model.TriggerKind_ON_EXTERNAL {
+		return ""
+	}
+	return c.command.GetSyncApplication().GetExternalSource()
+}
+
+// description returns the note provided by the commander of this candidate.
+func (c *candidate) description() string {
+	switch c.kind {
+	case model.TriggerKind_ON_COMMAND, model.TriggerKind_ON_EXTERNAL:
+		return c.command.GetSyncApplication().GetDescription()
+	default:
+		return ""
+	}
+}
+
+// Kinds returns the kind of this candidate followed by the merged ones.
+func (c *candidate) Kinds() []model.TriggerKind {
+	return append([]model.TriggerKind{c.kind}, c.mergedKinds...)
 }
 
 type Trigger struct {
-	apiClient         apiClient
-	gitClient         gitClient
-	applicationLister applicationLister
-	commandLister     commandLister
-	notifier          notifier
-	config            *config.PipedSpec
-	commitStore       *lastTriggeredCommitStore
-	gitRepos          map[string]git.Repo
-	gracePeriod       time.Duration
-	logger            *zap.Logger
+	apiClient             apiClient
+	gitClient             gitClient
+	applicationLister     applicationLister
+	commandLister         commandLister
+	notifier              notifier
+	driftDetector         driftDetector
+	config                *config.PipedSpec
+	skipCommitPatterns    map[string]*regexp.Regexp
+	commitStore           *lastTriggeredCommitStore
+	tagStore              *lastTriggeredTagStore
+	gitRepos              map[gitRepoKey]git.Repo
+	gitRepoLocks          map[gitRepoKey]*sync.Mutex
+	sparseDirs            map[gitRepoKey]string
+	gitReposMu            sync.Mutex
+	budget                *deploymentBudget
+	outOfSyncThrottle     *triggerThrottle
+	outOfSyncCounts       *outOfSyncCounter
+	deploymentFailures    *deploymentFailureCounter
+	invalidConfigThrottle *triggerThrottle
+	invalidConfigs        *invalidConfigStore
+	headCommits           *headCommitCache
+	repoPullFailures      *repoPullFailureCounter
+	unverifiedCommits     *unverifiedCommitStore
+	unregisteredRepos     *triggerThrottle
+	removedRepos          *gitRepoKeySet
+	deprecatedConfigs     *triggerThrottle
+	candidates            *candidateStatusStore
+	decisions             *decisionStore
+	dependencies          *dependencyGraph
+	branchApps            *branchApplicationStore
+	pause                 *triggeringPause
+	repoEvents            *repoEventQueue
+	seededRepos           *gitRepoKeySet
+	deniedBranches        *gitRepoKeySet
+	rollout               *fleetRollout
+	appConfigs            *applicationConfigCache
+	appRepos              *applicationRepoStore
+	repoAppLimit          *repoApplicationLimit
+	commitDebouncer       *commitDebouncer
+	triggeredBatch        *triggeredNotificationBatch
+	schedules             *scheduleStore
+	health                *tickHealth
+	gracePeriod           time.Duration
+	logger                *zap.Logger
+	auditLogger           *zap.Logger
 }
 
 func NewTrigger(
@@ -93,31 +222,120 @@ func NewTrigger(
 	appLister applicationLister,
 	commandLister commandLister,
 	notifier notifier,
+	driftDetector driftDetector,
 	cfg *config.PipedSpec,
 	gracePeriod time.Duration,
 	logger *zap.Logger,
 ) (*Trigger, error) {
 
-	cache, err := memorycache.NewLRUCache(defaultLastTriggeredCommitCacheSize)
+	cacheSize := cfg.LastTriggeredCommitCacheSize
+	if cacheSize <= 0 {
+		cacheSize = defaultLastTriggeredCommitCacheSize
+	}
+	cache, err := memorycache.NewLRUCacheWithEvictionHandler(cacheSize, triggermetrics.EvictedLastTriggeredCommit)
 	if err != nil {
 		return nil, err
 	}
+	logger.Info(fmt.Sprintf("using the last triggered commit cache with size %d", cacheSize))
 	commitStore := &lastTriggeredCommitStore{
 		apiClient: apiClient,
+		appLister: appLister,
 		cache:     cache,
 	}
+	if path := cfg.LastTriggeredCommitStoreFile; path != "" {
+		file, values, err := openLastTriggeredCommitFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open last triggered commit store file %s: %w", path, err)
+		}
+		for id, v := range values {
+			cache.Put(id, v)
+		}
+		logger.Info(fmt.Sprintf("loaded %d last triggered commits from %s", len(values), path))
+		// The commits handled in dry-run mode are kept in memory only
+		// so that they are deployed once the dry-run mode was disabled.
+		if cfg.DryRun {
+			if err := file.Close(); err != nil {
+				return nil, fmt.Errorf("failed to close last triggered commit store file %s: %w", path, err)
+			}
+		} else {
+			commitStore.file = file
+		}
+	}
+	commitStore.reportSize()
+	// Nothing is reported in dry-run mode since the control-plane must not be changed.
+	if r := cfg.LastTriggeredCommitReport; r != nil && !cfg.DryRun {
+		commitStore.reporter = newLastTriggeredCommitReporter(apiClient, r.Interval.Duration(), r.MaxReportsPerInterval, logger)
+	}
+
+	var budget *deploymentBudget
+	if b := cfg.DeploymentBudget; b != nil {
+		budget = newDeploymentBudget(b.Limit, b.Window.Duration())
+	} else {
+		budget = newDeploymentBudget(0, 0)
+	}
+
+	var rollout *fleetRollout
+	if r := cfg.FleetRollout; r != nil {
+		rollout = newFleetRollout(r.Percentage)
+	}
+
+	skipCommitPatterns := make(map[string]*regexp.Regexp)
+	for _, r := range cfg.Repositories {
+		if r.SkipCommitMessagePattern == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(r.SkipCommitMessagePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid skipCommitMessagePattern of repository %s: %w", r.RepoID, err)
+		}
+		skipCommitPatterns[r.RepoID] = pattern
+	}
 
 	t := &Trigger{
-		apiClient:         apiClient,
-		gitClient:         gitClient,
-		applicationLister: appLister,
-		commandLister:     commandLister,
-		notifier:          notifier,
-		config:            cfg,
-		commitStore:       commitStore,
-		gitRepos:          make(map[string]git.Repo, len(cfg.Repositories)),
-		gracePeriod:       gracePeriod,
-		logger:            logger.Named("trigger"),
+		apiClient:             apiClient,
+		gitClient:             gitClient,
+		applicationLister:     appLister,
+		commandLister:         commandLister,
+		notifier:              notifier,
+		driftDetector:         driftDetector,
+		config:                cfg,
+		skipCommitPatterns:    skipCommitPatterns,
+		commitStore:           commitStore,
+		tagStore:              newLastTriggeredTagStore(),
+		gitRepos:              make(map[gitRepoKey]git.Repo, len(cfg.Repositories)),
+		gitRepoLocks:          make(map[gitRepoKey]*sync.Mutex, len(cfg.Repositories)),
+		sparseDirs:            make(map[gitRepoKey]string),
+		budget:                budget,
+		outOfSyncThrottle:     newTriggerThrottle(cfg.OutOfSyncTriggerInterval.Duration()),
+		outOfSyncCounts:       newOutOfSyncCounter(),
+		deploymentFailures:    newDeploymentFailureCounter(),
+		invalidConfigThrottle: newTriggerThrottle(cfg.InvalidConfigNotificationInterval.Duration()),
+		invalidConfigs:        newInvalidConfigStore(),
+		headCommits:           newHeadCommitCache(cfg.HeadCommitCacheTTL.Duration()),
+		repoPullFailures:      newRepoPullFailureCounter(),
+		unverifiedCommits:     newUnverifiedCommitStore(),
+		unregisteredRepos:     newTriggerThrottle(unregisteredRepoWarningInterval),
+		removedRepos:          newGitRepoKeySet(),
+		deprecatedConfigs:     newTriggerThrottle(deprecatedConfigWarningInterval),
+		candidates:            newCandidateStatusStore(),
+		decisions:             newDecisionStore(),
+		dependencies:          newDependencyGraph(),
+		branchApps:            newBranchApplicationStore(),
+		pause:                 &triggeringPause{},
+		repoEvents:            newRepoEventQueue(),
+		seededRepos:           newGitRepoKeySet(),
+		deniedBranches:        newGitRepoKeySet(),
+		rollout:               rollout,
+		appConfigs:            newApplicationConfigCache(),
+		appRepos:              newApplicationRepoStore(),
+		repoAppLimit:          newRepoApplicationLimit(),
+		commitDebouncer:       newCommitDebouncer(),
+		triggeredBatch:        newTriggeredNotificationBatch(),
+		schedules:             newScheduleStore(),
+		health:                &tickHealth{},
+		gracePeriod:           gracePeriod,
+		logger:                logger.Named("trigger"),
+		auditLogger:           logger.Named("trigger-audit"),
 	}
 
 	return t, nil
@@ -125,226 +343,1235 @@ func NewTrigger(
 
 func (t *Trigger) Run(ctx context.Context) error {
 	t.logger.Info("start running deployment trigger")
+	if f := t.commitStore.file; f != nil {
+		defer f.Close()
+	}
+	if r := t.commitStore.reporter; r != nil {
+		go r.Run(ctx)
+	}
 
 	// Pre cloning to cache the registered git repositories.
-	t.gitRepos = make(map[string]git.Repo, len(t.config.Repositories))
+	// Starting is aborted when any of them could not be cloned.
+	t.gitRepos = make(map[gitRepoKey]git.Repo, len(t.config.Repositories))
+	t.gitRepoLocks = make(map[gitRepoKey]*sync.Mutex, len(t.config.Repositories))
+	t.sparseDirs = make(map[gitRepoKey]string)
+	keys := make([]gitRepoKey, 0, len(t.config.Repositories))
 	for _, r := range t.config.Repositories {
-		repo, err := t.gitClient.Clone(ctx, r.RepoID, r.Remote, r.Branch, "")
-		if err != nil {
-			t.logger.Error(fmt.Sprintf("failed to clone git repository %s", r.RepoID), zap.Error(err))
-			return err
+		keys = append(keys, gitRepoKey{repoID: r.RepoID, branch: r.Branch})
+	}
+	if err := t.cloneGitRepos(ctx, keys); err != nil {
+		return err
+	}
+
+	// Pre cloning the other branches used by the applications too.
+	// Each distinct branch is cloned only once and the ones failed to clone here
+	// will be cloned again when their applications are checked.
+	var (
+		appKeys = make([]gitRepoKey, 0)
+		seen    = make(map[gitRepoKey]struct{})
+	)
+	for _, app := range t.applicationLister.List() {
+		key := t.gitRepoKeyOf(app)
+		if _, ok := t.gitRepos[key]; ok {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		if _, ok := t.config.GetRepository(key.repoID); !ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		appKeys = append(appKeys, key)
+	}
+	// The failures were already logged so nothing to do here.
+	_ = t.cloneGitRepos(ctx, appKeys)
+
+	// Warm the cache of the last triggered commits in bulk
+	// instead of fetching them one by one in the first checks.
+	t.warmLastTriggeredCommits(ctx, t.applicationLister.List())
+
+	// Group the repositories by their sync interval
+	// to check the ones sharing the same interval together.
+	var (
+		syncRepos  = make(map[time.Duration][]string)
+		allRepoIDs = make([]string, 0, len(t.config.Repositories))
+	)
+	for _, r := range t.config.Repositories {
+		allRepoIDs = append(allRepoIDs, r.RepoID)
+		interval := time.Duration(t.config.SyncInterval)
+		if r.SyncInterval > 0 {
+			interval = r.SyncInterval.Duration()
 		}
-		t.gitRepos[r.RepoID] = repo
+		syncRepos[interval] = append(syncRepos[interval], r.RepoID)
 	}
 
-	syncTicker := time.NewTicker(time.Duration(t.config.SyncInterval))
-	defer syncTicker.Stop()
+	var jitter float64
+	if t.config.SyncJitter != nil {
+		jitter = *t.config.SyncJitter
+	}
+	syncCh := make(chan []string)
+	for interval, repoIDs := range syncRepos {
+		go runSyncTicker(ctx, interval, syncDelay(interval, jitter), repoIDs, syncCh)
+	}
 
 	ondemandTicker := time.NewTicker(ondemandCheckInterval)
 	defer ondemandTicker.Stop()
 
-	for {
+	// The checks are done with the context cancelled only after the grace period
+	// since the trigger was stopped to let the in-flight check complete its work.
+	workCtx, cancel := withGracePeriod(ctx, t.gracePeriod)
+	defer cancel()
+
+	// The health is measured from when the loop started.
+	t.health.Done(time.Now())
+	for ctx.Err() == nil {
 		select {
-		case <-syncTicker.C:
-			var (
-				commitCandidates    = t.listCommitCandidates()
-				outOfSyncCandidates = t.listOutOfSyncCandidates()
-				candidates          = append(commitCandidates, outOfSyncCandidates...)
-			)
-			t.logger.Info(fmt.Sprintf("found %d candidates: %d commit candidates and %d out_of_sync candidates",
-				len(candidates),
-				len(commitCandidates),
-				len(outOfSyncCandidates),
-			))
-			t.checkCandidates(ctx, candidates)
+		case repoIDs := <-syncCh:
+			t.runTick("sync", func() {
+				t.checkRepos(workCtx, repoIDs)
+			})
+
+		case <-t.repoEvents.C():
+			// The repositories changed by the webhook events are checked immediately
+			// so the head commits fetched before the changes must not be reused.
+			repoIDs := t.repoEvents.Pop()
+			for _, id := range repoIDs {
+				t.headCommits.InvalidateRepo(id)
+			}
+			t.runTick("webhook", func() {
+				t.checkRepos(workCtx, repoIDs)
+			})
 
 		case <-ondemandTicker.C:
-			candidates := t.listCommandCandidates()
-			t.logger.Info(fmt.Sprintf("found %d command candidates", len(candidates)))
-			t.checkCandidates(ctx, candidates)
+			t.runTick("ondemand", func() {
+				t.refreshLastTriggeredCommits(workCtx)
+				candidates := t.listCommandCandidates()
+				t.logger.Info(fmt.Sprintf("found %d command candidates", len(candidates)))
+				t.reportCandidates(allRepoIDs, candidates, model.TriggerKind_ON_COMMAND, model.TriggerKind_ON_CHAIN, model.TriggerKind_ON_EXTERNAL)
+				t.checkCommandCandidates(workCtx, candidates)
+			})
 
 		case <-ctx.Done():
-			t.logger.Info("deployment trigger has been stopped")
-			return nil
 		}
 	}
+
+	t.logger.Info("deployment trigger has been stopped")
+	return nil
 }
 
-func (t *Trigger) checkCandidates(ctx context.Context, cs []candidate) (err error) {
-	// Group candidates by repository to reduce the number of Git operations on each repo.
-	csm := make(map[string][]candidate)
+// withGracePeriod returns a new context which is cancelled
+// when the given grace period has elapsed since the given context was done.
+func withGracePeriod(ctx context.Context, gracePeriod time.Duration) (context.Context, context.CancelFunc) {
+	gctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-gctx.Done():
+			return
+		}
+		timer := time.NewTimer(gracePeriod)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-gctx.Done():
+		}
+	}()
+	return gctx, cancel
+}
+
+// checkCommandCandidates checks the given candidates having a command
+// and makes sure that every command gets its status reported
+// even if the check was aborted because the grace period for stopping has elapsed.
+// The commands left unreported by the completed check are kept to be handled at the subsequent checks.
+func (t *Trigger) checkCommandCandidates(ctx context.Context, cs []candidate) {
+	var (
+		mu       sync.Mutex
+		reported = make(map[string]struct{}, len(cs))
+	)
+	for i := range cs {
+		if !cs[i].HasCommand() {
+			continue
+		}
+		var (
+			id     = cs[i].command.Id
+			report = cs[i].command.Report
+		)
+		cs[i].command.Report = func(ctx context.Context, status model.CommandStatus, metadata map[string]string, output []byte) error {
+			mu.Lock()
+			reported[id] = struct{}{}
+			mu.Unlock()
+
+			// The status of the command whose deployment was already triggered must be reported
+			// even after stopping, otherwise that command would trigger again after restarting.
+			if ctx.Err() != nil {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(context.Background(), commandReportTimeout)
+				defer cancel()
+			}
+			return report(ctx, status, metadata, output)
+		}
+	}
+
+	t.checkCandidates(ctx, cs)
+	if ctx.Err() == nil {
+		return
+	}
+
 	for _, c := range cs {
-		repoId := c.application.GitPath.Repo.Id
-		if _, ok := csm[repoId]; !ok {
-			csm[repoId] = []candidate{c}
+		if !c.HasCommand() {
+			continue
+		}
+		if _, ok := reported[c.command.Id]; ok {
 			continue
 		}
-		csm[repoId] = append(csm[repoId], c)
+		t.logger.Warn("reporting the command as failed since piped was stopped while handling it",
+			zap.String("command", c.command.Id),
+			zap.String("app-id", c.application.Id),
+		)
+		t.reportCommandFailed(ctx, c, "piped was stopped before the command was handled")
+	}
+}
+
+// checkRepos finds and checks the candidates in the given repositories.
+func (t *Trigger) checkRepos(ctx context.Context, repoIDs []string) {
+	repos := makeRepoSet(repoIDs)
+	// The applications of the new branches are registered here to be deployed
+	// once they were listed while the ones of the deleted branches are no longer checked.
+	t.syncBranchApplications(ctx, repos)
+	t.outOfSyncCounts.Observe(t.filterAppsByRepo(t.applicationLister.List(), repos))
+	var (
+		commitCandidates    = t.listCommitCandidates(repos)
+		outOfSyncCandidates = t.listOutOfSyncCandidates(repos)
+		scheduleCandidates  = t.listScheduleCandidates(repos)
+		candidates          = t.filterUnregisteredBranchApps(append(append(commitCandidates, outOfSyncCandidates...), scheduleCandidates...))
+	)
+	t.logger.Info(fmt.Sprintf("found %d candidates in %d repositories: %d commit candidates, %d out_of_sync candidates and %d schedule candidates",
+		len(candidates),
+		len(repoIDs),
+		len(commitCandidates),
+		len(outOfSyncCandidates),
+		len(scheduleCandidates),
+	))
+	t.reportCandidates(repoIDs, candidates, model.TriggerKind_ON_COMMIT, model.TriggerKind_ON_OUT_OF_SYNC, model.TriggerKind_ON_SCHEDULE)
+	apps := make([]*model.Application, 0, len(candidates))
+	for _, c := range candidates {
+		apps = append(apps, c.application)
+	}
+	t.warmLastTriggeredCommits(ctx, apps)
+	// The pending commands are checked together to let them be merged with the other candidates
+	// of the same applications instead of triggering another deployment in the on-demand check.
+	candidates = append(candidates, t.filterCandidatesByRepo(t.listCommandCandidates(), repos)...)
+	t.checkCandidates(ctx, candidates)
+}
+
+// warmLastTriggeredCommits fetches the last triggered commits of the given applications
+// missing in the cache at once. The failures are only logged
+// since the commits are fetched one by one on demand anyway.
+func (t *Trigger) warmLastTriggeredCommits(ctx context.Context, apps []*model.Application) {
+	if len(apps) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(apps))
+	for _, app := range apps {
+		ids = append(ids, app.Id)
+	}
+	n, err := t.commitStore.Warm(ctx, ids)
+	if err != nil {
+		t.logger.Warn("failed to warm the cache of last triggered commits", zap.Error(err))
+		return
+	}
+	if n > 0 {
+		t.logger.Info(fmt.Sprintf("cached the last triggered commits of %d applications in bulk", n))
+	}
+}
+
+// syncDelay returns a random duration up to the given fraction of the interval.
+func syncDelay(interval time.Duration, jitter float64) time.Duration {
+	max := int64(float64(interval) * jitter)
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(max))
+}
+
+// runSyncTicker sends the given repositories to the channel at every interval
+// to let them be checked for the new commits and the configuration drifts.
+// The ticker is started after the given delay to spread the syncs of many pipeds over time.
+func runSyncTicker(ctx context.Context, interval, delay time.Duration, repoIDs []string, ch chan<- []string) {
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			select {
+			case ch <- repoIDs:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
 	}
+}
+
+func (t *Trigger) filterCandidatesByRepo(cs []candidate, repos map[string]struct{}) []candidate {
+	filtered := make([]candidate, 0, len(cs))
+	for _, c := range cs {
+		if _, ok := repos[t.repoIDOf(c.application)]; ok {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func (t *Trigger) filterAppsByRepo(apps []*model.Application, repos map[string]struct{}) []*model.Application {
+	filtered := make([]*model.Application, 0, len(apps))
+	for _, app := range apps {
+		if _, ok := repos[t.repoIDOf(app)]; ok {
+			filtered = append(filtered, app)
+		}
+	}
+	return filtered
+}
+
+func makeRepoSet(repoIDs []string) map[string]struct{} {
+	repos := make(map[string]struct{}, len(repoIDs))
+	for _, id := range repoIDs {
+		repos[id] = struct{}{}
+	}
+	return repos
+}
 
-	// Iterate each repository and check its candidates.
-	// Only the last error will be returned.
-	for repoID, cs := range csm {
-		if e := t.checkRepoCandidates(ctx, repoID, cs); e != nil {
-			t.logger.Error(fmt.Sprintf("failed while checking applications in repo %s", repoID), zap.Error(e))
-			err = e
+func (t *Trigger) checkCandidates(ctx context.Context, cs []candidate) (err error) {
+	start := time.Now()
+	defer func() {
+		status := triggermetrics.StatusSuccess
+		if err != nil {
+			status = triggermetrics.StatusFailure
+		}
+		triggermetrics.CheckedCandidates(status, time.Since(start))
+	}()
+	defer t.reportDeploymentBudget()
+
+	cs = t.skipPausedCandidates(ctx, cs)
+
+	// Outside the trigger windows, only the candidates having a command are checked.
+	// The others are left unhandled to be found again in the checks after the next window opens.
+	if !t.isInTriggerWindows(time.Now()) {
+		filtered := make([]candidate, 0, len(cs))
+		for _, c := range cs {
+			if c.HasCommand() {
+				filtered = append(filtered, c)
+			}
 		}
+		if n := len(cs) - len(filtered); n > 0 {
+			t.logger.Info(fmt.Sprintf("skipped %d candidates since it is outside of the trigger windows", n))
+		}
+		cs = filtered
+	}
+
+	cs = t.skipUnregisteredRepos(cs)
+
+	// The candidates are listed in no particular order,
+	// so sort them to check the applications in the same order at every check.
+	sortCandidatesByApplication(cs)
+
+	// Merge the candidates of the same application to trigger at most one deployment for it.
+	cs = mergeCandidates(cs)
+
+	// Let the candidates deferred by the deployment budget be checked first.
+	t.budget.Prioritize(cs)
+
+	// The number of deployments triggered in this check is limited to avoid overwhelming the control-plane.
+	// The exceeded candidates are left unhandled to be found again in the next check.
+	limit := newTickLimit(t.config.MaxTriggersPerTick)
+	if t.config.MaxTriggersPerTick > 0 {
+		sortCandidatesForTickLimit(cs)
+		defer func() {
+			if n := limit.Deferred(); n > 0 {
+				t.logger.Info(fmt.Sprintf("deferred %d candidates to the next check since the limit of %d triggers per check was reached", n, t.config.MaxTriggersPerTick))
+			}
+		}()
+	}
+
+	// Group candidates by repository branch to reduce the number of Git operations on each repo.
+	// The branches are checked in the order of their first candidate.
+	var (
+		csm      = make(map[gitRepoKey][]candidate)
+		repoKeys = make([]gitRepoKey, 0)
+	)
+	for _, c := range cs {
+		key := t.gitRepoKeyOf(c.application)
+		if _, ok := csm[key]; !ok {
+			csm[key] = []candidate{c}
+			repoKeys = append(repoKeys, key)
+			continue
+		}
+		csm[key] = append(csm[key], c)
+	}
+
+	var (
+		numRepos   = len(repoKeys)
+		numWorkers = t.config.TriggerConcurrency
+		repoCh     = make(chan gitRepoKey, numRepos)
+		errCh      = make(chan error, numRepos)
+	)
+	if numWorkers <= 0 {
+		numWorkers = 1
+	}
+	if numWorkers > numRepos {
+		numWorkers = numRepos
+	}
+
+	// Start some workers to check the repositories concurrently.
+	// Each repository branch is handled by only one worker at a time.
+	for w := 0; w < numWorkers; w++ {
+		go func() {
+			for key := range repoCh {
+				e := func() (err error) {
+					// A panic while checking one repository must not stop the worker checking the others.
+					defer t.recoverRepoPanic(key, csm[key], &err)
+					return t.checkRepoCandidates(ctx, key, csm[key], limit)
+				}()
+				if e != nil {
+					t.logger.Error(fmt.Sprintf("failed while checking applications in repo %s", key.repoID), zap.String("branch", key.branch), zap.Error(e))
+					e = fmt.Errorf("failed while checking applications in repo %s: %w", key.repoID, e)
+				}
+				errCh <- e
+			}
+		}()
+	}
+
+	for _, key := range repoKeys {
+		repoCh <- key
+	}
+	close(repoCh)
+
+	// Wait and collect the errors of all repositories.
+	for i := 0; i < numRepos; i++ {
+		err = multierr.Append(err, <-errCh)
 	}
 	return
 }
 
-func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []candidate) error {
-	gitRepo, branch, headCommit, err := t.updateRepoToLatest(ctx, repoID)
+// sortCandidatesByApplication sorts the given candidates by the name of their application
+// and then its ID. The candidates of the same application are kept in the given order.
+// The subsequent stable sorts such as by the deployment budget and the tick limit
+// keep this order among the candidates of the same priority.
+func sortCandidatesByApplication(cs []candidate) {
+	sort.SliceStable(cs, func(i, j int) bool {
+		ai, aj := cs[i].application, cs[j].application
+		if ai.Name != aj.Name {
+			return ai.Name < aj.Name
+		}
+		return ai.Id < aj.Id
+	})
+}
+
+func (t *Trigger) checkRepoCandidates(ctx context.Context, key gitRepoKey, cs []candidate, limit *tickLimit) (err error) {
+	ctx, span := tracer.Start(ctx, "checkRepoCandidates", trace.WithAttributes(
+		attribute.String("repo.id", key.repoID),
+		attribute.String("repo.branch", key.branch),
+		attribute.Int("candidates", len(cs)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	// The branches configured not to be deployed are skipped before pulling them
+	// since they are used only by the misconfigured applications.
+	if t.config.IsDeniedBranch(key.repoID, key.branch) {
+		if t.deniedBranches.Add(key) {
+			t.logger.Warn(fmt.Sprintf("skipped checking the candidates in repo %s since the branch %s is denied", key.repoID, key.branch))
+		}
+		// The commands are reported as failed since they will never be handled.
+		reason := fmt.Sprintf("the branch %s is denied", key.branch)
+		for _, c := range cs {
+			t.reportCommandFailed(ctx, c, reason)
+			t.auditDecision(c, key, "", "", reason)
+		}
+		return nil
+	}
+
+	// The repository having too many applications is likely misconfigured,
+	// so none of them is triggered to protect the control-plane from a storm of deployments.
+	if reason, exceeded := t.exceedApplicationLimit(key.repoID); exceeded {
+		for _, c := range cs {
+			t.reportCommandFailed(ctx, c, reason)
+			t.auditDecision(c, key, "", "", reason)
+		}
+		return nil
+	}
+
+	// Git operations must be serialized on the same repository branch
+	// since its local data is shared between all of them.
+	mu := t.gitRepoLock(key)
+	mu.Lock()
+	defer mu.Unlock()
+	// The triggered deployments collected while checking are notified before
+	// releasing the lock so that the batches of the same key are not mixed.
+	defer t.flushDeploymentTriggeredNotifications(key)
+
+	var (
+		repoID = key.repoID
+		branch = key.branch
+	)
+	gitRepo, headCommit, err := t.updateRepoToLatest(ctx, key)
 	if err != nil {
 		// TODO: Find a better way to skip the CANCELLED error log while shutting down.
 		if ctx.Err() != context.Canceled {
 			t.logger.Error(fmt.Sprintf("failed to update git repository %s to latest", repoID), zap.Error(err))
+			t.handleRepoPullFailure(key, err)
+			t.reportDeploymentCreationFailures(cs, triggermetrics.FailureReasonGit)
 		}
 		return err
 	}
+	t.repoPullFailures.Reset(key)
 
 	ds := &determiners{
-		onCommand:   NewOnCommandDeterminer(),
-		onOutOfSync: NewOnOutOfSyncDeterminer(t.apiClient),
-		onCommit:    NewOnCommitDeterminer(gitRepo, headCommit.Hash, t.commitStore, t.logger),
-		onChain:     NewOnChainDeterminer(),
+		onCommand:  NewOnCommandDeterminer(),
+		onCommit:   NewOnCommitDeterminer(gitRepo, headCommit.Hash, t.submodulesEnabled(key.repoID), t.shallowCloned(key.repoID), t.commitStore, t.logger),
+		onChain:    NewOnChainDeterminer(),
+		onExternal: NewOnExternalDeterminer(),
+		onSchedule: NewOnScheduleDeterminer(t.schedules, time.Now()),
+	}
+
+	// The applications in the repository using tags are deployed at the newest tag
+	// instead of the branch head, so the tagged commit is used as the head commit from here.
+	var tag *git.Tag
+	if pattern := t.tagPatternOf(key); pattern != "" {
+		tag, err = t.checkoutLatestTag(ctx, gitRepo, pattern)
+		if err != nil {
+			t.logger.Error(fmt.Sprintf("failed to checkout the latest tag of git repository %s", repoID), zap.Error(err))
+			t.reportDeploymentCreationFailures(cs, triggermetrics.FailureReasonGit)
+			return err
+		}
+		if tag != nil {
+			if t.submodulesEnabled(repoID) {
+				if err = gitRepo.UpdateSubmodules(ctx); err != nil {
+					t.logger.Error(fmt.Sprintf("failed to update the submodules of git repository %s at tag %s", repoID, tag.Name), zap.Error(err))
+					t.reportDeploymentCreationFailures(cs, triggermetrics.FailureReasonGit)
+					return err
+				}
+			}
+			if headCommit, err = gitRepo.GetLatestCommit(ctx); err != nil {
+				t.logger.Error(fmt.Sprintf("failed to get the commit of tag %s in git repository %s", tag.Name, repoID), zap.Error(err))
+				t.reportDeploymentCreationFailures(cs, triggermetrics.FailureReasonGit)
+				return err
+			}
+		}
+		ds.onCommit = NewOnTagDeterminer(tag, t.tagStore, t.commitStore, t.logger)
+	}
+	span.SetAttributes(attribute.String("commit", headCommit.Hash))
+
+	// The commits configured not to be deployed must not trigger any deployment
+	// even by the commands or the configuration drifts since they deploy the head commit too.
+	if t.isDeniedCommit(repoID, headCommit.Hash) {
+		t.logger.Warn(fmt.Sprintf("skipped checking %d candidates in repo %s since the head commit is denied", len(cs), repoID),
+			zap.String("branch", branch),
+			zap.String("commit", headCommit.Hash),
+		)
+		reason := fmt.Sprintf("head commit %s is denied", headCommit.Hash)
+		for _, c := range cs {
+			t.reportCommandFailed(ctx, c, reason)
+			t.auditDecision(c, key, headCommit.Hash, "", reason)
+		}
+		return nil
+	}
+	// The commits not signed by the trusted keys must not trigger any deployment either
+	// to prevent deploying the commits pushed by the ones stealing the write access to the repository.
+	if t.requiresSignedCommits(repoID) {
+		if ok, reason := verifyCommitSignature(ctx, gitRepo, headCommit); !ok {
+			t.handleUnverifiedCommit(key, headCommit, reason)
+			for _, c := range cs {
+				t.reportCommandFailed(ctx, c, reason)
+				t.auditDecision(c, key, headCommit.Hash, "", reason)
+			}
+			return nil
+		}
+		t.unverifiedCommits.Delete(key)
+	}
+
+	// The commits pushed by CI bots, for example, are allowed to be excluded by their message
+	// from triggering while the explicit commands and configuration drifts still trigger.
+	if pattern := t.skipCommitMessagePatternOf(key); pattern != nil && pattern.MatchString(headCommit.Message) {
+		t.logger.Info(fmt.Sprintf("skipped the commit-based triggering in repo %s since the message of head commit matched the pattern %q", repoID, pattern),
+			zap.String("branch", branch),
+			zap.String("commit", headCommit.Hash),
+		)
+		ds.onCommit = skipDeterminer{reason: fmt.Sprintf("the message of head commit matched the pattern %q", pattern)}
+	}
+	// The head commit authored by the ones not allowed must not be deployed automatically
+	// even by the configuration drifts while the explicit commands still trigger.
+	authorAllowed := t.isAllowedCommitAuthor(repoID, headCommit)
+	if !authorAllowed {
+		t.logger.Info(fmt.Sprintf("suppressed the automatic triggering in repo %s since the author of head commit is not allowed", repoID),
+			zap.String("branch", branch),
+			zap.String("commit", headCommit.Hash),
+			zap.String("author", headCommit.AuthorEmail),
+		)
+	}
+	var manifestChecker IdenticalManifestChecker
+	if t.driftDetector != nil {
+		manifestChecker = &identicalManifestChecker{
+			detector: t.driftDetector,
+			repo:     gitRepo,
+			commit:   headCommit,
+			logger:   t.logger,
+		}
+	}
+	ds.onOutOfSync = NewOnOutOfSyncDeterminer(t.apiClient, t.outOfSyncCounts, t.deploymentFailures, manifestChecker, headCommit.Hash)
+
+	// The new commits are deployed only after the repository became quiet
+	// to avoid deploying mid-way through a burst of related commits.
+	cs = t.debounceCandidates(key, headCommit, cs, time.Now())
+
+	// The last triggered commits of the applications moved from another repository cannot be compared with the new commits.
+	t.resetMovedApplications(ctx, key, gitRepo, cs)
+
+	// The applications having no deployment are not triggered at the first check
+	// to avoid deploying all of them at once when piped started to handle the existing repository.
+	cs = t.seedNeverDeployedApplications(ctx, key, headCommit, cs)
+
+	// Keep the candidates found at this check to let them be inspected via the admin server.
+	now := time.Now()
+	statuses := make([]CandidateStatus, 0, len(cs))
+	for _, c := range cs {
+		statuses = append(statuses, CandidateStatus{
+			ApplicationID:   c.application.Id,
+			ApplicationName: c.application.Name,
+			Kind:            c.kind.String(),
+			RepoID:          repoID,
+			Branch:          branch,
+			HeadCommit:      headCommit.Hash,
+			CoolingDown:     t.isCoolingDown(c, now),
+			CheckedAt:       now,
+		})
+	}
+	t.candidates.Set(key, statuses)
+
+	// Loading the configurations and running the determiners are unnecessary
+	// when the head commit has already been triggered for all candidates.
+	if t.skipUnchangedHead(ctx, key, headCommit.Hash, cs) {
+		return nil
 	}
 	triggered := make(map[string]struct{})
 
+	// Group candidates by application to evaluate the trigger condition
+	// against all candidates of the same application as a unit.
+	appCandidates := make(map[string][]candidate)
 	for _, c := range cs {
-		app := c.application
+		appCandidates[c.application.Id] = append(appCandidates[c.application.Id], c)
+	}
+	conditionEvaluated := make(map[string]struct{})
+	// Only a part of the applications are triggered by the new commit until its rollout was promoted.
+	heldByRollout := make([]string, 0)
+	defer func() { t.recordHeldRollout(key, headCommit.Hash, heldByRollout) }()
 
-		// Avoid triggering multiple deployments for the same application in the same iteration.
-		if _, ok := triggered[app.Id]; ok {
-			continue
+	for _, c := range cs {
+		func() {
+			// A panic while handling one candidate must not stop handling the others in this check.
+			defer t.recoverCandidatePanic(ctx, key, c)
+
+			app := c.application
+
+			// Avoid triggering multiple deployments for the same application in the same iteration.
+			if _, ok := triggered[app.Id]; ok {
+				return
+			}
+			if _, ok := conditionEvaluated[app.Id]; ok {
+				return
+			}
+
+			if !authorAllowed && !c.HasCommand() {
+				t.auditDecision(c, key, headCommit.Hash, "", fmt.Sprintf("the author %q of head commit is not allowed to trigger automatically", headCommit.AuthorEmail))
+				return
+			}
+
+			// The candidates suppressed by the trigger rule of the application's environment are left unhandled
+			// to be found again in the checks after its window opens.
+			if !t.isEnvironmentOpen(c, time.Now()) {
+				t.logger.Info(fmt.Sprintf("skipped application %s since it is outside of the trigger windows of its environment", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("env-id", app.EnvId),
+				)
+				t.auditDecision(c, key, headCommit.Hash, "", "outside of the trigger windows of the environment")
+				return
+			}
+
+			// The commands for the disabled application are handled as usual
+			// unless piped was configured to reject them.
+			if app.Disabled && c.HasCommand() && t.config.RejectCommandsForDisabledApplications {
+				msg := fmt.Sprintf("rejected the command for application %s since it is disabled", app.Name)
+				t.logger.Info(msg,
+					zap.String("app-id", app.Id),
+					zap.String("command", c.command.Id),
+				)
+				t.reportCommandFailed(ctx, c, msg)
+				t.auditDecision(c, key, headCommit.Hash, "", msg)
+				return
+			}
+
+			appCfg, cfgFile, err := t.loadApplicationConfiguration(gitRepo.GetPath(), app, headCommit.Hash)
+			if err != nil {
+				t.logger.Error("failed to load application config file",
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("commit", headCommit.Hash),
+					zap.Error(err),
+				)
+				// Notifying this event every time may cause annoying
+				// when one application is missing or having an invalid configuration file,
+				// so it is notified at most once per the configured interval for each application.
+				if now := time.Now(); t.invalidConfigThrottle.Allow(app.Id, now) {
+					t.invalidConfigThrottle.Record(app.Id, now)
+					t.notifyDeploymentTriggerSkippedInvalidConfig(app, err, headCommit)
+				}
+				t.markInvalidConfig(ctx, app, fmt.Sprintf("failed to load application config file: %v", err))
+				t.auditDecision(c, key, headCommit.Hash, "", fmt.Sprintf("failed to load application config file: %v", err))
+				triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonConfig)
+				return
+			}
+			t.schedules.Observe(app.Id, appCfg.Trigger.OnSchedule, time.Now())
+			if msg, ok := config.APIVersionDeprecation(cfgFile.apiVersion); ok {
+				if now := time.Now(); t.deprecatedConfigs.Allow(app.Id, now) {
+					t.deprecatedConfigs.Record(app.Id, now)
+					t.logger.Warn(fmt.Sprintf("application config file %s uses the deprecated apiVersion: %s", cfgFile.filename, msg),
+						zap.String("app", app.Name),
+						zap.String("app-id", app.Id),
+					)
+				}
+			}
+			usingFallbackConfig := cfgFile.filename != app.GitPath.GetApplicationConfigFilename()
+			if usingFallbackConfig {
+				t.logger.Info(fmt.Sprintf("loaded the fallback application config file %s since the registered one %s was not found",
+					cfgFile.filename,
+					app.GitPath.GetApplicationConfigFilename(),
+				),
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("commit", headCommit.Hash),
+				)
+			}
+
+			var (
+				shouldTrigger bool
+				cond          = appCfg.Trigger.Condition
+			)
+			if cond != nil {
+				conditionEvaluated[app.Id] = struct{}{}
+			}
+			shouldTrigger, c, err = t.determineWithRetry(ctx, app, func() (bool, candidate, error) {
+				if cond == nil {
+					return determineCandidate(ctx, ds, c, appCfg)
+				}
+				e := newConditionEvaluator(ds, app, appCfg, appCandidates[app.Id])
+				ok, err := e.Evaluate(ctx, *cond)
+				if !ok {
+					return false, c, err
+				}
+				triggered, _ := e.Candidate()
+				return true, triggered, nil
+			})
+			// The failures caused by the application configuration are not notified
+			// since they have to be fixed by the users anyway, instead the application is marked as INVALID_CONFIG.
+			var cfgErr *ConfigError
+			if errors.As(err, &cfgErr) {
+				msg := fmt.Sprintf("failed to determine whether application %s should be triggered or not due to its configuration: %s", app.Name, err)
+				t.logger.Warn(msg, zap.String("app-id", app.Id))
+				t.markInvalidConfig(ctx, app, cfgErr.Error())
+				t.auditDecision(c, key, headCommit.Hash, "", msg)
+				triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonConfig)
+				return
+			}
+			if err != nil {
+				msg := fmt.Sprintf("failed while determining whether application %s should be triggered or not: %s", app.Name, err)
+				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+				t.logger.Error(msg, zap.Error(err))
+				t.auditDecision(c, key, headCommit.Hash, "", msg)
+				triggermetrics.DeploymentCreationFailed(app.Id, failureReasonOf(err))
+				return
+			}
+			t.unmarkInvalidConfig(ctx, app)
+
+			if !shouldTrigger {
+				// The last triggered commit must not be advanced while the condition is unsatisfied
+				// because the commit changes have to be kept until the other parts of the condition are satisfied.
+				if cond == nil {
+					t.commitStore.Put(app.Id, headCommit.Hash)
+				}
+				t.budget.Forget(app.Id)
+				reason := c.reason
+				if cond != nil {
+					reason = fmt.Sprintf("trigger condition %s was not satisfied", cond)
+				}
+				if reason == "" {
+					reason = "no trigger was satisfied"
+				}
+				// All candidates of the application were evaluated together by the condition,
+				// so their commands are reported as failed instead of being left unhandled.
+				if cond != nil {
+					for _, ac := range appCandidates[app.Id] {
+						t.reportCommandFailed(ctx, ac, reason)
+					}
+				}
+				t.auditDecision(c, key, headCommit.Hash, "", reason)
+				return
+			}
+
+			// The strategy requested by the command must be a known one to plan the deployment with it.
+			if strategy := c.syncStrategy(); !strategy.IsValid() {
+				msg := fmt.Sprintf("invalid sync strategy %d was requested for application %s", strategy, app.Id)
+				t.logger.Error(msg, zap.String("command", c.command.Id))
+				t.reportCommandFailed(ctx, c, msg)
+				t.auditDecision(c, key, headCommit.Hash, "", msg)
+				return
+			}
+
+			// The SYNC command can specify the commit to deploy instead of the head one, e.g. to roll back.
+			// The last triggered commit is still the head one to not trigger the newer commits again.
+			commit := headCommit
+			if hash := c.targetCommitHash(); hash != "" {
+				if commit, err = gitRepo.GetCommitForRev(ctx, hash); err != nil {
+					msg := fmt.Sprintf("failed to find the target commit %s of application %s: %v", hash, app.Id, err)
+					t.logger.Error(msg, zap.Error(err))
+					t.reportCommandFailed(ctx, c, msg)
+					t.auditDecision(c, key, hash, "", msg)
+					triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonGit)
+					return
+				}
+				c.reason = fmt.Sprintf("%s to deploy commit %s", c.reason, commit.Hash)
+			}
+
+			// Suppress the automatic triggers while the application is cooling down from its last deployment.
+			// The last triggered commit is not updated here to let the changes be handled after the cooldown.
+			if t.isCoolingDown(c, time.Now()) {
+				t.logger.Info("skipped triggering a new deployment since the application is cooling down from its last deployment",
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("kind", c.kind.String()),
+				)
+				t.auditDecision(c, key, commit.Hash, "", "the application is cooling down from its last deployment")
+				return
+			}
+
+			// The triggers by configuration drift are throttled separately
+			// to break the loop of the flapping drift detection and the deployments triggered by it.
+			if c.kind == model.TriggerKind_ON_OUT_OF_SYNC && !t.outOfSyncThrottle.Allow(app.Id, time.Now()) {
+				t.logger.Info(fmt.Sprintf("skipped triggering a new deployment for the configuration drift since the last one was triggered within %v", t.config.OutOfSyncTriggerInterval.Duration()),
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+				)
+				t.auditDecision(c, key, commit.Hash, "", "the deployment for the configuration drift was triggered recently")
+				return
+			}
+
+			// The held applications are left unhandled to be triggered at the checks after the rollout was promoted.
+			if t.holdsFleetRollout(key, headCommit.Hash, c) {
+				heldByRollout = append(heldByRollout, app.Id)
+				t.auditDecision(c, key, commit.Hash, "", "held until the rollout of the head commit is promoted")
+				return
+			}
+
+			// The new commits touching the application are deployed one by one in order if configured.
+			// The last triggered commit is advanced after each of them to continue from there at the subsequent checks.
+			commits, truncated := []git.Commit{commit}, false
+			if c.kind == model.TriggerKind_ON_COMMIT {
+				if l, ok := ds.onCommit.(commitRangeLister); ok {
+					if rangeCommits, more := l.TargetCommits(app.Id); len(rangeCommits) > 0 {
+						commits, truncated = rangeCommits, more
+					}
+				}
+			}
+			for i, target := range commits {
+				// The rest of the new commits after the last one touching the application
+				// are handled together with it.
+				handledCommit := target.Hash
+				if i == len(commits)-1 && !truncated {
+					handledCommit = headCommit.Hash
+				}
+				cc := c
+				if len(commits) > 1 || truncated {
+					cc.reason = fmt.Sprintf("commit %s touched the application, %s", target.Hash, c.reason)
+				}
+				if !t.triggerCommit(ctx, key, gitRepo, cc, appCfg, cfgFile, target, handledCommit, tag, ds.onCommit, limit) {
+					break
+				}
+				triggered[app.Id] = struct{}{}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// triggerCommit triggers a new deployment of the given candidate at the given commit
+// and records the handled commit as the last triggered one of its application.
+// False is returned when it was not triggered.
+func (t *Trigger) triggerCommit(
+	ctx context.Context,
+	key gitRepoKey,
+	repo git.Repo,
+	c candidate,
+	appCfg *config.GenericApplicationSpec,
+	cfgFile applicationConfigFile,
+	commit git.Commit,
+	handledCommit string,
+	tag *git.Tag,
+	onCommit Determiner,
+	limit *tickLimit,
+) bool {
+	app := c.application
+
+	// Defer this application until its dependencies have successfully deployed the same commit.
+	state, reason, err := t.checkAppDependencies(ctx, app, appCfg.Trigger.DependsOn, commit.Hash)
+	if err != nil {
+		t.logger.Error("failed to check the dependencies of application",
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.Error(err),
+		)
+		t.auditDecision(c, key, commit.Hash, "", err.Error())
+		triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonAPI)
+		return false
+	}
+	switch state {
+	case dependenciesPending:
+		t.logger.Info("deferred triggering a new deployment since "+reason,
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+		)
+		t.auditDecision(c, key, commit.Hash, "", reason)
+		return false
+	case dependenciesFailed:
+		// The changes are marked as handled to not notify the same failure at every check.
+		msg := fmt.Sprintf("skipped triggering application %s since %s", app.Name, reason)
+		t.logger.Warn(msg, zap.String("app-id", app.Id))
+		t.notifyDeploymentTriggerFailed(app, appCfg, msg, commit)
+		t.reportCommandFailed(ctx, c, msg)
+		t.auditDecision(c, key, commit.Hash, "", msg)
+		t.commitStore.Put(app.Id, handledCommit)
+		t.budget.Forget(app.Id)
+		return false
+	}
+
+	// The pre-trigger hook is run before consuming the limits
+	// so that a denied candidate does not take the slot of the others.
+	if hook := appCfg.Trigger.PreTriggerHook; hook != nil {
+		appDir := filepath.Join(repo.GetPath(), app.GitPath.Path)
+		if err := runPreTriggerHook(ctx, hook, appDir, c, commit.Hash); err != nil {
+			msg := fmt.Sprintf("triggering was denied by the pre-trigger hook: %v", err)
+			t.logger.Info(msg,
+				zap.String("app", app.Name),
+				zap.String("app-id", app.Id),
+				zap.String("kind", c.kind.String()),
+			)
+			t.reportCommandFailed(ctx, c, msg)
+			t.auditDecision(c, key, commit.Hash, "", msg)
+			return false
 		}
+	}
 
-		appCfg, err := loadApplicationConfiguration(gitRepo.GetPath(), app)
-		if err != nil {
-			t.logger.Error("failed to load application config file",
+	if !limit.TryAcquire() {
+		t.auditDecision(c, key, commit.Hash, "", "the limit of triggers per check was reached")
+		return false
+	}
+
+	// Defer this application to the subsequent checks when the deployment budget was exhausted.
+	// Nothing is marked as handled here so this candidate will be found again.
+	if !t.budget.TryConsume(c, time.Now()) {
+		t.logger.Info("deferred triggering a new deployment since the deployment budget was exhausted",
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.String("kind", c.kind.String()),
+		)
+		t.auditDecision(c, key, commit.Hash, "", "the deployment budget was exhausted")
+		return false
+	}
+
+	var (
+		commander                 string
+		strategy                  model.SyncStrategy
+		strategySummary           string
+		deploymentChainID         string
+		deploymentChainBlockIndex uint32
+	)
+
+	switch c.kind {
+	case model.TriggerKind_ON_COMMAND:
+		if appCfg.Trigger.Pinned {
+			t.logger.Info("triggering a new deployment for the pinned application since a SYNC command was received",
 				zap.String("app", app.Name),
 				zap.String("app-id", app.Id),
-				zap.String("commit", headCommit.Hash),
-				zap.Error(err),
+				zap.String("command", c.command.Id),
 			)
-			// Do not notify this event to external services because it may cause annoying
-			// when one application is missing or having an invalid configuration file.
-			// So instead of notifying this as a notification,
-			// we should show this problem on the web with a status like INVALID_CONFIG.
-			//
-			// t.notifyDeploymentTriggerFailed(app, msg, headCommit)
-			continue
+		}
+		strategy = c.syncStrategy()
+		commander = c.command.Commander
+		if strategy == model.SyncStrategy_QUICK_SYNC {
+			strategySummary = "Quick sync because piped received a command from user via web console or pipectl"
+		} else {
+			strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
 		}
 
-		shouldTrigger, err := ds.Determiner(c.kind).ShouldTrigger(ctx, app, appCfg)
-		if err != nil {
-			msg := fmt.Sprintf("failed while determining whether application %s should be triggered or not: %s", app.Name, err)
-			t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+	case model.TriggerKind_ON_CHAIN:
+		strategy = c.syncStrategy()
+		commander = c.command.Commander
+		strategySummary = "Sync application in chain"
+		deploymentChainID = c.command.GetChainSyncApplication().DeploymentChainId
+		deploymentChainBlockIndex = c.command.GetChainSyncApplication().BlockIndex
+
+	case model.TriggerKind_ON_EXTERNAL:
+		strategy = c.syncStrategy()
+		commander = c.command.Commander
+		strategySummary = fmt.Sprintf("Sync the head commit because piped received a request from %s", c.externalSource())
+
+	case model.TriggerKind_ON_OUT_OF_SYNC:
+		strategy = model.SyncStrategy_QUICK_SYNC
+		strategySummary = "Quick sync to attempt to resolve the detected configuration drift"
+
+	case model.TriggerKind_ON_SCHEDULE:
+		strategy = model.SyncStrategy_QUICK_SYNC
+		strategySummary = "Quick sync to redeploy the head commit on schedule"
+
+	default:
+		strategy, strategySummary = t.determineCommitSyncStrategy(app, commit)
+	}
+
+	// Build the deployment to trigger.
+	deployment, err := BuildDeployment(DeploymentInput{
+		Application:               app,
+		ApplicationConfig:         appCfg,
+		ConfigFilename:            cfgFile.filename,
+		Branch:                    key.branch,
+		Commit:                    commit,
+		Commander:                 commander,
+		SyncStrategy:              strategy,
+		StrategySummary:           strategySummary,
+		TriggerReason:             c.Reason(),
+		TriggerSource:             c.externalSource(),
+		Description:               c.description(),
+		DetectRevert:              t.config.DetectRevertCommits,
+		DeploymentChainID:         deploymentChainID,
+		DeploymentChainBlockIndex: deploymentChainBlockIndex,
+		CreatedAt:                 time.Now(),
+	})
+	if err != nil {
+		msg := fmt.Sprintf("failed to build deployment for application %s: %v", app.Id, err)
+		t.notifyDeploymentTriggerFailed(app, appCfg, msg, commit)
+		t.logger.Error(msg, zap.Error(err))
+		t.reportCommandFailed(ctx, c, msg)
+		t.auditDecision(c, key, commit.Hash, "", msg)
+		triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonConfig)
+		return false
+	}
+
+	// In case the triggered deployment is of application that can trigger a deployment chain
+	// create a new deployment chain with its configuration besides with the first deployment
+	// in that chain.
+	if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+		if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
+			msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
+			t.notifyDeploymentTriggerFailed(app, appCfg, msg, commit)
 			t.logger.Error(msg, zap.Error(err))
-			continue
+			t.reportCommandFailed(ctx, c, msg)
+			t.auditDecision(c, key, commit.Hash, "", msg)
+			triggermetrics.DeploymentCreationFailed(app.Id, triggermetrics.FailureReasonAPI)
+			return false
+		}
+		triggermetrics.DeploymentCreationSucceeded(app.Id)
+	} else {
+		// Send a request to API to create a new deployment.
+		if err := t.triggerDeployment(ctx, deployment, c.kind); err != nil {
+			msg := fmt.Sprintf("failed to trigger application %s: %v", app.Id, err)
+			t.notifyDeploymentTriggerFailed(app, appCfg, msg, commit)
+			t.logger.Error(msg, zap.Error(err))
+			t.reportCommandFailed(ctx, c, msg)
+			t.auditDecision(c, key, commit.Hash, "", msg)
+			return false
 		}
+	}
 
-		if !shouldTrigger {
-			t.commitStore.Put(app.Id, headCommit.Hash)
-			continue
+	// Nothing was created in dry-run mode so there is nothing to notify.
+	// The last triggered commit is still updated in memory to avoid logging the same changes again,
+	// while it is not persisted to let them be deployed after the dry-run mode was disabled.
+	// The command is reported as failed since no deployment was created for it.
+	if t.config.DryRun {
+		t.auditDecision(c, key, commit.Hash, "", "dry-run: "+c.Reason())
+		t.commitStore.Put(app.Id, handledCommit)
+		if tag != nil {
+			t.tagStore.Put(app.Id, tag.Name)
 		}
+		t.reportCommandFailed(ctx, c, "dry-run: no deployment was created since piped is running in dry-run mode")
+		return false
+	}
 
-		var (
-			commander                 string
-			strategy                  model.SyncStrategy
-			strategySummary           string
-			deploymentChainID         string
-			deploymentChainBlockIndex uint32
-		)
+	// TODO: Find a better way to ensure that the application should be updated correctly
+	// when the deployment was successfully triggered.
+	// This error is ignored because the deployment was already registered successfully.
+	if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
+		t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+	}
 
-		switch c.kind {
-		case model.TriggerKind_ON_COMMAND:
-			strategy = c.command.GetSyncApplication().SyncStrategy
-			commander = c.command.Commander
-			if strategy == model.SyncStrategy_QUICK_SYNC {
-				strategySummary = "Quick sync because piped received a command from user via web console or pipectl"
-			} else {
-				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
-			}
-
-		case model.TriggerKind_ON_CHAIN:
-			strategy = c.command.GetChainSyncApplication().SyncStrategy
-			commander = c.command.Commander
-			strategySummary = "Sync application in chain"
-			deploymentChainID = c.command.GetChainSyncApplication().DeploymentChainId
-			deploymentChainBlockIndex = c.command.GetChainSyncApplication().BlockIndex
-
-		case model.TriggerKind_ON_OUT_OF_SYNC:
-			strategy = model.SyncStrategy_QUICK_SYNC
-			strategySummary = "Quick sync to attempt to resolve the detected configuration drift"
-
-		default:
-			strategy = model.SyncStrategy_AUTO
-		}
-
-		// Build the deployment to trigger.
-		deployment, err := buildDeployment(
-			app,
-			branch,
-			headCommit,
-			commander,
-			strategy,
-			strategySummary,
-			time.Now(),
-			appCfg.DeploymentNotification,
-			deploymentChainID,
-			deploymentChainBlockIndex,
-		)
-		if err != nil {
-			msg := fmt.Sprintf("failed to build deployment for application %s: %v", app.Id, err)
-			t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
-			t.logger.Error(msg, zap.Error(err))
-			continue
-		}
+	t.auditDecision(c, key, commit.Hash, deployment.Id, c.Reason())
+	t.commitStore.PutTriggered(app.Id, handledCommit, time.Now())
+	if tag != nil {
+		t.tagStore.Put(app.Id, tag.Name)
+	}
+	if c.kind == model.TriggerKind_ON_OUT_OF_SYNC {
+		t.outOfSyncThrottle.Record(app.Id, time.Now())
+	}
+	t.schedules.Triggered(app.Id, time.Now())
+	// Let the subsequent checks fetch the repository again.
+	t.headCommits.Invalidate(key.repoID, key.branch)
+	// The changed files are summarized only for the deployments triggered by them.
+	var changes string
+	if s, ok := onCommit.(changesSummarizer); ok && c.kind == model.TriggerKind_ON_COMMIT {
+		changes = s.ChangesSummary(app.Id)
+	}
+	t.notifyDeploymentTriggered(ctx, key, appCfg, deployment, changes)
 
-		// In case the triggered deployment is of application that can trigger a deployment chain
-		// create a new deployment chain with its configuration besides with the first deployment
-		// in that chain.
-		if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
-			if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
-				msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
-				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
-				t.logger.Error(msg, zap.Error(err))
-				continue
-			}
-		} else {
-			// Send a request to API to create a new deployment.
-			if err := t.triggerDeployment(ctx, deployment); err != nil {
-				msg := fmt.Sprintf("failed to trigger application %s: %v", app.Id, err)
-				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
-				t.logger.Error(msg, zap.Error(err))
-				continue
-			}
+	// Mask command as handled since the deployment has been triggered successfully.
+	if c.HasCommand() {
+		metadata := map[string]string{
+			model.MetadataKeyTriggeredDeploymentID: deployment.Id,
 		}
+		if err := c.command.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, metadata, nil); err != nil {
+			t.logger.Error("failed to report command status", zap.Error(err))
+		}
+	}
+
+	return true
+}
+
+// auditDecision records the decision made for the given candidate as a structured log
+// to let it be shipped to the external systems for auditing.
+// The decision is also kept as the most recent one of its application.
+// The candidate was triggered when the deployment ID is not empty.
+func (t *Trigger) auditDecision(c candidate, key gitRepoKey, commit, deploymentID, reason string) {
+	actor := "system"
+	if c.HasCommand() {
+		actor = c.command.Commander
+	}
+	t.decisions.Set(Decision{
+		ApplicationID:   c.application.Id,
+		ApplicationName: c.application.Name,
+		Kind:            c.kind.String(),
+		Commit:          commit,
+		ShouldTrigger:   c.shouldTrigger,
+		Triggered:       deploymentID != "",
+		DeploymentID:    deploymentID,
+		Reason:          reason,
+		DecidedAt:       time.Now(),
+	})
+	t.auditLogger.Info("trigger decision",
+		zap.String("app", c.application.Name),
+		zap.String("app-id", c.application.Id),
+		zap.String("repo-id", key.repoID),
+		zap.String("branch", key.branch),
+		zap.String("commit", commit),
+		zap.String("kind", c.kind.String()),
+		zap.Bool("triggered", deploymentID != ""),
+		zap.String("reason", reason),
+		zap.String("deployment-id", deploymentID),
+		zap.String("actor", actor),
+		zap.Time("timestamp", time.Now()),
+	)
+}
+
+// reportCommandFailed marks the command of the given candidate as failed with the given reason
+// to let the user know why no deployment was triggered for it.
+// Nothing is reported for the candidates without command since they will be retried at the subsequent checks.
+func (t *Trigger) reportCommandFailed(ctx context.Context, c candidate, reason string) {
+	if !c.HasCommand() {
+		return
+	}
+	metadata := map[string]string{
+		model.MetadataKeyTriggerError: reason,
+	}
+	if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, metadata, nil); err != nil {
+		t.logger.Error("failed to report command status", zap.Error(err))
+	}
+}
 
-		// TODO: Find a better way to ensure that the application should be updated correctly
-		// when the deployment was successfully triggered.
-		// This error is ignored because the deployment was already registered successfully.
-		if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
-			t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
-		}
+// determineCommitSyncStrategy returns the sync strategy specified by the trailer of the given commit message,
+// e.g. "Pipecd-Sync-Strategy: QUICK_SYNC". AUTO is used when no valid strategy was specified.
+func (t *Trigger) determineCommitSyncStrategy(app *model.Application, commit git.Commit) (model.SyncStrategy, string) {
+	value, ok := commit.GetTrailerValue(syncStrategyTrailer)
+	if !ok {
+		return model.SyncStrategy_AUTO, ""
+	}
 
-		triggered[app.Id] = struct{}{}
-		t.commitStore.Put(app.Id, headCommit.Hash)
-		t.notifyDeploymentTriggered(ctx, appCfg, deployment)
+	s, ok := model.SyncStrategy_value[strings.ToUpper(value)]
+	if !ok {
+		t.logger.Warn(fmt.Sprintf("unknown sync strategy %q was specified by the commit message, AUTO will be used", value),
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.String("commit", commit.Hash),
+		)
+		return model.SyncStrategy_AUTO, ""
+	}
 
-		// Mask command as handled since the deployment has been triggered successfully.
-		if c.HasCommand() {
-			metadata := map[string]string{
-				model.MetadataKeyTriggeredDeploymentID: deployment.Id,
-			}
-			if err := c.command.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, metadata, nil); err != nil {
-				t.logger.Error("failed to report command status", zap.Error(err))
-			}
+	switch strategy := model.SyncStrategy(s); strategy {
+	case model.SyncStrategy_QUICK_SYNC:
+		return strategy, fmt.Sprintf("Quick sync because it was specified by the %s trailer of the commit message", syncStrategyTrailer)
+	case model.SyncStrategy_PIPELINE:
+		return strategy, fmt.Sprintf("Sync with the specified pipeline because it was specified by the %s trailer of the commit message", syncStrategyTrailer)
+	default:
+		return strategy, ""
+	}
+}
+
+// isInTriggerWindows reports whether the given time is inside any of the configured trigger windows.
+func (t *Trigger) isInTriggerWindows(now time.Time) bool {
+	if len(t.config.TriggerWindows) == 0 {
+		return true
+	}
+	for _, w := range t.config.TriggerWindows {
+		if w.Includes(now) {
+			return true
 		}
 	}
+	return false
+}
 
-	return nil
+// isEnvironmentOpen reports whether the given candidate is allowed by the trigger rule
+// configured for the environment of its application at the given time.
+func (t *Trigger) isEnvironmentOpen(c candidate, now time.Time) bool {
+	if c.application.EnvId == "" {
+		return true
+	}
+	rule, ok := t.config.GetEnvironmentTriggerRule(c.application.EnvId)
+	if !ok {
+		return true
+	}
+	return rule.IsOpen(now, c.HasCommand())
+}
+
+// isCoolingDown checks whether the given candidate should be suppressed by the trigger cooldown.
+// The candidates triggered by commands are never suppressed.
+func (t *Trigger) isCoolingDown(c candidate, now time.Time) bool {
+	cooldown := t.config.TriggerCooldown.Duration()
+	if cooldown <= 0 {
+		return false
+	}
+	if c.kind != model.TriggerKind_ON_COMMIT && c.kind != model.TriggerKind_ON_OUT_OF_SYNC {
+		return false
+	}
+	triggeredAt, ok := t.commitStore.GetTriggeredAt(c.application.Id)
+	if !ok {
+		return false
+	}
+	return now.Sub(triggeredAt) < cooldown
 }
 
 // listCommandCandidates finds all applications that have been commanded to sync.
@@ -368,9 +1595,15 @@ func (t *Trigger) listCommandCandidates() []candidate {
 				continue
 			}
 
+			// The SYNC command sent by an external source is handled as its own kind
+			// to let the deployment record the source.
+			kind := model.TriggerKind_ON_COMMAND
+			if cmd.GetSyncApplication().GetExternalSource() != "" {
+				kind = model.TriggerKind_ON_EXTERNAL
+			}
 			apps = append(apps, candidate{
 				application: app,
-				kind:        model.TriggerKind_ON_COMMAND,
+				kind:        kind,
 				command:     cmd,
 			})
 		}
@@ -399,16 +1632,63 @@ func (t *Trigger) listCommandCandidates() []candidate {
 	return apps
 }
 
-// listOutOfSyncCandidates finds all applications that are staying at OUT_OF_SYNC state.
-func (t *Trigger) listOutOfSyncCandidates() []candidate {
+// refreshLastTriggeredCommits handles the REFRESH_LAST_TRIGGERED_COMMIT commands
+// by re-fetching the last triggered commit of their applications from the control-plane.
+func (t *Trigger) refreshLastTriggeredCommits(ctx context.Context) {
+	for _, cmd := range t.commandLister.ListApplicationCommands() {
+		if !cmd.IsRefreshLastTriggeredCommitCmd() {
+			continue
+		}
+		appID := cmd.GetRefreshLastTriggeredCommit().ApplicationId
+		logger := t.logger.With(
+			zap.String("command", cmd.Id),
+			zap.String("app-id", appID),
+			zap.String("commander", cmd.Commander),
+		)
+
+		var (
+			status   = model.CommandStatus_COMMAND_SUCCEEDED
+			metadata map[string]string
+		)
+		commit, err := t.commitStore.Refresh(ctx, appID)
+		if err != nil {
+			logger.Error("failed to refresh the last triggered commit", zap.Error(err))
+			status = model.CommandStatus_COMMAND_FAILED
+			metadata = map[string]string{
+				model.MetadataKeyTriggerError: err.Error(),
+			}
+		} else {
+			logger.Info("refreshed the last triggered commit", zap.String("commit", commit))
+		}
+
+		if err := cmd.Report(ctx, status, metadata, nil); err != nil {
+			logger.Error("failed to report command status", zap.Error(err))
+		}
+	}
+}
+
+// listOutOfSyncCandidates finds all applications in the given repositories
+// that are staying at OUT_OF_SYNC state and matching the trigger selector.
+func (t *Trigger) listOutOfSyncCandidates(repos map[string]struct{}) []candidate {
 	var (
 		list = t.applicationLister.List()
 		apps = make([]candidate, 0)
 	)
 	for _, app := range list {
+		if _, ok := repos[t.repoIDOf(app)]; !ok {
+			continue
+		}
+		// The disabled applications are not triggered automatically.
+		// Their last triggered commit is kept as is to let the changes be handled once re-enabled.
+		if app.Disabled {
+			continue
+		}
 		if !app.IsOutOfSync() {
 			continue
 		}
+		if !app.ContainLabels(t.config.TriggerSelector) {
+			continue
+		}
 		apps = append(apps, candidate{
 			application: app,
 			kind:        model.TriggerKind_ON_OUT_OF_SYNC,
@@ -419,13 +1699,22 @@ func (t *Trigger) listOutOfSyncCandidates() []candidate {
 
 // listCommitCandidates finds all applications that have potentiality
 // to be candidates by the changes of new commits.
-// They are all applications managed by this Piped.
-func (t *Trigger) listCommitCandidates() []candidate {
+// They are all applications in the given repositories matching the trigger selector.
+func (t *Trigger) listCommitCandidates(repos map[string]struct{}) []candidate {
 	var (
 		list = t.applicationLister.List()
 		apps = make([]candidate, 0)
 	)
 	for _, app := range list {
+		if _, ok := repos[t.repoIDOf(app)]; !ok {
+			continue
+		}
+		if app.Disabled {
+			continue
+		}
+		if !app.ContainLabels(t.config.TriggerSelector) {
+			continue
+		}
 		apps = append(apps, candidate{
 			application: app,
 			kind:        model.TriggerKind_ON_COMMIT,
@@ -434,44 +1723,510 @@ func (t *Trigger) listCommitCandidates() []candidate {
 	return apps
 }
 
-// updateRepoToLatest ensures that the local data of the given Git repository should be up-to-date.
-func (t *Trigger) updateRepoToLatest(ctx context.Context, repoID string) (repo git.Repo, branch string, headCommit git.Commit, err error) {
-	var ok bool
+// skipUnregisteredRepos removes the candidates whose repository is not registered in Piped configuration,
+// e.g. the repository was removed from the configuration while their applications still point to it.
+// A warning is logged at most once per interval for each repository instead of failing at every check,
+// and the local data of that repository is removed since it will not be used anymore.
+// Since Piped configuration is never reloaded while running, the repository never becomes registered again,
+// so its local data is removed only the first time it was found unregistered.
+func (t *Trigger) skipUnregisteredRepos(cs []candidate) []candidate {
+	var (
+		filtered = make([]candidate, 0, len(cs))
+		skipped  = make(map[string]int)
+	)
+	for _, c := range cs {
+		repoID := c.application.GitPath.Repo.Id
+		if _, ok := t.config.ResolveRepository(repoID); ok {
+			filtered = append(filtered, c)
+			continue
+		}
+		skipped[repoID]++
+	}
+
+	now := time.Now()
+	for repoID, n := range skipped {
+		// The removed repositories are keyed by their ID only regardless of the branches.
+		if t.removedRepos.Add(gitRepoKey{repoID: repoID}) {
+			t.removeGitRepos(repoID)
+		}
+		if !t.unregisteredRepos.Allow(repoID, now) {
+			continue
+		}
+		t.unregisteredRepos.Record(repoID, now)
+		t.logger.Warn(fmt.Sprintf("skipped %d candidates since their repository %s was not registered in Piped configuration, registered repositories: %s", n, repoID, t.registeredRepoIDs()))
+	}
+	return filtered
+}
+
+// removeGitRepos removes the local data of all branches of the given repository.
+func (t *Trigger) removeGitRepos(repoID string) {
+	t.gitReposMu.Lock()
+	defer t.gitReposMu.Unlock()
 
-	// Find the repository from the previously loaded list.
-	repo, ok = t.gitRepos[repoID]
+	for key, repo := range t.gitRepos {
+		if key.repoID != repoID {
+			continue
+		}
+		delete(t.gitRepos, key)
+		delete(t.sparseDirs, key)
+		t.headCommits.Invalidate(key.repoID, key.branch)
+		t.candidates.Delete(key.repoID)
+		if repo == nil {
+			continue
+		}
+		if err := repo.Clean(); err != nil {
+			t.logger.Warn(fmt.Sprintf("failed to remove the local data of git repository %s", repoID), zap.Error(err))
+		}
+	}
+}
+
+// gitRepoKey identifies the local data of a branch of a Git repository.
+type gitRepoKey struct {
+	repoID string
+	branch string
+}
+
+// gitRepoKeySet keeps the repository branches handled once since piped started,
+// e.g. the ones whose applications were already checked for seeding.
+type gitRepoKeySet struct {
+	mu   sync.Mutex
+	keys map[gitRepoKey]struct{}
+}
+
+func newGitRepoKeySet() *gitRepoKeySet {
+	return &gitRepoKeySet{
+		keys: make(map[gitRepoKey]struct{}),
+	}
+}
+
+// Add adds the given repository branch and reports whether it was not added yet.
+func (s *gitRepoKeySet) Add(key gitRepoKey) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.keys[key]; ok {
+		return false
+	}
+	s.keys[key] = struct{}{}
+	return true
+}
+
+// gitRepoKeyOf returns the key of the repository branch where the given application belongs to.
+// The branch configured for the repository in Piped configuration is used
+// when the application does not specify its branch.
+func (t *Trigger) gitRepoKeyOf(app *model.Application) gitRepoKey {
+	key := gitRepoKey{
+		repoID: t.repoIDOf(app),
+		branch: app.GitPath.Repo.Branch,
+	}
+	if key.branch == "" {
+		if r, ok := t.config.GetRepository(key.repoID); ok {
+			key.branch = r.Branch
+		}
+	}
+	return key
+}
+
+// repoIDOf returns the ID of the registered repository the given application refers to
+// by resolving the aliases and the differences in case of the referred ID.
+// The referred ID is returned as is when it could not be resolved.
+func (t *Trigger) repoIDOf(app *model.Application) string {
+	id := app.GitPath.Repo.Id
+	if r, ok := t.config.ResolveRepository(id); ok {
+		return r.RepoID
+	}
+	return id
+}
+
+// registeredRepoIDs returns the comma-separated IDs of the registered repositories.
+func (t *Trigger) registeredRepoIDs() string {
+	ids := make([]string, 0, len(t.config.Repositories))
+	for _, r := range t.config.Repositories {
+		ids = append(ids, r.RepoID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+func (t *Trigger) gitRepoLock(key gitRepoKey) *sync.Mutex {
+	t.gitReposMu.Lock()
+	defer t.gitReposMu.Unlock()
+
+	mu, ok := t.gitRepoLocks[key]
+	if !ok {
+		mu = &sync.Mutex{}
+		t.gitRepoLocks[key] = mu
+	}
+	return mu
+}
+
+// getGitRepo returns the local data of the given repository branch.
+// The branch is cloned at this time if it was not cloned yet.
+func (t *Trigger) getGitRepo(ctx context.Context, key gitRepoKey) (git.Repo, error) {
+	t.gitReposMu.Lock()
+	repo, ok := t.gitRepos[key]
+	t.gitReposMu.Unlock()
+	if ok {
+		return repo, nil
+	}
+
+	r, ok := t.config.GetRepository(key.repoID)
 	if !ok {
-		err = fmt.Errorf("the repository was not registered in Piped configuration")
+		return nil, fmt.Errorf("the repository %s was not registered in Piped configuration, registered repositories: %s", key.repoID, t.registeredRepoIDs())
+	}
+	repo, err := t.cloneGitRepo(ctx, key, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone branch %s: %w", key.branch, err)
+	}
+
+	t.gitReposMu.Lock()
+	t.gitRepos[key] = repo
+	t.gitReposMu.Unlock()
+	return repo, nil
+}
+
+// cloneGitRepo clones the given branch of the registered repository.
+// Only the directories of its applications are checked out when the sparse checkout is enabled
+// and only the latest commits are fetched when the clone depth is specified.
+func (t *Trigger) cloneGitRepo(ctx context.Context, key gitRepoKey, r config.PipedRepository) (git.Repo, error) {
+	if r.CloneDepth > 0 {
+		return t.gitClient.ShallowClone(ctx, key.repoID, r.Remote, key.branch, "", r.CloneDepth)
+	}
+	if !r.SparseCheckout {
+		return t.gitClient.Clone(ctx, key.repoID, r.Remote, key.branch, "")
+	}
+
+	dirs := t.appDirsOf(key)
+	repo, err := t.gitClient.SparseClone(ctx, key.repoID, r.Remote, key.branch, "", dirs)
+	if err != nil {
+		return nil, err
+	}
+	t.gitReposMu.Lock()
+	t.sparseDirs[key] = strings.Join(dirs, ",")
+	t.gitReposMu.Unlock()
+	return repo, nil
+}
+
+// updateSparseCheckout updates the sparse checkout of the given repository branch
+// to follow the applications added or removed since the last update.
+func (t *Trigger) updateSparseCheckout(ctx context.Context, key gitRepoKey, repo git.Repo) error {
+	if r, ok := t.config.GetRepository(key.repoID); !ok || !r.SparseCheckout {
+		return nil
+	}
+
+	dirs := t.appDirsOf(key)
+	joined := strings.Join(dirs, ",")
+	t.gitReposMu.Lock()
+	prev := t.sparseDirs[key]
+	t.gitReposMu.Unlock()
+	if joined == prev {
+		return nil
+	}
+
+	if err := repo.SetSparseCheckout(ctx, dirs); err != nil {
+		return err
+	}
+	t.logger.Info(fmt.Sprintf("updated the sparse checkout of git repository %s to %d directories", key.repoID, len(dirs)),
+		zap.String("branch", key.branch),
+	)
+	t.gitReposMu.Lock()
+	t.sparseDirs[key] = joined
+	t.gitReposMu.Unlock()
+	return nil
+}
+
+// appDirsOf returns the sorted directories of the applications in the given repository branch.
+func (t *Trigger) appDirsOf(key gitRepoKey) []string {
+	var (
+		dirs = make([]string, 0)
+		seen = make(map[string]struct{})
+	)
+	for _, app := range t.applicationLister.List() {
+		if t.gitRepoKeyOf(app) != key {
+			continue
+		}
+		if _, ok := seen[app.GitPath.Path]; ok {
+			continue
+		}
+		seen[app.GitPath.Path] = struct{}{}
+		dirs = append(dirs, app.GitPath.Path)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// cloneGitRepos clones the given branches of the registered repositories concurrently
+// and returns the aggregated error of the ones could not be cloned.
+func (t *Trigger) cloneGitRepos(ctx context.Context, keys []gitRepoKey) error {
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, maxConcurrentClones)
+		errs = make([]error, len(keys))
+	)
+	for i, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, key gitRepoKey) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			var (
+				start  = time.Now()
+				logger = t.logger.With(zap.String("repo-id", key.repoID), zap.String("branch", key.branch))
+			)
+			logger.Info(fmt.Sprintf("cloning git repository %s", key.repoID))
+			r, _ := t.config.GetRepository(key.repoID)
+			cloneCtx, cancel := t.withGitOperationTimeout(ctx)
+			defer cancel()
+			repo, err := t.cloneGitRepo(cloneCtx, key, r)
+			if err != nil {
+				t.reportGitOperationTimeout(ctx, cloneCtx, key.repoID, "clone")
+				logger.Error(fmt.Sprintf("failed to clone git repository %s", key.repoID), zap.Error(err))
+				errs[i] = fmt.Errorf("failed to clone git repository %s: %w", key.repoID, err)
+				return
+			}
+			logger.Info(fmt.Sprintf("successfully cloned git repository %s in %v", key.repoID, time.Since(start)))
+
+			t.gitReposMu.Lock()
+			t.gitRepos[key] = repo
+			t.gitReposMu.Unlock()
+		}(i, key)
+	}
+	wg.Wait()
+
+	return multierr.Combine(errs...)
+}
+
+// updateRepoToLatest ensures that the local data of the given Git repository branch should be up-to-date.
+// The operations are given up when they could not be done within gitOperationTimeout.
+func (t *Trigger) updateRepoToLatest(parent context.Context, key gitRepoKey) (repo git.Repo, headCommit git.Commit, err error) {
+	ctx, cancel := t.withGitOperationTimeout(parent)
+	defer func() {
+		if err != nil && t.reportGitOperationTimeout(parent, ctx, key.repoID, "update") {
+			err = fmt.Errorf("git operations did not finish within %v: %w", t.config.GitOperationTimeout.Duration(), err)
+		}
+		cancel()
+	}()
+
+	repo, err = t.getGitRepo(ctx, key)
+	if err != nil {
 		return
 	}
-	branch = repo.GetClonedBranch()
+
+	// Reuse the head commit fetched very recently to avoid pulling the repository again.
+	var cached bool
+	if headCommit, cached = t.headCommits.Get(key.repoID, key.branch, time.Now()); cached {
+		return
+	}
+
+	// The repository using tags may be left at the previously checked out tag.
+	if t.tagPatternOf(key) != "" {
+		if err = repo.Checkout(ctx, key.branch); err != nil {
+			return
+		}
+	}
 
 	// Fetch to update the repository.
-	err = repo.Pull(ctx, branch)
+	// The short-lived credentials may have expired since the last pull,
+	// so pull again once after refreshing them if configured.
+	err = repo.Pull(ctx, key.branch)
+	if errors.Is(err, git.ErrAuthentication) && t.refreshCredentials(ctx, key.repoID) {
+		err = repo.Pull(ctx, key.branch)
+	}
 	if err != nil {
 		return
 	}
 
+	// Check out the directories of the newly added applications too.
+	if err = t.updateSparseCheckout(ctx, key, repo); err != nil {
+		return
+	}
+
+	if t.submodulesEnabled(key.repoID) {
+		if err = repo.UpdateSubmodules(ctx); err != nil {
+			return
+		}
+	}
+
 	// Get the head commit of the repository.
 	headCommit, err = repo.GetLatestCommit(ctx)
+	if err != nil {
+		return
+	}
+	t.headCommits.Put(key.repoID, key.branch, headCommit, time.Now())
 	return
 }
 
+// withGitOperationTimeout returns the context for the Git operations on a repository
+// which is done after gitOperationTimeout, or when the given one is done if no timeout was configured.
+func (t *Trigger) withGitOperationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.config.GitOperationTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, t.config.GitOperationTimeout.Duration())
+}
+
+// reportGitOperationTimeout reports whether the Git operation run with the given context
+// was given up by exceeding gitOperationTimeout rather than the parent context being done.
+// The timed out operation is counted in the metrics.
+func (t *Trigger) reportGitOperationTimeout(parent, ctx context.Context, repoID, operation string) bool {
+	if parent.Err() != nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+	t.logger.Warn(fmt.Sprintf("git operation %s on repository %s timed out after %v", operation, repoID, t.config.GitOperationTimeout.Duration()))
+	triggermetrics.GitOperationTimedOut(repoID, operation)
+	return true
+}
+
+// reportDeploymentCreationFailures counts the failures to create the deployments
+// for the applications of the given candidates which could not be checked at all.
+func (t *Trigger) reportDeploymentCreationFailures(cs []candidate, reason triggermetrics.FailureReason) {
+	seen := make(map[string]struct{}, len(cs))
+	for _, c := range cs {
+		if _, ok := seen[c.application.Id]; ok {
+			continue
+		}
+		seen[c.application.Id] = struct{}{}
+		triggermetrics.DeploymentCreationFailed(c.application.Id, reason)
+	}
+}
+
+// tagPatternOf returns the pattern of the tags used to trigger the applications of the given repository branch.
+// Empty is returned for the branches other than the configured one since the tags are bound to it.
+func (t *Trigger) tagPatternOf(key gitRepoKey) string {
+	r, ok := t.config.GetRepository(key.repoID)
+	if !ok || r.Branch != key.branch {
+		return ""
+	}
+	return r.TagPattern
+}
+
+// submodulesEnabled reports whether the submodules of the given repository should be updated
+// to trigger the applications by the changes inside them.
+func (t *Trigger) submodulesEnabled(repoID string) bool {
+	r, ok := t.config.GetRepository(repoID)
+	return ok && r.Submodules
+}
+
+// shallowCloned reports whether only the latest commits of the given repository are cloned.
+func (t *Trigger) shallowCloned(repoID string) bool {
+	r, ok := t.config.GetRepository(repoID)
+	return ok && r.CloneDepth > 0
+}
+
+// skipCommitMessagePatternOf returns the pattern of the commit messages
+// which must not trigger the deployments in the given repository branch.
+// Nil is returned when no pattern was configured.
+func (t *Trigger) skipCommitMessagePatternOf(key gitRepoKey) *regexp.Regexp {
+	r, ok := t.config.GetRepository(key.repoID)
+	if !ok || r.Branch != key.branch {
+		return nil
+	}
+	return t.skipCommitPatterns[key.repoID]
+}
+
+// isAllowedCommitAuthor reports whether the given commit of the given repository
+// was authored by the one allowed to trigger the deployments automatically.
+func (t *Trigger) isAllowedCommitAuthor(repoID string, commit git.Commit) bool {
+	r, ok := t.config.GetRepository(repoID)
+	return !ok || r.IsAllowedCommitAuthor(commit.AuthorEmail)
+}
+
+// isDeniedCommit reports whether the given commit of the given repository is configured not to be deployed.
+func (t *Trigger) isDeniedCommit(repoID, hash string) bool {
+	r, ok := t.config.GetRepository(repoID)
+	return ok && r.IsDeniedCommit(hash)
+}
+
+// checkoutLatestTag checks out the newest tag matching the given pattern.
+// Nil is returned when no tag was found.
+func (t *Trigger) checkoutLatestTag(ctx context.Context, repo git.Repo, pattern string) (*git.Tag, error) {
+	tags, err := repo.ListTags(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	if err := repo.Checkout(ctx, tags[0].Hash); err != nil {
+		return nil, err
+	}
+	return &tags[0], nil
+}
+
 func (t *Trigger) GetLastTriggeredCommitGetter() LastTriggeredCommitGetter {
 	return t.commitStore
 }
 
-func (t *Trigger) notifyDeploymentTriggered(ctx context.Context, appCfg *config.GenericApplicationSpec, d *model.Deployment) {
+// GetLastDecisionGetter returns the getter of the most recent decision made for each application.
+func (t *Trigger) GetLastDecisionGetter() LastDecisionGetter {
+	return t.decisions
+}
+
+// GetDeploymentBudgetStatus returns the current consumption of the deployment budget
+// and the list of candidates deferred by it.
+func (t *Trigger) GetDeploymentBudgetStatus() DeploymentBudgetStatus {
+	return t.budget.Status(time.Now())
+}
+
+// GetCandidates returns the candidates found at the most recent check of each repository branch.
+func (t *Trigger) GetCandidates() []CandidateStatus {
+	return t.candidates.List()
+}
+
+// reportCandidates reports the number of candidates of the given kinds found in each repository.
+// Zero is reported for the given repositories having no candidate so that the stale values are not kept.
+func (t *Trigger) reportCandidates(repoIDs []string, cs []candidate, kinds ...model.TriggerKind) {
+	counts := make(map[string]map[model.TriggerKind]int, len(repoIDs))
+	for _, id := range repoIDs {
+		counts[id] = make(map[model.TriggerKind]int, len(kinds))
+	}
+	for _, c := range cs {
+		repoID := t.repoIDOf(c.application)
+		if _, ok := counts[repoID]; !ok {
+			counts[repoID] = make(map[model.TriggerKind]int, len(kinds))
+		}
+		counts[repoID][c.kind]++
+	}
+	for repoID, m := range counts {
+		for _, k := range kinds {
+			triggermetrics.FoundCandidates(repoID, k.String(), m[k])
+		}
+	}
+}
+
+func (t *Trigger) reportDeploymentBudget() {
+	s := t.budget.Status(time.Now())
+	triggermetrics.SetDeploymentBudget(s.Limit, s.Consumed, len(s.Deferred))
+}
+
+// notifyDeploymentTriggered notifies the given triggered deployment.
+// It is collected to be notified together with the others triggered by the same commit
+// when the batching of the notifications was configured.
+func (t *Trigger) notifyDeploymentTriggered(ctx context.Context, key gitRepoKey, appCfg *config.GenericApplicationSpec, d *model.Deployment, changes string) {
 	var mentions []string
 	if n := appCfg.DeploymentNotification; n != nil {
 		mentions = n.FindSlackAccounts(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
 	}
 
+	if t.config.Notifications.DeploymentTriggeredBatch != nil {
+		t.triggeredBatch.Add(key, batchedDeployment{
+			deployment: d,
+			mentions:   mentions,
+			changes:    changes,
+		})
+		return
+	}
+	t.notifySingleDeploymentTriggered(d, mentions, changes)
+}
+
+func (t *Trigger) notifySingleDeploymentTriggered(d *model.Deployment, mentions []string, changes string) {
 	t.notifier.Notify(model.NotificationEvent{
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
 		Metadata: &model.NotificationEventDeploymentTriggered{
 			Deployment:        d,
 			MentionedAccounts: mentions,
+			ChangesSummary:    changes,
 		},
 	})
 }
@@ -494,27 +2249,120 @@ func (t *Trigger) notifyDeploymentTriggerFailed(app *model.Application, appCfg *
 	})
 }
 
-func loadApplicationConfiguration(repoPath string, app *model.Application) (*config.GenericApplicationSpec, error) {
-	var (
-		relPath = app.GitPath.GetApplicationConfigFilePath()
-		absPath = filepath.Join(repoPath, relPath)
+func (t *Trigger) notifyDeploymentTriggerSkippedInvalidConfig(app *model.Application, reason error, commit git.Commit) {
+	t.notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_SKIPPED_INVALID_CONFIG,
+		Metadata: &model.NotificationEventDeploymentTriggerSkippedInvalidConfig{
+			Application: app,
+			ConfigPath:  app.GitPath.GetApplicationConfigFilePath(),
+			CommitHash:  commit.Hash,
+			Reason:      reason.Error(),
+		},
+	})
+}
+
+// handleRepoPullFailure counts the failure of pulling the given repository branch
+// and notifies once it has failed the configured number of times in a row.
+func (t *Trigger) handleRepoPullFailure(key gitRepoKey, reason error) {
+	failures := t.repoPullFailures.Fail(key)
+	if failures != t.config.RepoPullFailureNotificationThreshold {
+		return
+	}
+	t.logger.Warn(fmt.Sprintf("failed to pull git repository %s %d times in a row", key.repoID, failures),
+		zap.String("branch", key.branch),
 	)
+	t.notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_GIT_REPO_PULL_FAILED,
+		Metadata: &model.NotificationEventGitRepoPullFailed{
+			PipedId:   t.config.PipedID,
+			ProjectId: t.config.ProjectID,
+			RepoId:    key.repoID,
+			Branch:    key.branch,
+			Failures:  int32(failures),
+			Reason:    reason.Error(),
+		},
+	})
+}
 
-	cfg, err := config.LoadFromYAML(absPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("application config file %s was not found in Git", relPath)
+// applicationConfigFile describes the application config file loaded by the trigger.
+type applicationConfigFile struct {
+	filename   string
+	apiVersion string
+	// The file of the application's environment merged into the loaded one.
+	// Empty means no overlay was merged.
+	overlayFilename string
+}
+
+// loadApplicationConfiguration loads the configuration file registered with the given application.
+// The explicitly specified config file path is used instead of the one in the application directory if set.
+// When it was not found, the given fallback file names are tried in order in the application directory.
+// The overlay file of the application's environment, e.g. app.pipecd.<envId>.yaml, is merged into the loaded one if exists.
+// The unsupported apiVersion is rejected with the error telling the supported ones.
+func loadApplicationConfiguration(repoPath string, app *model.Application, fallbackFilenames []string) (*config.GenericApplicationSpec, applicationConfigFile, error) {
+	if err := app.GitPath.ValidateConfigFilePath(); err != nil {
+		return nil, applicationConfigFile{}, err
+	}
+
+	var (
+		filenames = []string{app.GitPath.GetApplicationConfigFilename()}
+		relPaths  = []string{app.GitPath.GetApplicationConfigFilePath()}
+		seen      = map[string]struct{}{relPaths[0]: {}}
+	)
+	for _, name := range fallbackFilenames {
+		relPath := filepath.Join(app.GitPath.Path, name)
+		if _, ok := seen[relPath]; ok {
+			continue
 		}
-		return nil, err
+		seen[relPath] = struct{}{}
+		filenames = append(filenames, name)
+		relPaths = append(relPaths, relPath)
 	}
-	if appKind, ok := config.ToApplicationKind(cfg.Kind); !ok || appKind != app.Kind {
-		return nil, fmt.Errorf("invalid application kind in the application config file, got: %s, expected: %s", appKind, app.Kind)
+
+	for i, relPath := range relPaths {
+		var (
+			cfg     *config.Config
+			err     error
+			overlay string
+		)
+		if app.EnvId != "" {
+			overlay = overlayFilename(filenames[i], app.EnvId)
+			overlayPath := filepath.Join(repoPath, filepath.Dir(relPath), overlay)
+			if _, err := os.Stat(overlayPath); err != nil {
+				overlay = ""
+			}
+			cfg, err = config.LoadFromYAMLWithOverlay(filepath.Join(repoPath, relPath), overlayPath)
+		} else {
+			cfg, err = config.LoadFromYAML(filepath.Join(repoPath, relPath))
+		}
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			if overlay != "" {
+				return nil, applicationConfigFile{}, fmt.Errorf("invalid application config file %s merged with overlay %s: %w", relPath, overlay, err)
+			}
+			return nil, applicationConfigFile{}, fmt.Errorf("invalid application config file %s: %w", relPath, err)
+		}
+		if appKind, ok := config.ToApplicationKind(cfg.Kind); !ok || appKind != app.Kind {
+			return nil, applicationConfigFile{}, fmt.Errorf("invalid application kind in the application config file, got: %s, expected: %s", appKind, app.Kind)
+		}
+
+		spec, ok := cfg.GetGenericApplication()
+		if !ok {
+			return nil, applicationConfigFile{}, fmt.Errorf("unsupported application kind: %s", app.Kind)
+		}
+		return &spec, applicationConfigFile{filename: filenames[i], apiVersion: cfg.APIVersion, overlayFilename: overlay}, nil
 	}
 
-	spec, ok := cfg.GetGenericApplication()
-	if !ok {
-		return nil, fmt.Errorf("unsupported application kind: %s", app.Kind)
+	if len(relPaths) == 1 {
+		return nil, applicationConfigFile{}, fmt.Errorf("application config file %s was not found in Git", relPaths[0])
 	}
+	return nil, applicationConfigFile{}, fmt.Errorf("application config file was not found in Git, tried: %s", strings.Join(relPaths, ", "))
+}
 
-	return &spec, nil
+// overlayFilename returns the name of the overlay file of the given environment
+// for the given application config file, e.g. app.pipecd.<envId>.yaml for app.pipecd.yaml.
+func overlayFilename(filename, envID string) string {
+	ext := filepath.Ext(filename)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(filename, ext), envID, ext)
 }
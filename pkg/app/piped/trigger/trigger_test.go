@@ -0,0 +1,1961 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestCheckCandidatesAggregateErrors(t *testing.T) {
+	t.Parallel()
+
+	newCandidate := func(appID, repoID string) candidate {
+		return candidate{
+			application: &model.Application{
+				Id: appID,
+				GitPath: &model.ApplicationGitPath{
+					Repo: &model.ApplicationGitRepository{Id: repoID},
+				},
+			},
+			kind: model.TriggerKind_ON_COMMIT,
+		}
+	}
+
+	for _, concurrency := range []int{0, 1, 2, 10} {
+		var (
+			cfg = &config.PipedSpec{
+				TriggerConcurrency: concurrency,
+				Repositories: []config.PipedRepository{
+					{RepoID: "repo-1"},
+					{RepoID: "repo-2"},
+					{RepoID: "repo-3"},
+				},
+			}
+			gc = &fakeGitClient{failures: map[string]struct{}{"repo-1": {}, "repo-2": {}, "repo-3": {}}}
+		)
+		tr, err := NewTrigger(&recordingAPIClient{}, gc, nil, nil, nil, nil, cfg, 0, zap.NewNop())
+		require.NoError(t, err)
+
+		// All repositories could not be cloned so checking each of them must fail.
+		err = tr.checkCandidates(context.Background(), []candidate{
+			newCandidate("app-1", "repo-1"),
+			newCandidate("app-2", "repo-2"),
+			newCandidate("app-3", "repo-1"),
+			newCandidate("app-4", "repo-3"),
+		})
+		require.Error(t, err)
+		assert.Len(t, multierr.Errors(err), 3, "concurrency: %d", concurrency)
+	}
+}
+
+func TestCheckCandidatesTriggerDeployment(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(appCfg), 0600))
+
+	newApp := func() *model.Application {
+		return &model.Application{
+			Id:        "app-1",
+			Name:      "app",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		kind         model.TriggerKind
+		lastCommit   string
+		changedFiles []string
+		disabled     bool
+		// The commit authors allowed to trigger automatically.
+		allowedAuthors       []string
+		requireSignedCommits bool
+		signature            git.CommitSignature
+		expected             bool
+		// The statuses expected to be reported by the command.
+		expectedReports []model.CommandStatus
+	}{
+		{
+			name:         "commit changing the application",
+			kind:         model.TriggerKind_ON_COMMIT,
+			lastCommit:   "commit-1",
+			changedFiles: []string{"app/deployment.yaml"},
+			expected:     true,
+		},
+		{
+			name:         "commit changing another application",
+			kind:         model.TriggerKind_ON_COMMIT,
+			lastCommit:   "commit-1",
+			changedFiles: []string{"another/deployment.yaml"},
+			expected:     false,
+		},
+		{
+			name:     "first commit of the application",
+			kind:     model.TriggerKind_ON_COMMIT,
+			expected: true,
+		},
+		{
+			name:            "command",
+			kind:            model.TriggerKind_ON_COMMAND,
+			lastCommit:      "commit-2",
+			expected:        true,
+			expectedReports: []model.CommandStatus{model.CommandStatus_COMMAND_SUCCEEDED},
+		},
+		{
+			name:            "command for the disabled application",
+			kind:            model.TriggerKind_ON_COMMAND,
+			lastCommit:      "commit-2",
+			disabled:        true,
+			expected:        false,
+			expectedReports: []model.CommandStatus{model.CommandStatus_COMMAND_FAILED},
+		},
+		{
+			name:            "external source without change",
+			kind:            model.TriggerKind_ON_EXTERNAL,
+			lastCommit:      "commit-2",
+			expected:        true,
+			expectedReports: []model.CommandStatus{model.CommandStatus_COMMAND_SUCCEEDED},
+		},
+		{
+			name:           "commit by the author not allowed",
+			kind:           model.TriggerKind_ON_COMMIT,
+			lastCommit:     "commit-1",
+			changedFiles:   []string{"app/deployment.yaml"},
+			allowedAuthors: []string{"*@trusted.example.com"},
+			expected:       false,
+		},
+		{
+			name:           "commit by the allowed author",
+			kind:           model.TriggerKind_ON_COMMIT,
+			lastCommit:     "commit-1",
+			changedFiles:   []string{"app/deployment.yaml"},
+			allowedAuthors: []string{"user@example.com"},
+			expected:       true,
+		},
+		{
+			name:            "command for the commit by the author not allowed",
+			kind:            model.TriggerKind_ON_COMMAND,
+			lastCommit:      "commit-2",
+			allowedAuthors:  []string{"*@trusted.example.com"},
+			expected:        true,
+			expectedReports: []model.CommandStatus{model.CommandStatus_COMMAND_SUCCEEDED},
+		},
+		{
+			name:                 "verified commit in the repository requiring signed commits",
+			kind:                 model.TriggerKind_ON_COMMIT,
+			lastCommit:           "commit-1",
+			changedFiles:         []string{"app/deployment.yaml"},
+			requireSignedCommits: true,
+			signature:            git.CommitSignature{Status: git.SignatureStatusGood},
+			expected:             true,
+		},
+		{
+			name:                 "unsigned commit in the repository requiring signed commits",
+			kind:                 model.TriggerKind_ON_COMMIT,
+			lastCommit:           "commit-1",
+			changedFiles:         []string{"app/deployment.yaml"},
+			requireSignedCommits: true,
+			signature:            git.CommitSignature{Status: git.SignatureStatusNoSignature},
+			expected:             false,
+		},
+		{
+			name:                 "command for the unverified commit in the repository requiring signed commits",
+			kind:                 model.TriggerKind_ON_COMMAND,
+			lastCommit:           "commit-2",
+			requireSignedCommits: true,
+			signature:            git.CommitSignature{Status: git.SignatureStatusUnknownValidity},
+			expected:             false,
+			expectedReports:      []model.CommandStatus{model.CommandStatus_COMMAND_FAILED},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				app = newApp()
+				ac  = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{}}
+				gc  = &fakeGitClient{repos: map[string]git.Repo{
+					"repo-1": &fakeRepo{
+						path:         repoPath,
+						head:         git.Commit{Hash: "commit-2", Message: "update app", AuthorEmail: "user@example.com"},
+						changedFiles: tc.changedFiles,
+						signature:    tc.signature,
+					},
+				}}
+				cr  = &commandRecorder{}
+				cfg = &config.PipedSpec{
+					ProjectID:                             "project-1",
+					PipedID:                               "piped-1",
+					Repositories:                          []config.PipedRepository{{RepoID: "repo-1", Branch: "main", AllowedCommitAuthors: tc.allowedAuthors, RequireSignedCommits: tc.requireSignedCommits}},
+					RejectCommandsForDisabledApplications: true,
+				}
+			)
+			app.Disabled = tc.disabled
+			if tc.lastCommit != "" {
+				ac.mostRecent[app.Id] = newDeploymentReference("deployment-1", tc.lastCommit)
+			}
+			tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			c := candidate{application: app, kind: tc.kind}
+			if tc.kind == model.TriggerKind_ON_COMMAND || tc.kind == model.TriggerKind_ON_EXTERNAL {
+				sa := &model.Command_SyncApplication{ApplicationId: app.Id}
+				if tc.kind == model.TriggerKind_ON_EXTERNAL {
+					sa.ExternalSource = "gcr.io/org/app"
+				}
+				c.command = cr.Command(&model.Command{
+					Id:              "command-1",
+					ApplicationId:   app.Id,
+					Commander:       "user",
+					SyncApplication: sa,
+				})
+			}
+			require.NoError(t, tr.checkCandidates(context.Background(), []candidate{c}))
+
+			created := ac.Created()
+			assert.Equal(t, tc.expectedReports, cr.Reported("command-1"))
+			// The reason is kept even when no deployment was triggered.
+			d, ok := tr.GetLastDecisionGetter().Get(app.Id)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, d.Triggered)
+			assert.NotEmpty(t, d.Reason)
+			if !tc.expected {
+				assert.Empty(t, created)
+				return
+			}
+			require.Len(t, created, 1)
+			assert.Equal(t, app.Id, created[0].ApplicationId)
+			assert.Equal(t, "commit-2", created[0].Trigger.Commit.Hash)
+			assert.NotEmpty(t, created[0].Metadata[model.MetadataKeyTriggerReason])
+			assert.Equal(t, c.externalSource(), created[0].Metadata[model.MetadataKeyTriggerSource])
+
+			// The triggered commit is not triggered again.
+			require.NoError(t, tr.checkCandidates(context.Background(), []candidate{{application: app, kind: model.TriggerKind_ON_COMMIT}}))
+			assert.Len(t, ac.Created(), 1)
+		})
+	}
+}
+
+func TestCheckCandidatesOnOutOfSync(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  trigger:
+    onOutOfSync:
+      disabled: %t
+%s`
+	testcases := []struct {
+		name               string
+		disabled           bool
+		triggerOnOutOfSync string
+		kind               model.TriggerKind
+		expected           bool
+		reason             string
+	}{
+		{
+			name:     "drift with onOutOfSync enabled",
+			disabled: false,
+			kind:     model.TriggerKind_ON_OUT_OF_SYNC,
+			expected: true,
+		},
+		{
+			name:               "drift with triggerOnOutOfSync true",
+			disabled:           false,
+			triggerOnOutOfSync: "    triggerOnOutOfSync: true\n",
+			kind:               model.TriggerKind_ON_OUT_OF_SYNC,
+			expected:           true,
+		},
+		{
+			name:               "drift with triggerOnOutOfSync false",
+			disabled:           false,
+			triggerOnOutOfSync: "    triggerOnOutOfSync: false\n",
+			kind:               model.TriggerKind_ON_OUT_OF_SYNC,
+			expected:           false,
+			reason:             "trigger.triggerOnOutOfSync is false",
+		},
+		{
+			name:     "drift with onOutOfSync disabled",
+			disabled: true,
+			kind:     model.TriggerKind_ON_OUT_OF_SYNC,
+			expected: false,
+			reason:   "trigger.onOutOfSync is disabled",
+		},
+		{
+			name:     "command with onOutOfSync enabled",
+			disabled: false,
+			kind:     model.TriggerKind_ON_COMMAND,
+			expected: true,
+		},
+		{
+			name:     "command with onOutOfSync disabled",
+			disabled: true,
+			kind:     model.TriggerKind_ON_COMMAND,
+			expected: true,
+		},
+		{
+			name:               "command with triggerOnOutOfSync false",
+			disabled:           false,
+			triggerOnOutOfSync: "    triggerOnOutOfSync: false\n",
+			kind:               model.TriggerKind_ON_COMMAND,
+			expected:           true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+			require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(fmt.Sprintf(appCfg, tc.disabled, tc.triggerOnOutOfSync)), 0600))
+
+			var (
+				app = &model.Application{
+					Id:        "app-1",
+					Name:      "app",
+					Kind:      model.ApplicationKind_KUBERNETES,
+					ProjectId: "project-1",
+					PipedId:   "piped-1",
+					GitPath: &model.ApplicationGitPath{
+						Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+						Path:           "app",
+						ConfigFilename: "app.pipecd.yaml",
+					},
+					SyncState: &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_OUT_OF_SYNC},
+				}
+				ac = &recordingAPIClient{}
+				gc = &fakeGitClient{repos: map[string]git.Repo{
+					"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+				}}
+				cr  = &commandRecorder{}
+				cfg = &config.PipedSpec{
+					ProjectID:    "project-1",
+					PipedID:      "piped-1",
+					Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+				}
+			)
+			tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			c := candidate{application: app, kind: tc.kind}
+			if tc.kind == model.TriggerKind_ON_COMMAND {
+				c.command = cr.Command(&model.Command{
+					Id:              "command-1",
+					ApplicationId:   app.Id,
+					Commander:       "user",
+					SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id},
+				})
+			}
+			require.NoError(t, tr.checkCandidates(context.Background(), []candidate{c}))
+
+			d, ok := tr.GetLastDecisionGetter().Get(app.Id)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, d.Triggered, d.Reason)
+			if tc.expected {
+				assert.Len(t, ac.Created(), 1)
+			} else {
+				assert.Empty(t, ac.Created())
+				assert.Contains(t, d.Reason, tc.reason)
+			}
+		})
+	}
+}
+
+func TestCheckCandidatesOnSchedule(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  trigger:
+    onSchedule:
+      cron: "* * * * *"
+`
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(appCfg), 0600))
+
+	var (
+		app = &model.Application{
+			Id:        "app-1",
+			Name:      "app",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		ac = &recordingAPIClient{}
+		gc = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID:    "project-1",
+			PipedID:      "piped-1",
+			Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+		}
+		cs = []candidate{
+			{application: app, kind: model.TriggerKind_ON_COMMIT},
+			{application: app, kind: model.TriggerKind_ON_SCHEDULE},
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tr.commitStore.Put(app.Id, "commit-1"))
+	tr.schedules.Triggered(app.Id, time.Now().Add(-2*time.Minute))
+
+	// The head commit is redeployed since the schedule was reached.
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+	d, ok := tr.GetLastDecisionGetter().Get(app.Id)
+	require.True(t, ok)
+	assert.True(t, d.Triggered, d.Reason)
+	require.Len(t, ac.Created(), 1)
+	assert.Equal(t, model.SyncStrategy_QUICK_SYNC, ac.Created()[0].Trigger.SyncStrategy)
+	assert.Equal(t, "commit-1", ac.Created()[0].Trigger.Commit.Hash)
+
+	// The schedule restarts from the triggered deployment.
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+	assert.Len(t, ac.Created(), 1)
+}
+
+func TestCheckCandidatesDeployEachCommit(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  trigger:
+    onCommit:
+      deployEachCommit: true
+      maxCommitsPerCheck: 2
+`
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(appCfg), 0600))
+
+	var (
+		app = &model.Application{
+			Id:        "app-1",
+			Name:      "app",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		ac = &recordingAPIClient{mostRecent: map[string]*model.ApplicationDeploymentReference{
+			"app-1": newDeploymentReference("deployment-1", "commit-1"),
+		}}
+		gc = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeHistoryRepo{
+				fakeRepo: &fakeRepo{
+					path: repoPath,
+					head: git.Commit{Hash: "commit-6"},
+				},
+				first: "commit-1",
+				commits: []git.Commit{
+					{Hash: "commit-2"},
+					{Hash: "commit-3"},
+					{Hash: "commit-4"},
+					{Hash: "commit-5"},
+					{Hash: "commit-6"},
+				},
+				changes: map[string][]string{
+					"commit-2": {"app/deployment.yaml"},
+					"commit-3": {"app/service.yaml"},
+					"commit-4": {"another/deployment.yaml"},
+					"commit-5": {"app/deployment.yaml"},
+					"commit-6": {"another/service.yaml"},
+				},
+			},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID:    "project-1",
+			PipedID:      "piped-1",
+			Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+		}
+		ctx = context.Background()
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+	triggeredCommits := func() []string {
+		out := make([]string, 0)
+		for _, d := range ac.Created() {
+			out = append(out, d.Trigger.Commit.Hash)
+		}
+		return out
+	}
+	cs := []candidate{{application: app, kind: model.TriggerKind_ON_COMMIT}}
+
+	// The commits touching the application are deployed in order up to the limit.
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"commit-2", "commit-3"}, triggeredCommits())
+	commit, err := tr.commitStore.Get(ctx, app.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "commit-3", commit)
+
+	// The rest of them are deployed at the next check.
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Equal(t, []string{"commit-2", "commit-3", "commit-5"}, triggeredCommits())
+	commit, err = tr.commitStore.Get(ctx, app.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "commit-6", commit)
+
+	require.NoError(t, tr.checkCandidates(ctx, cs))
+	assert.Len(t, ac.Created(), 3)
+}
+
+func TestSortCandidatesByApplication(t *testing.T) {
+	t.Parallel()
+
+	var (
+		appA  = &model.Application{Id: "app-3", Name: "a"}
+		appB1 = &model.Application{Id: "app-1", Name: "b"}
+		appB2 = &model.Application{Id: "app-2", Name: "b"}
+	)
+	cs := []candidate{
+		{application: appB2, kind: model.TriggerKind_ON_COMMIT},
+		{application: appA, kind: model.TriggerKind_ON_OUT_OF_SYNC},
+		{application: appB1, kind: model.TriggerKind_ON_COMMIT},
+		{application: appA, kind: model.TriggerKind_ON_COMMIT},
+	}
+	sortCandidatesByApplication(cs)
+
+	got := make([]string, 0, len(cs))
+	for _, c := range cs {
+		got = append(got, fmt.Sprintf("%s/%s", c.application.Id, c.kind))
+	}
+	// The candidates of the same application are kept in the given order.
+	assert.Equal(t, []string{"app-3/ON_OUT_OF_SYNC", "app-3/ON_COMMIT", "app-1/ON_COMMIT", "app-2/ON_COMMIT"}, got)
+}
+
+func TestCheckCandidatesInStableOrder(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+	repoPath := t.TempDir()
+	apps := make([]*model.Application, 0, 4)
+	for _, name := range []string{"delta", "alpha", "charlie", "bravo"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoPath, name), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, name, "app.pipecd.yaml"), []byte(appCfg), 0600))
+		apps = append(apps, &model.Application{
+			Id:        "id-" + name,
+			Name:      name,
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           name,
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		})
+	}
+
+	for _, limit := range []int{0, 2} {
+		var (
+			ac = &recordingAPIClient{}
+			gc = &fakeGitClient{repos: map[string]git.Repo{
+				"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+			}}
+			cfg = &config.PipedSpec{
+				ProjectID:          "project-1",
+				PipedID:            "piped-1",
+				Repositories:       []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+				MaxTriggersPerTick: limit,
+			}
+		)
+		tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: apps}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+		require.NoError(t, err)
+
+		cs := make([]candidate, 0, len(apps))
+		for _, app := range apps {
+			cs = append(cs, candidate{application: app, kind: model.TriggerKind_ON_COMMIT})
+		}
+		require.NoError(t, tr.checkCandidates(context.Background(), cs))
+
+		got := make([]string, 0)
+		for _, d := range ac.Created() {
+			got = append(got, d.ApplicationName)
+		}
+		want := []string{"alpha", "bravo", "charlie", "delta"}
+		if limit > 0 {
+			want = want[:limit]
+		}
+		assert.Equal(t, want, got, "limit: %d", limit)
+	}
+}
+
+func TestCheckCandidatesOnDeniedBranch(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+	repoPath := t.TempDir()
+	newApp := func(name, branch string) *model.Application {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoPath, name), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, name, "app.pipecd.yaml"), []byte(appCfg), 0600))
+		return &model.Application{
+			Id:        "id-" + name,
+			Name:      name,
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: branch},
+				Path:           name,
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+	}
+	var (
+		allowed = newApp("allowed", "main")
+		denied  = newApp("denied", "temp/foo")
+		ac      = &recordingAPIClient{}
+		gc      = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID:      "project-1",
+			PipedID:        "piped-1",
+			Repositories:   []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			DeniedBranches: []string{"temp/*"},
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{allowed, denied}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	cr := &commandRecorder{}
+	cs := []candidate{
+		{application: allowed, kind: model.TriggerKind_ON_COMMIT},
+		{application: denied, kind: model.TriggerKind_ON_COMMIT},
+		{
+			application: denied,
+			kind:        model.TriggerKind_ON_COMMAND,
+			command: cr.Command(&model.Command{
+				Id:              "command-1",
+				ApplicationId:   denied.Id,
+				Commander:       "user",
+				SyncApplication: &model.Command_SyncApplication{ApplicationId: denied.Id},
+			}),
+		},
+	}
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+
+	got := make([]string, 0)
+	for _, d := range ac.Created() {
+		got = append(got, d.ApplicationName)
+	}
+	assert.Equal(t, []string{"allowed"}, got)
+	// The denied branch is not even pulled.
+	assert.Equal(t, []gitRepoKey{{repoID: "repo-1", branch: "main"}}, gc.cloned)
+
+	d, ok := tr.GetLastDecisionGetter().Get(denied.Id)
+	require.True(t, ok)
+	assert.False(t, d.Triggered)
+	assert.Equal(t, "the branch temp/foo is denied", d.Reason)
+	// The command for the denied branch is not left unhandled.
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, cr.Reported("command-1"))
+}
+
+func TestCheckCandidatesCommandOnUnsatisfiedCondition(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  trigger:
+    condition:
+      and:
+        - kind: ON_COMMIT
+        - kind: ON_EXTERNAL
+`
+	var (
+		repoPath = t.TempDir()
+		app      = newTestApplication(t, repoPath, "app-1", appCfg)
+		ac       = &recordingAPIClient{}
+		gc       = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID:    "project-1",
+			PipedID:      "piped-1",
+			Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	cr := &commandRecorder{}
+	cs := []candidate{
+		{
+			application: app,
+			kind:        model.TriggerKind_ON_COMMAND,
+			command: cr.Command(&model.Command{
+				Id:              "command-1",
+				ApplicationId:   app.Id,
+				Commander:       "user",
+				SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id},
+			}),
+		},
+	}
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+
+	assert.Empty(t, ac.Created())
+	d, ok := tr.GetLastDecisionGetter().Get(app.Id)
+	require.True(t, ok)
+	assert.False(t, d.Triggered)
+	assert.Contains(t, d.Reason, "was not satisfied")
+	// The command which can never satisfy the condition is not left unhandled.
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, cr.Reported("command-1"))
+}
+
+func TestCheckCandidatesOnDeniedCommit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repoPath = t.TempDir()
+		app      = newTestApplication(t, repoPath, "app-1", testAppConfig)
+		ac       = &recordingAPIClient{}
+		gc       = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "0123456789abcdef"}},
+		}}
+		cfg = &config.PipedSpec{
+			ProjectID:    "project-1",
+			PipedID:      "piped-1",
+			Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main", DeniedCommits: []string{"0123456"}}},
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	cr := &commandRecorder{}
+	cs := []candidate{
+		{application: app, kind: model.TriggerKind_ON_COMMIT},
+		{
+			application: app,
+			kind:        model.TriggerKind_ON_COMMAND,
+			command: cr.Command(&model.Command{
+				Id:              "command-1",
+				ApplicationId:   app.Id,
+				Commander:       "user",
+				SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id},
+			}),
+		},
+	}
+	require.NoError(t, tr.checkCandidates(context.Background(), cs))
+
+	assert.Empty(t, ac.Created())
+	d, ok := tr.GetLastDecisionGetter().Get(app.Id)
+	require.True(t, ok)
+	assert.False(t, d.Triggered)
+	assert.Equal(t, "head commit 0123456789abcdef is denied", d.Reason)
+	// The command is not left unhandled until the head commit changes.
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, cr.Reported("command-1"))
+}
+
+// stoppingGitClient simulates the trigger being stopped while cloning a repository.
+type stoppingGitClient struct {
+	gitClient
+	stop func()
+	// Whether to keep cloning until the given context is done.
+	block bool
+}
+
+func (c *stoppingGitClient) Clone(ctx context.Context, _, _, _, _ string) (git.Repo, error) {
+	c.stop()
+	if c.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return nil, errors.New("failed to clone")
+}
+
+func TestCheckCommandCandidatesWhileStopping(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		gracePeriod time.Duration
+		block       bool
+		expected    []model.CommandStatus
+	}{
+		{
+			name:        "completed within the grace period",
+			gracePeriod: time.Minute,
+		},
+		{
+			name:        "aborted after the grace period",
+			gracePeriod: 10 * time.Millisecond,
+			block:       true,
+			expected:    []model.CommandStatus{model.CommandStatus_COMMAND_FAILED},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				reported []model.CommandStatus
+			)
+			cmd := model.ReportableCommand{
+				Command: &model.Command{Id: "cmd-1"},
+				Report: func(ctx context.Context, status model.CommandStatus, _ map[string]string, _ []byte) error {
+					// The status must be reported with the live context even after stopping.
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					reported = append(reported, status)
+					return nil
+				},
+			}
+
+			ctx, stop := context.WithCancel(context.Background())
+			defer stop()
+			workCtx, cancel := withGracePeriod(ctx, tc.gracePeriod)
+			defer cancel()
+
+			cfg := &config.PipedSpec{
+				Repositories: []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			}
+			tr, err := NewTrigger(&recordingAPIClient{}, &stoppingGitClient{stop: stop, block: tc.block}, nil, nil, nil, nil, cfg, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			tr.checkCommandCandidates(workCtx, []candidate{
+				{
+					application: &model.Application{
+						Id: "app-1",
+						GitPath: &model.ApplicationGitPath{
+							Repo: &model.ApplicationGitRepository{Id: "repo-1", Branch: "main"},
+						},
+					},
+					kind:    model.TriggerKind_ON_COMMAND,
+					command: cmd,
+				},
+			})
+
+			// The command left unreported by the completed check is kept to be handled at the next check.
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, tc.expected, reported)
+		})
+	}
+}
+
+func TestWithGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	ctx, stop := context.WithCancel(context.Background())
+	gctx, cancel := withGracePeriod(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	stop()
+	assert.NoError(t, gctx.Err())
+	select {
+	case <-gctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected to be cancelled after the grace period")
+	}
+}
+
+// blockingRepo simulates a repository whose pull never finishes until the given context is done.
+type blockingRepo struct {
+	fakeRepo
+}
+
+func (r *blockingRepo) Pull(ctx context.Context, _ string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestUpdateRepoToLatestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.PipedSpec{
+		Repositories: []config.PipedRepository{
+			{RepoID: "repo-1", Branch: "main"},
+			{RepoID: "repo-2", Branch: "main"},
+		},
+		GitOperationTimeout: config.Duration(10 * time.Millisecond),
+	}
+	gitClient := &fakeGitClient{
+		repos: map[string]git.Repo{
+			"repo-1": &blockingRepo{},
+			"repo-2": &fakeRepo{head: git.Commit{Hash: "hash-2"}},
+		},
+	}
+	tr, err := NewTrigger(nil, gitClient, nil, nil, nil, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	start := time.Now()
+	_, _, err = tr.updateRepoToLatest(ctx, gitRepoKey{repoID: "repo-1", branch: "main"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// The other repository is still updated after the timed out one.
+	_, head, err := tr.updateRepoToLatest(ctx, gitRepoKey{repoID: "repo-2", branch: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "hash-2", head.Hash)
+}
+
+func TestListCandidatesInRepositories(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id, repoID string, status model.ApplicationSyncStatus, labels map[string]string) *model.Application {
+		return &model.Application{
+			Id: id,
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: repoID},
+			},
+			SyncState: &model.ApplicationSyncState{Status: status},
+			Labels:    labels,
+		}
+	}
+	// The disabled applications are not listed.
+	disabledApp := newApp("app-5", "repo-1", model.ApplicationSyncStatus_OUT_OF_SYNC, map[string]string{"team": "a"})
+	disabledApp.Disabled = true
+
+	tr := &Trigger{
+		applicationLister: &fakeApplicationLister{
+			apps: []*model.Application{
+				newApp("app-1", "repo-1", model.ApplicationSyncStatus_SYNCED, map[string]string{"team": "a"}),
+				newApp("app-2", "repo-2", model.ApplicationSyncStatus_OUT_OF_SYNC, map[string]string{"team": "b"}),
+				newApp("app-3", "repo-1", model.ApplicationSyncStatus_OUT_OF_SYNC, map[string]string{"team": "a", "env": "dev"}),
+				newApp("app-4", "repo-3", model.ApplicationSyncStatus_OUT_OF_SYNC, nil),
+				disabledApp,
+			},
+		},
+		config: &config.PipedSpec{},
+	}
+	repos := makeRepoSet([]string{"repo-1", "repo-2"})
+
+	appIDs := func(cs []candidate) []string {
+		ids := make([]string, 0, len(cs))
+		for _, c := range cs {
+			ids = append(ids, c.application.Id)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"app-1", "app-2", "app-3"}, appIDs(tr.listCommitCandidates(repos)))
+	assert.Equal(t, []string{"app-2", "app-3"}, appIDs(tr.listOutOfSyncCandidates(repos)))
+
+	tr.config.TriggerSelector = map[string]string{"team": "a"}
+	assert.Equal(t, []string{"app-1", "app-3"}, appIDs(tr.listCommitCandidates(repos)))
+	assert.Equal(t, []string{"app-3"}, appIDs(tr.listOutOfSyncCandidates(repos)))
+}
+
+func TestTriggerDeploymentInDryRunMode(t *testing.T) {
+	t.Parallel()
+
+	// The API client is not set so any call to the control-plane would panic.
+	tr := &Trigger{
+		config: &config.PipedSpec{DryRun: true},
+		logger: zap.NewNop(),
+	}
+	d := &model.Deployment{
+		Id: "deployment-id",
+		Trigger: &model.DeploymentTrigger{
+			Commit:       &model.Commit{Hash: "commit-hash"},
+			SyncStrategy: model.SyncStrategy_QUICK_SYNC,
+		},
+	}
+	assert.NoError(t, tr.triggerDeployment(context.Background(), d, model.TriggerKind_ON_COMMIT))
+	assert.NoError(t, tr.triggerDeploymentChain(context.Background(), &config.DeploymentChain{}, d))
+}
+
+func TestCheckCandidatesInDryRunMode(t *testing.T) {
+	t.Parallel()
+
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte("apiVersion: pipecd.dev/v1beta1\nkind: KubernetesApp\nspec:\n  name: app\n"), 0600))
+
+	var (
+		app = &model.Application{
+			Id:        "app-1",
+			Name:      "app",
+			Kind:      model.ApplicationKind_KUBERNETES,
+			ProjectId: "project-1",
+			PipedId:   "piped-1",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+		ac = &recordingAPIClient{}
+		gc = &fakeGitClient{repos: map[string]git.Repo{
+			"repo-1": &fakeRepo{path: repoPath, head: git.Commit{Hash: "commit-1"}},
+		}}
+		cr        = &commandRecorder{}
+		storePath = filepath.Join(t.TempDir(), "last-triggered-commits")
+		cfg       = &config.PipedSpec{
+			ProjectID:                    "project-1",
+			PipedID:                      "piped-1",
+			Repositories:                 []config.PipedRepository{{RepoID: "repo-1", Branch: "main"}},
+			DryRun:                       true,
+			LastTriggeredCommitStoreFile: storePath,
+		}
+	)
+	tr, err := NewTrigger(ac, gc, &fakeApplicationLister{apps: []*model.Application{app}}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	c := candidate{
+		application: app,
+		kind:        model.TriggerKind_ON_COMMAND,
+		command: cr.Command(&model.Command{
+			Id:              "command-1",
+			ApplicationId:   app.Id,
+			Commander:       "user",
+			SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id},
+		}),
+	}
+	require.NoError(t, tr.checkCandidates(context.Background(), []candidate{c}))
+
+	assert.Empty(t, ac.Created())
+	// The command is not reported as succeeded since no deployment was created.
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, cr.Reported("command-1"))
+
+	// The handled commit is kept in memory only.
+	commit, err := tr.commitStore.Get(context.Background(), app.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "commit-1", commit)
+	f, values, err := openLastTriggeredCommitFile(storePath)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Empty(t, values)
+}
+
+func TestAuditDecision(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zap.InfoLevel)
+	tr := &Trigger{decisions: newDecisionStore(), auditLogger: zap.New(core)}
+	var (
+		key = gitRepoKey{repoID: "repo-1", branch: "main"}
+		app = &model.Application{Id: "app-id", Name: "app"}
+	)
+
+	tr.auditDecision(candidate{application: app, kind: model.TriggerKind_ON_COMMIT}, key, "commit-1", "", "no trigger was satisfied")
+	tr.auditDecision(candidate{
+		application: app,
+		kind:        model.TriggerKind_ON_COMMAND,
+		command:     model.ReportableCommand{Command: &model.Command{Id: "cmd-1", Commander: "user"}},
+	}, key, "commit-2", "deployment-1", "received a SYNC command, command: cmd-1")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "app-id", fields["app-id"])
+	assert.Equal(t, "repo-1", fields["repo-id"])
+	assert.Equal(t, "commit-1", fields["commit"])
+	assert.Equal(t, "ON_COMMIT", fields["kind"])
+	assert.Equal(t, false, fields["triggered"])
+	assert.Equal(t, "no trigger was satisfied", fields["reason"])
+	assert.Equal(t, "system", fields["actor"])
+
+	fields = entries[1].ContextMap()
+	assert.Equal(t, "commit-2", fields["commit"])
+	assert.Equal(t, "ON_COMMAND", fields["kind"])
+	assert.Equal(t, true, fields["triggered"])
+	assert.Equal(t, "deployment-1", fields["deployment-id"])
+	assert.Equal(t, "user", fields["actor"])
+
+	// Only the most recent decision of the application is kept.
+	d, ok := tr.GetLastDecisionGetter().Get("app-id")
+	require.True(t, ok)
+	assert.Equal(t, "ON_COMMAND", d.Kind)
+	assert.Equal(t, "commit-2", d.Commit)
+	assert.True(t, d.Triggered)
+	assert.Equal(t, "deployment-1", d.DeploymentID)
+	assert.Len(t, tr.GetLastDecisionGetter().List(), 1)
+}
+
+func TestReportCommandFailed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		reported []model.CommandStatus
+		metadata map[string]string
+	)
+	cmd := model.ReportableCommand{
+		Command: &model.Command{Id: "cmd-1"},
+		Report: func(_ context.Context, status model.CommandStatus, md map[string]string, _ []byte) error {
+			reported = append(reported, status)
+			metadata = md
+			return nil
+		},
+	}
+	tr := &Trigger{logger: zap.NewNop()}
+	app := &model.Application{Id: "app-id"}
+
+	// Nothing is reported for the candidates without command.
+	tr.reportCommandFailed(context.Background(), candidate{application: app, kind: model.TriggerKind_ON_COMMIT}, "failed")
+	assert.Empty(t, reported)
+
+	tr.reportCommandFailed(context.Background(), candidate{application: app, kind: model.TriggerKind_ON_COMMAND, command: cmd}, "failed to trigger application app-id: unavailable")
+	assert.Equal(t, []model.CommandStatus{model.CommandStatus_COMMAND_FAILED}, reported)
+	assert.Equal(t, map[string]string{model.MetadataKeyTriggerError: "failed to trigger application app-id: unavailable"}, metadata)
+}
+
+func TestListCommandCandidates(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{Id: "app-1"}
+	newCommand := func(id string, sa *model.Command_SyncApplication) model.ReportableCommand {
+		return model.ReportableCommand{
+			Command: &model.Command{Id: id, ApplicationId: sa.ApplicationId, Type: model.Command_SYNC_APPLICATION, SyncApplication: sa},
+		}
+	}
+	tr := &Trigger{
+		applicationLister: &fakeApplicationLister{apps: []*model.Application{app}},
+		commandLister: &fakeCommandLister{
+			commands: []model.ReportableCommand{
+				newCommand("cmd-1", &model.Command_SyncApplication{ApplicationId: "app-1"}),
+				newCommand("cmd-2", &model.Command_SyncApplication{ApplicationId: "app-1", ExternalSource: "gcr.io/org/app"}),
+				newCommand("cmd-3", &model.Command_SyncApplication{ApplicationId: "unregistered"}),
+			},
+		},
+		logger: zap.NewNop(),
+	}
+
+	got := tr.listCommandCandidates()
+	require.Len(t, got, 2)
+	assert.Equal(t, model.TriggerKind_ON_COMMAND, got[0].kind)
+	assert.Equal(t, "", got[0].externalSource())
+	assert.Equal(t, model.TriggerKind_ON_EXTERNAL, got[1].kind)
+	assert.Equal(t, "gcr.io/org/app", got[1].externalSource())
+}
+
+func TestRefreshLastTriggeredCommits(t *testing.T) {
+	t.Parallel()
+
+	cache, err := memorycache.NewLRUCache(10)
+	require.NoError(t, err)
+
+	var (
+		now      = time.Now()
+		reported = make(map[string]model.CommandStatus)
+		pending  = model.DeploymentStatus_DEPLOYMENT_PENDING
+	)
+	newCommand := func(id string, cmd *model.Command) model.ReportableCommand {
+		cmd.Id = id
+		return model.ReportableCommand{
+			Command: cmd,
+			Report: func(_ context.Context, status model.CommandStatus, _ map[string]string, _ []byte) error {
+				reported[id] = status
+				return nil
+			},
+		}
+	}
+	apiClient := &fakeDependencyAPIClient{
+		mostRecent: map[string]map[model.DeploymentStatus]*model.ApplicationDeploymentReference{
+			"app-1": {pending: newDeploymentReference("deployment-1", "commit-1")},
+		},
+	}
+	tr := &Trigger{
+		commandLister: &fakeCommandLister{
+			commands: []model.ReportableCommand{
+				newCommand("cmd-1", &model.Command{
+					RefreshLastTriggeredCommit: &model.Command_RefreshLastTriggeredCommit{ApplicationId: "app-1"},
+				}),
+				newCommand("cmd-2", &model.Command{
+					RefreshLastTriggeredCommit: &model.Command_RefreshLastTriggeredCommit{ApplicationId: "app-2"},
+				}),
+				newCommand("cmd-3", &model.Command{
+					SyncApplication: &model.Command_SyncApplication{ApplicationId: "app-1"},
+				}),
+			},
+		},
+		commitStore: &lastTriggeredCommitStore{apiClient: apiClient, cache: cache},
+		logger:      zap.NewNop(),
+	}
+	tr.commitStore.PutTriggered("app-1", "stale-commit", now)
+	tr.commitStore.Put("app-2", "stale-commit")
+
+	tr.refreshLastTriggeredCommits(context.Background())
+
+	assert.Equal(t, map[string]model.CommandStatus{
+		"cmd-1": model.CommandStatus_COMMAND_SUCCEEDED,
+		"cmd-2": model.CommandStatus_COMMAND_SUCCEEDED,
+	}, reported)
+
+	commit, err := tr.commitStore.Get(context.Background(), "app-1")
+	require.NoError(t, err)
+	assert.Equal(t, "commit-1", commit)
+	// The time when the last deployment was triggered must be kept.
+	at, ok := tr.commitStore.GetTriggeredAt("app-1")
+	assert.True(t, ok)
+	assert.Equal(t, now, at)
+
+	// The application has never been deployed.
+	commit, err = tr.commitStore.Get(context.Background(), "app-2")
+	require.NoError(t, err)
+	assert.Equal(t, "", commit)
+}
+
+func TestCandidateTargetCommitHash(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{Id: "app-id"}
+	testcases := []struct {
+		name      string
+		candidate candidate
+		expected  string
+	}{
+		{
+			name:      "no command",
+			candidate: candidate{application: app, kind: model.TriggerKind_ON_COMMIT},
+			expected:  "",
+		},
+		{
+			name: "sync command without target commit",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_COMMAND,
+				command: model.ReportableCommand{
+					Command: &model.Command{SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id}},
+				},
+			},
+			expected: "",
+		},
+		{
+			name: "sync command with target commit",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_COMMAND,
+				command: model.ReportableCommand{
+					Command: &model.Command{SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id, TargetCommitHash: "commit-hash"}},
+				},
+			},
+			expected: "commit-hash",
+		},
+		{
+			name: "chain sync command",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_CHAIN,
+				command: model.ReportableCommand{
+					Command: &model.Command{ChainSyncApplication: &model.Command_ChainSyncApplication{ApplicationId: app.Id}},
+				},
+			},
+			expected: "",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.candidate.targetCommitHash())
+		})
+	}
+}
+
+func TestCandidateSyncStrategy(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{Id: "app-id"}
+	testcases := []struct {
+		name      string
+		candidate candidate
+		expected  model.SyncStrategy
+	}{
+		{
+			name:      "no command",
+			candidate: candidate{application: app, kind: model.TriggerKind_ON_COMMIT},
+			expected:  model.SyncStrategy_AUTO,
+		},
+		{
+			name: "sync command without strategy",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_COMMAND,
+				command: model.ReportableCommand{
+					Command: &model.Command{SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id}},
+				},
+			},
+			expected: model.SyncStrategy_AUTO,
+		},
+		{
+			name: "sync command with strategy",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_COMMAND,
+				command: model.ReportableCommand{
+					Command: &model.Command{SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id, SyncStrategy: model.SyncStrategy_PIPELINE}},
+				},
+			},
+			expected: model.SyncStrategy_PIPELINE,
+		},
+		{
+			name: "chain sync command with strategy",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_CHAIN,
+				command: model.ReportableCommand{
+					Command: &model.Command{ChainSyncApplication: &model.Command_ChainSyncApplication{ApplicationId: app.Id, SyncStrategy: model.SyncStrategy_QUICK_SYNC}},
+				},
+			},
+			expected: model.SyncStrategy_QUICK_SYNC,
+		},
+		{
+			name: "sync command of external source with strategy",
+			candidate: candidate{
+				application: app,
+				kind:        model.TriggerKind_ON_EXTERNAL,
+				command: model.ReportableCommand{
+					Command: &model.Command{SyncApplication: &model.Command_SyncApplication{ApplicationId: app.Id, SyncStrategy: model.SyncStrategy_QUICK_SYNC, ExternalSource: "gcr.io/org/app"}},
+				},
+			},
+			expected: model.SyncStrategy_QUICK_SYNC,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.candidate.syncStrategy())
+		})
+	}
+}
+
+func TestIsCoolingDown(t *testing.T) {
+	t.Parallel()
+
+	cache, err := memorycache.NewLRUCache(10)
+	require.NoError(t, err)
+	tr := &Trigger{
+		config:      &config.PipedSpec{TriggerCooldown: config.Duration(time.Minute)},
+		commitStore: &lastTriggeredCommitStore{cache: cache},
+	}
+
+	var (
+		now = time.Now()
+		app = &model.Application{Id: "app-id"}
+	)
+	newCandidate := func(kind model.TriggerKind) candidate {
+		return candidate{application: app, kind: kind}
+	}
+
+	// No deployment has been triggered yet.
+	assert.False(t, tr.isCoolingDown(newCandidate(model.TriggerKind_ON_COMMIT), now))
+
+	tr.commitStore.PutTriggered(app.Id, "commit-1", now)
+	// Advancing the last handled commit must keep the triggered time.
+	tr.commitStore.Put(app.Id, "commit-2")
+
+	assert.True(t, tr.isCoolingDown(newCandidate(model.TriggerKind_ON_COMMIT), now.Add(30*time.Second)))
+	assert.True(t, tr.isCoolingDown(newCandidate(model.TriggerKind_ON_OUT_OF_SYNC), now.Add(30*time.Second)))
+	assert.False(t, tr.isCoolingDown(newCandidate(model.TriggerKind_ON_COMMAND), now.Add(30*time.Second)))
+	assert.False(t, tr.isCoolingDown(newCandidate(model.TriggerKind_ON_COMMIT), now.Add(time.Minute)))
+}
+
+func TestSyncDelay(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), syncDelay(time.Minute, 0))
+	assert.Equal(t, time.Duration(0), syncDelay(0, 0.1))
+	for i := 0; i < 100; i++ {
+		d := syncDelay(time.Minute, 0.1)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.Less(t, d, 6*time.Second)
+	}
+}
+
+func TestDetermineCommitSyncStrategy(t *testing.T) {
+	t.Parallel()
+
+	tr := &Trigger{logger: zap.NewNop()}
+	app := &model.Application{Id: "app-id"}
+
+	testcases := []struct {
+		name     string
+		body     string
+		expected model.SyncStrategy
+	}{
+		{
+			name:     "no trailer",
+			body:     "Update manifests",
+			expected: model.SyncStrategy_AUTO,
+		},
+		{
+			name:     "quick sync",
+			body:     "Update manifests\n\nPipecd-Sync-Strategy: QUICK_SYNC",
+			expected: model.SyncStrategy_QUICK_SYNC,
+		},
+		{
+			name:     "pipeline in lower case",
+			body:     "Pipecd-Sync-Strategy: pipeline",
+			expected: model.SyncStrategy_PIPELINE,
+		},
+		{
+			name:     "unknown strategy",
+			body:     "Pipecd-Sync-Strategy: FAST",
+			expected: model.SyncStrategy_AUTO,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, _ := tr.determineCommitSyncStrategy(app, git.Commit{Body: tc.body})
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestIsInTriggerWindows(t *testing.T) {
+	t.Parallel()
+
+	// 2021-08-02 is Monday.
+	monday := time.Date(2021, 8, 2, 10, 0, 0, 0, time.UTC)
+
+	tr := &Trigger{config: &config.PipedSpec{}}
+	assert.True(t, tr.isInTriggerWindows(monday))
+
+	tr.config.TriggerWindows = []config.PipedTriggerWindow{
+		{Days: []string{"Sat", "Sun"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+		{Days: []string{"Mon"}, Start: "12:00", End: "13:00", Timezone: "UTC"},
+	}
+	assert.False(t, tr.isInTriggerWindows(monday))
+	assert.True(t, tr.isInTriggerWindows(monday.Add(2*time.Hour)))
+}
+
+func TestIsEnvironmentOpen(t *testing.T) {
+	t.Parallel()
+
+	// 2021-08-02 is Monday.
+	monday := time.Date(2021, 8, 2, 10, 0, 0, 0, time.UTC)
+
+	tr := &Trigger{config: &config.PipedSpec{
+		EnvironmentTriggerRules: []config.PipedEnvironmentTriggerRule{
+			{
+				EnvID: "prod",
+				TriggerWindows: []config.PipedTriggerWindow{
+					{Days: []string{"Mon"}, Start: "12:00", End: "13:00", Timezone: "UTC"},
+				},
+			},
+			{
+				EnvID: "stg",
+				TriggerWindows: []config.PipedTriggerWindow{
+					{Days: []string{"Mon"}, Start: "12:00", End: "13:00", Timezone: "UTC"},
+				},
+				AllowCommands: true,
+			},
+		},
+	}}
+	candidateOf := func(envID string, kind model.TriggerKind) candidate {
+		return candidate{application: &model.Application{Id: "app", EnvId: envID}, kind: kind}
+	}
+
+	assert.True(t, tr.isEnvironmentOpen(candidateOf("", model.TriggerKind_ON_COMMIT), monday))
+	assert.True(t, tr.isEnvironmentOpen(candidateOf("dev", model.TriggerKind_ON_COMMIT), monday))
+	assert.False(t, tr.isEnvironmentOpen(candidateOf("prod", model.TriggerKind_ON_COMMIT), monday))
+	assert.False(t, tr.isEnvironmentOpen(candidateOf("prod", model.TriggerKind_ON_COMMAND), monday))
+	assert.True(t, tr.isEnvironmentOpen(candidateOf("prod", model.TriggerKind_ON_COMMIT), monday.Add(2*time.Hour)))
+	assert.False(t, tr.isEnvironmentOpen(candidateOf("stg", model.TriggerKind_ON_OUT_OF_SYNC), monday))
+	assert.True(t, tr.isEnvironmentOpen(candidateOf("stg", model.TriggerKind_ON_COMMAND), monday))
+}
+
+type fakeSparseRepo struct {
+	git.Repo
+	dirs [][]string
+}
+
+func (r *fakeSparseRepo) SetSparseCheckout(_ context.Context, dirs []string) error {
+	r.dirs = append(r.dirs, dirs)
+	return nil
+}
+
+func TestGetGitRepoByBranch(t *testing.T) {
+	t.Parallel()
+
+	gc := &fakeGitClient{}
+	tr, err := NewTrigger(nil, gc, nil, nil, nil, nil, &config.PipedSpec{
+		Repositories: []config.PipedRepository{
+			{RepoID: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+		},
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	newApp := func(repoID, branch string) *model.Application {
+		return &model.Application{
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: repoID, Branch: branch},
+			},
+		}
+	}
+	assert.Equal(t, gitRepoKey{repoID: "repo-1", branch: "main"}, tr.gitRepoKeyOf(newApp("repo-1", "")))
+	assert.Equal(t, gitRepoKey{repoID: "repo-1", branch: "release"}, tr.gitRepoKeyOf(newApp("repo-1", "release")))
+
+	ctx := context.Background()
+	for _, key := range []gitRepoKey{
+		{repoID: "repo-1", branch: "main"},
+		{repoID: "repo-1", branch: "release"},
+		{repoID: "repo-1", branch: "main"},
+	} {
+		_, err := tr.getGitRepo(ctx, key)
+		require.NoError(t, err)
+	}
+	// Each distinct branch is cloned only once.
+	assert.Equal(t, []gitRepoKey{
+		{repoID: "repo-1", branch: "main"},
+		{repoID: "repo-1", branch: "release"},
+	}, gc.cloned)
+
+	_, err = tr.getGitRepo(ctx, gitRepoKey{repoID: "repo-2", branch: "main"})
+	assert.Error(t, err)
+}
+
+func TestSkipCommitMessagePatternOf(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.PipedSpec{
+		Repositories: []config.PipedRepository{
+			{RepoID: "repo-1", Branch: "main", SkipCommitMessagePattern: `\[skip-deploy\]`},
+			{RepoID: "repo-2", Branch: "main"},
+		},
+	}
+	tr, err := NewTrigger(&recordingAPIClient{}, &fakeGitClient{}, &fakeApplicationLister{}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	pattern := tr.skipCommitMessagePatternOf(gitRepoKey{repoID: "repo-1", branch: "main"})
+	require.NotNil(t, pattern)
+	assert.True(t, pattern.MatchString("chore: bump image tag [skip-deploy]"))
+	assert.False(t, pattern.MatchString("feat: add new feature"))
+
+	// The pattern is applied only to the configured branch.
+	assert.Nil(t, tr.skipCommitMessagePatternOf(gitRepoKey{repoID: "repo-1", branch: "release"}))
+	assert.Nil(t, tr.skipCommitMessagePatternOf(gitRepoKey{repoID: "repo-2", branch: "main"}))
+	assert.Nil(t, tr.skipCommitMessagePatternOf(gitRepoKey{repoID: "repo-3", branch: "main"}))
+
+	// The invalid pattern is rejected at building the trigger.
+	cfg.Repositories[0].SkipCommitMessagePattern = "[skip-deploy"
+	_, err = NewTrigger(&recordingAPIClient{}, &fakeGitClient{}, &fakeApplicationLister{}, nil, &fakeNotifier{}, nil, cfg, 0, zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestSparseCheckout(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(path, branch string) *model.Application {
+		return &model.Application{
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "repo-1", Branch: branch},
+				Path: path,
+			},
+		}
+	}
+	lister := &fakeApplicationLister{
+		apps: []*model.Application{
+			newApp("apps/b", ""),
+			newApp("apps/a", "main"),
+			newApp("apps/c", "release"),
+			newApp("apps/a", ""),
+		},
+	}
+	tr, err := NewTrigger(nil, &fakeGitClient{}, lister, nil, nil, nil, &config.PipedSpec{
+		Repositories: []config.PipedRepository{
+			{RepoID: "repo-1", Branch: "main", SparseCheckout: true},
+		},
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	var (
+		ctx = context.Background()
+		key = gitRepoKey{repoID: "repo-1", branch: "main"}
+	)
+	repo, err := tr.getGitRepo(ctx, key)
+	require.NoError(t, err)
+	sparseRepo := repo.(*fakeSparseRepo)
+	// Only the directories of the applications in the same branch are checked out.
+	assert.Equal(t, [][]string{{"apps/a", "apps/b"}}, sparseRepo.dirs)
+
+	// Nothing is updated while no application was added.
+	require.NoError(t, tr.updateSparseCheckout(ctx, key, repo))
+	assert.Len(t, sparseRepo.dirs, 1)
+
+	lister.apps = append(lister.apps, newApp("apps/d", ""))
+	require.NoError(t, tr.updateSparseCheckout(ctx, key, repo))
+	assert.Equal(t, [][]string{{"apps/a", "apps/b"}, {"apps/a", "apps/b", "apps/d"}}, sparseRepo.dirs)
+}
+
+func TestCloneGitRepos(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repos = make([]config.PipedRepository, 0, 20)
+		keys  = make([]gitRepoKey, 0, 20)
+	)
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("repo-%d", i)
+		repos = append(repos, config.PipedRepository{RepoID: id, Branch: "main"})
+		keys = append(keys, gitRepoKey{repoID: id, branch: "main"})
+	}
+
+	gc := &fakeGitClient{}
+	tr, err := NewTrigger(nil, gc, nil, nil, nil, nil, &config.PipedSpec{Repositories: repos}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, tr.cloneGitRepos(context.Background(), keys))
+	assert.ElementsMatch(t, keys, gc.cloned)
+	assert.Len(t, tr.gitRepos, 20)
+
+	gc = &fakeGitClient{failures: map[string]struct{}{"repo-3": {}, "repo-7": {}}}
+	tr, err = NewTrigger(nil, gc, nil, nil, nil, nil, &config.PipedSpec{Repositories: repos}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	err = tr.cloneGitRepos(context.Background(), keys)
+	require.Error(t, err)
+	assert.Len(t, multierr.Errors(err), 2)
+	assert.Len(t, tr.gitRepos, 18)
+}
+
+func TestSkipUnregisteredRepos(t *testing.T) {
+	t.Parallel()
+
+	newCandidate := func(appID, repoID string) candidate {
+		return candidate{
+			application: &model.Application{
+				Id: appID,
+				GitPath: &model.ApplicationGitPath{
+					Repo: &model.ApplicationGitRepository{Id: repoID},
+				},
+			},
+			kind: model.TriggerKind_ON_COMMAND,
+		}
+	}
+
+	tr, err := NewTrigger(nil, nil, nil, nil, nil, nil, &config.PipedSpec{
+		Repositories: []config.PipedRepository{{RepoID: "repo-1"}},
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+	tr.gitRepos[gitRepoKey{repoID: "repo-2", branch: "main"}] = nil
+
+	got := tr.skipUnregisteredRepos([]candidate{
+		newCandidate("app-1", "repo-1"),
+		newCandidate("app-2", "repo-2"),
+		newCandidate("app-3", "repo-1"),
+	})
+	require.Len(t, got, 2)
+	assert.Equal(t, "app-1", got[0].application.Id)
+	assert.Equal(t, "app-3", got[1].application.Id)
+
+	// The stale local data of the unregistered repository was removed.
+	assert.Empty(t, tr.gitRepos)
+	// The warning about the same repository is throttled.
+	assert.False(t, tr.unregisteredRepos.Allow("repo-2", time.Now()))
+
+	// The local data is removed only the first time the repository was found unregistered.
+	tr.gitRepos[gitRepoKey{repoID: "repo-2", branch: "main"}] = nil
+	tr.skipUnregisteredRepos([]candidate{newCandidate("app-2", "repo-2")})
+	assert.Len(t, tr.gitRepos, 1)
+}
+
+func TestResolveRepoAliases(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(appID, repoID string) *model.Application {
+		return &model.Application{
+			Id: appID,
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: repoID},
+			},
+		}
+	}
+
+	tr, err := NewTrigger(nil, nil, nil, nil, nil, nil, &config.PipedSpec{
+		Repositories: []config.PipedRepository{
+			{RepoID: "repo-1", Branch: "main", Aliases: []string{"old-repo-1"}},
+		},
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	// The applications referring to the repository by its alias or in another case
+	// are grouped into the branch of the registered repository.
+	for _, id := range []string{"repo-1", "old-repo-1", "Repo-1"} {
+		assert.Equal(t, gitRepoKey{repoID: "repo-1", branch: "main"}, tr.gitRepoKeyOf(newApp("app", id)), id)
+	}
+	assert.Equal(t, gitRepoKey{repoID: "repo-2"}, tr.gitRepoKeyOf(newApp("app", "repo-2")))
+
+	cs := []candidate{
+		{application: newApp("app-1", "old-repo-1")},
+		{application: newApp("app-2", "repo-2")},
+	}
+	got := tr.skipUnregisteredRepos(cs)
+	require.Len(t, got, 1)
+	assert.Equal(t, "app-1", got[0].application.Id)
+
+	got = tr.filterCandidatesByRepo(cs, map[string]struct{}{"repo-1": {}})
+	require.Len(t, got, 1)
+	assert.Equal(t, "app-1", got[0].application.Id)
+
+	_, err = tr.getGitRepo(context.Background(), gitRepoKey{repoID: "repo-2"})
+	assert.EqualError(t, err, "the repository repo-2 was not registered in Piped configuration, registered repositories: repo-1")
+}
+
+func TestLoadApplicationConfiguration(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+`
+	repoPath := t.TempDir()
+	for _, dir := range []string{"new", "legacy", "missing"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoPath, dir), 0700))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "new", "app.pipecd.yaml"), []byte(appCfg), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "legacy", ".pipe.yaml"), []byte(appCfg), 0600))
+
+	newApp := func(dir string) *model.Application {
+		return &model.Application{
+			Kind: model.ApplicationKind_KUBERNETES,
+			GitPath: &model.ApplicationGitPath{
+				Path:           dir,
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+	}
+	fallbacks := []string{"app.pipecd.yaml", ".pipe.yaml"}
+
+	spec, file, err := loadApplicationConfiguration(repoPath, newApp("new"), fallbacks)
+	require.NoError(t, err)
+	assert.Equal(t, "app", spec.Name)
+	assert.Equal(t, applicationConfigFile{filename: "app.pipecd.yaml", apiVersion: "pipecd.dev/v1beta1"}, file)
+
+	spec, file, err = loadApplicationConfiguration(repoPath, newApp("legacy"), fallbacks)
+	require.NoError(t, err)
+	assert.Equal(t, "app", spec.Name)
+	assert.Equal(t, applicationConfigFile{filename: ".pipe.yaml", apiVersion: "pipecd.dev/v1beta1"}, file)
+
+	// Only the registered one is tried without fallbacks.
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("legacy"), nil)
+	assert.EqualError(t, err, "application config file legacy/app.pipecd.yaml was not found in Git")
+
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("missing"), fallbacks)
+	assert.EqualError(t, err, "application config file was not found in Git, tried: missing/app.pipecd.yaml, missing/.pipe.yaml")
+
+	// The unsupported apiVersion is rejected with the descriptive error.
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "missing", "app.pipecd.yaml"), []byte(strings.Replace(appCfg, "v1beta1", "v1alpha1", 1)), 0600))
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("missing"), fallbacks)
+	assert.EqualError(t, err, `invalid application config file missing/app.pipecd.yaml: unsupported apiVersion "pipecd.dev/v1alpha1", supported versions are: pipecd.dev/v1beta1`)
+}
+
+func TestLoadApplicationConfigurationWithExplicitPath(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: %s
+`
+	repoPath := t.TempDir()
+	for _, dir := range []string{".pipecd", "app"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoPath, dir), 0700))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".pipecd", "app.pipecd.yaml"), []byte(fmt.Sprintf(appCfg, "central")), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".pipecd", "app.pipecd.dev.yaml"), []byte(fmt.Sprintf(appCfg, "central-dev")), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(fmt.Sprintf(appCfg, "colocated")), 0600))
+
+	newApp := func(cfgFilePath, envID string) *model.Application {
+		return &model.Application{
+			Kind:  model.ApplicationKind_KUBERNETES,
+			EnvId: envID,
+			GitPath: &model.ApplicationGitPath{
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+				ConfigFilePath: cfgFilePath,
+			},
+		}
+	}
+
+	// The path is derived from the application directory when not specified.
+	spec, _, err := loadApplicationConfiguration(repoPath, newApp("", ""), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "colocated", spec.Name)
+
+	// The explicitly specified path takes precedence over the derived one.
+	spec, file, err := loadApplicationConfiguration(repoPath, newApp(".pipecd/app.pipecd.yaml", ""), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "central", spec.Name)
+	assert.Equal(t, applicationConfigFile{filename: "app.pipecd.yaml", apiVersion: "pipecd.dev/v1beta1"}, file)
+
+	// The overlay is looked up next to the explicitly specified file.
+	spec, file, err = loadApplicationConfiguration(repoPath, newApp(".pipecd/app.pipecd.yaml", "dev"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "central-dev", spec.Name)
+	assert.Equal(t, "app.pipecd.dev.yaml", file.overlayFilename)
+
+	// The fallbacks are still tried in the application directory.
+	spec, _, err = loadApplicationConfiguration(repoPath, newApp(".pipecd/missing.pipecd.yaml", ""), []string{"app.pipecd.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, "colocated", spec.Name)
+
+	// The path going out of the repository is rejected.
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("../app.pipecd.yaml", ""), nil)
+	assert.EqualError(t, err, "config file path ../app.pipecd.yaml must be within the repository")
+}
+
+func TestLoadApplicationConfigurationWithOverlay(t *testing.T) {
+	t.Parallel()
+
+	const appCfg = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  labels:
+    team: foo
+    tier: backend
+`
+	repoPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.yaml"), []byte(appCfg), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.env-prod.yaml"), []byte(`spec:
+  labels:
+    team: bar
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.env-broken.yaml"), []byte("spec: [invalid"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "app", "app.pipecd.env-lambda.yaml"), []byte("kind: LambdaApp\n"), 0600))
+
+	newApp := func(envID string) *model.Application {
+		return &model.Application{
+			Kind:  model.ApplicationKind_KUBERNETES,
+			EnvId: envID,
+			GitPath: &model.ApplicationGitPath{
+				Path:           "app",
+				ConfigFilename: "app.pipecd.yaml",
+			},
+		}
+	}
+
+	// The overlay values win against the base ones.
+	spec, file, err := loadApplicationConfiguration(repoPath, newApp("env-prod"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "bar", "tier": "backend"}, spec.Labels)
+	assert.Equal(t, applicationConfigFile{filename: "app.pipecd.yaml", apiVersion: "pipecd.dev/v1beta1", overlayFilename: "app.pipecd.env-prod.yaml"}, file)
+
+	// Only the base file is loaded when the environment has no overlay.
+	spec, file, err = loadApplicationConfiguration(repoPath, newApp("env-dev"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "foo", "tier": "backend"}, spec.Labels)
+	assert.Equal(t, applicationConfigFile{filename: "app.pipecd.yaml", apiVersion: "pipecd.dev/v1beta1"}, file)
+
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("env-broken"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid application config file app/app.pipecd.yaml merged with overlay app.pipecd.env-broken.yaml")
+
+	// The kind of the merged configuration must match the application kind.
+	_, _, err = loadApplicationConfiguration(repoPath, newApp("env-lambda"), nil)
+	assert.Error(t, err)
+}
+
+func TestHandleRepoPullFailure(t *testing.T) {
+	t.Parallel()
+
+	n := &fakeNotifier{}
+	tr, err := NewTrigger(nil, nil, nil, nil, n, nil, &config.PipedSpec{
+		ProjectID:                            "project-1",
+		PipedID:                              "piped-1",
+		RepoPullFailureNotificationThreshold: 2,
+	}, 0, zap.NewNop())
+	require.NoError(t, err)
+
+	key := gitRepoKey{repoID: "repo-1", branch: "main"}
+	tr.handleRepoPullFailure(key, errors.New("connection refused"))
+	assert.Empty(t, n.events)
+
+	tr.handleRepoPullFailure(key, errors.New("connection refused"))
+	require.Len(t, n.events, 1)
+	assert.Equal(t, model.NotificationEventType_EVENT_GIT_REPO_PULL_FAILED, n.events[0].Type)
+	assert.Equal(t, &model.NotificationEventGitRepoPullFailed{
+		PipedId:   "piped-1",
+		ProjectId: "project-1",
+		RepoId:    "repo-1",
+		Branch:    "main",
+		Failures:  2,
+		Reason:    "connection refused",
+	}, n.events[0].Metadata)
+
+	// It is notified once until the repository was pulled successfully.
+	tr.handleRepoPullFailure(key, errors.New("connection refused"))
+	assert.Len(t, n.events, 1)
+
+	tr.repoPullFailures.Reset(key)
+	tr.handleRepoPullFailure(key, errors.New("connection refused"))
+	tr.handleRepoPullFailure(key, errors.New("connection refused"))
+	assert.Len(t, n.events, 2)
+}
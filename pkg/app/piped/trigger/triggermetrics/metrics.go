@@ -0,0 +1,262 @@
+// Copyright 2021 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggermetrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	repoIDKey    = "repo_id"
+	appIDKey     = "app_id"
+	kindKey      = "kind"
+	statusKey    = "status"
+	reasonKey    = "reason"
+	operationKey = "operation"
+	resultKey    = "result"
+
+	// aggregatedAppID is the app_id label value of the metrics aggregated across the applications
+	// found after maxLabeledAppIDs applications were already labeled.
+	aggregatedAppID = "all"
+	// The maximum number of applications labeled with their IDs to keep the cardinality of the metrics low.
+	maxLabeledAppIDs = 100
+)
+
+// CacheResult represents the result of a lookup in the cache.
+type CacheResult string
+
+const (
+	CacheResultHit  CacheResult = "hit"
+	CacheResultMiss CacheResult = "miss"
+)
+
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusFailure Status = "failure"
+)
+
+// FailureReason represents the category of the failure to create a deployment.
+type FailureReason string
+
+const (
+	// FailureReasonGit is the failure while fetching or reading the Git repository.
+	FailureReasonGit FailureReason = "git"
+	// FailureReasonAPI is the failure while communicating with the control-plane.
+	FailureReasonAPI FailureReason = "api"
+	// FailureReasonConfig is the failure caused by the invalid application configuration.
+	FailureReasonConfig FailureReason = "config"
+	// FailureReasonPanic is the unexpected panic recovered while handling the candidate.
+	FailureReasonPanic FailureReason = "panic"
+)
+
+var (
+	labeledAppIDsMu sync.Mutex
+	labeledAppIDs   = make(map[string]struct{}, maxLabeledAppIDs)
+)
+
+var (
+	candidates = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "trigger_candidates",
+			Help: "Number of candidates found in the last check of each repository.",
+		},
+		[]string{repoIDKey, kindKey},
+	)
+	candidatesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "trigger_candidates_total",
+			Help: "Total number of candidates found by piped.",
+		},
+		[]string{repoIDKey, kindKey},
+	)
+	checkCandidatesSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "trigger_check_candidates_seconds",
+			Help:    "Histogram of seconds taken to check all candidates found in a tick.",
+			Buckets: []float64{0.1, 1, 5, 10, 30, 60, 120, 300, 600},
+		},
+		[]string{statusKey},
+	)
+	commitToDeploymentSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "trigger_commit_to_deployment_seconds",
+			Help:    "Histogram of seconds elapsed from the creation of the triggering commit to the creation of its deployment.",
+			Buckets: []float64{10, 30, 60, 120, 300, 600, 1800, 3600, 21600, 86400},
+		},
+		[]string{kindKey},
+	)
+	deploymentBudgetLimit = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "trigger_deployment_budget_limit",
+			Help: "Maximum number of deployments can be triggered by piped within the budget window.",
+		},
+	)
+	deploymentBudgetConsumed = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "trigger_deployment_budget_consumed",
+			Help: "Number of deployments triggered by piped within the current budget window.",
+		},
+	)
+	deploymentCreations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "trigger_deployment_creations_total",
+			Help: "Total number of attempts to create a deployment for each application by the result and the failure reason.",
+		},
+		[]string{appIDKey, statusKey, reasonKey},
+	)
+	gitOperationTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "trigger_git_operation_timeouts_total",
+			Help: "Total number of Git operations on each repository given up by exceeding the timeout.",
+		},
+		[]string{repoIDKey, operationKey},
+	)
+	lastTriggeredCommitCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "trigger_last_triggered_commit_cache_requests_total",
+			Help: "Total number of lookups of the last triggered commits in the cache by the result.",
+		},
+		[]string{resultKey},
+	)
+	lastTriggeredCommitCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "trigger_last_triggered_commit_cache_evictions_total",
+			Help: "Total number of the last triggered commits evicted from the cache to add the new ones.",
+		},
+	)
+	lastTriggeredCommitCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "trigger_last_triggered_commit_cache_size",
+			Help: "Number of the last triggered commits currently kept in the cache.",
+		},
+	)
+	deferredCandidates = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "trigger_deferred_candidates",
+			Help: "Number of applications whose deployment was deferred because the budget was exhausted.",
+		},
+	)
+)
+
+// FoundCandidates reports the number of candidates of the given kind found in a repository.
+func FoundCandidates(repoID, kind string, n int) {
+	labels := prometheus.Labels{
+		repoIDKey: repoID,
+		kindKey:   kind,
+	}
+	candidates.With(labels).Set(float64(n))
+	candidatesTotal.With(labels).Add(float64(n))
+}
+
+func CheckedCandidates(s Status, d time.Duration) {
+	checkCandidatesSeconds.With(prometheus.Labels{
+		statusKey: string(s),
+	}).Observe(d.Seconds())
+}
+
+// CreatedDeployment reports the latency from when the triggering commit was created
+// to when the deployment of the given trigger kind was created.
+func CreatedDeployment(kind string, latency time.Duration) {
+	commitToDeploymentSeconds.With(prometheus.Labels{
+		kindKey: kind,
+	}).Observe(latency.Seconds())
+}
+
+// appIDLabel returns the app_id label value of the given application.
+// The applications found after maxLabeledAppIDs applications were labeled are aggregated into one.
+func appIDLabel(appID string) string {
+	labeledAppIDsMu.Lock()
+	defer labeledAppIDsMu.Unlock()
+
+	if _, ok := labeledAppIDs[appID]; ok {
+		return appID
+	}
+	if len(labeledAppIDs) >= maxLabeledAppIDs {
+		return aggregatedAppID
+	}
+	labeledAppIDs[appID] = struct{}{}
+	return appID
+}
+
+// DeploymentCreationSucceeded reports that a deployment was created for the given application.
+func DeploymentCreationSucceeded(appID string) {
+	deploymentCreations.With(prometheus.Labels{
+		appIDKey:  appIDLabel(appID),
+		statusKey: string(StatusSuccess),
+		reasonKey: "",
+	}).Inc()
+}
+
+// DeploymentCreationFailed reports that a deployment could not be created
+// for the given application by the given reason.
+func DeploymentCreationFailed(appID string, reason FailureReason) {
+	deploymentCreations.With(prometheus.Labels{
+		appIDKey:  appIDLabel(appID),
+		statusKey: string(StatusFailure),
+		reasonKey: string(reason),
+	}).Inc()
+}
+
+func SetDeploymentBudget(limit, consumed, deferred int) {
+	deploymentBudgetLimit.Set(float64(limit))
+	deploymentBudgetConsumed.Set(float64(consumed))
+	deferredCandidates.Set(float64(deferred))
+}
+
+// GitOperationTimedOut reports that the given Git operation on a repository exceeded the timeout.
+func GitOperationTimedOut(repoID, operation string) {
+	gitOperationTimeouts.With(prometheus.Labels{
+		repoIDKey:    repoID,
+		operationKey: operation,
+	}).Inc()
+}
+
+// LookedUpLastTriggeredCommit reports the result of a lookup of the last triggered commit in the cache.
+func LookedUpLastTriggeredCommit(r CacheResult) {
+	lastTriggeredCommitCacheRequests.With(prometheus.Labels{
+		resultKey: string(r),
+	}).Inc()
+}
+
+// EvictedLastTriggeredCommit reports that a last triggered commit was evicted from the cache.
+func EvictedLastTriggeredCommit() {
+	lastTriggeredCommitCacheEvictions.Inc()
+}
+
+func SetLastTriggeredCommitCacheSize(size int) {
+	lastTriggeredCommitCacheSize.Set(float64(size))
+}
+
+func Register(r prometheus.Registerer) {
+	r.MustRegister(
+		candidates,
+		candidatesTotal,
+		checkCandidatesSeconds,
+		commitToDeploymentSeconds,
+		deploymentBudgetLimit,
+		deploymentBudgetConsumed,
+		deferredCandidates,
+		deploymentCreations,
+		gitOperationTimeouts,
+		lastTriggeredCommitCacheRequests,
+		lastTriggeredCommitCacheEvictions,
+		lastTriggeredCommitCacheSize,
+	)
+}
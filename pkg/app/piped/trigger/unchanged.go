@@ -0,0 +1,63 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// skipUnchangedHead reports whether all of the given candidates can be skipped without loading
+// their application configurations since nothing has been pushed after their last triggered commit,
+// e.g. when the repository was checked again by a webhook or after its quiet period.
+// The candidates by the commands and the configuration drifts are never skipped
+// since they have to be handled even though the head commit is unchanged,
+// neither are the ones whose schedule may have been reached.
+func (t *Trigger) skipUnchangedHead(ctx context.Context, key gitRepoKey, headCommit string, cs []candidate) bool {
+	if len(cs) == 0 || t.tagPatternOf(key) != "" {
+		return false
+	}
+	now := time.Now()
+	for _, c := range cs {
+		for _, k := range c.Kinds() {
+			if k != model.TriggerKind_ON_COMMIT && k != model.TriggerKind_ON_SCHEDULE {
+				return false
+			}
+			if k == model.TriggerKind_ON_SCHEDULE && t.schedules.Due(c.application.Id, now) {
+				return false
+			}
+		}
+		commit, err := t.commitStore.Get(ctx, c.application.Id)
+		if err != nil || commit != headCommit {
+			return false
+		}
+	}
+
+	reason := fmt.Sprintf("no new commit since the last triggered commit %s", headCommit)
+	for _, c := range cs {
+		t.budget.Forget(c.application.Id)
+		t.auditDecision(c, key, headCommit, "", reason)
+	}
+	t.logger.Debug(fmt.Sprintf("skipped checking %d candidates in repo %s since the head commit has already been triggered", len(cs), key.repoID),
+		zap.String("branch", key.branch),
+		zap.String("commit", headCommit),
+	)
+	return true
+}
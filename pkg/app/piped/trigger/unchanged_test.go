@@ -0,0 +1,124 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestSkipUnchangedHead(t *testing.T) {
+	t.Parallel()
+
+	var (
+		app1 = &model.Application{Id: "app-1", Name: "app-1"}
+		app2 = &model.Application{Id: "app-2", Name: "app-2"}
+		app3 = &model.Application{Id: "app-3", Name: "app-3"}
+		app4 = &model.Application{Id: "app-4", Name: "app-4"}
+		key  = gitRepoKey{repoID: "repo-1", branch: "main"}
+		cfg  = &config.PipedSpec{
+			Repositories: []config.PipedRepository{
+				{RepoID: "repo-1", Branch: "main"},
+				{RepoID: "repo-2", Branch: "main", TagPattern: "v*"},
+			},
+		}
+	)
+	tr, err := NewTrigger(nil, nil, nil, nil, nil, nil, cfg, 0, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tr.commitStore.Put(app1.Id, "commit-2"))
+	require.NoError(t, tr.commitStore.Put(app2.Id, "commit-1"))
+	require.NoError(t, tr.commitStore.Put(app3.Id, "commit-2"))
+	require.NoError(t, tr.commitStore.Put(app4.Id, "commit-2"))
+	tr.schedules.Observe(app1.Id, config.OnSchedule{}, time.Now())
+	tr.schedules.Observe(app4.Id, config.OnSchedule{Cron: "* * * * *"}, time.Now().Add(-time.Hour))
+
+	testcases := []struct {
+		name     string
+		key      gitRepoKey
+		cs       []candidate
+		expected bool
+	}{
+		{
+			name: "no candidate",
+			key:  key,
+		},
+		{
+			name:     "head has been triggered",
+			key:      key,
+			cs:       []candidate{{application: app1, kind: model.TriggerKind_ON_COMMIT}},
+			expected: true,
+		},
+		{
+			name: "head has not been triggered for one of them",
+			key:  key,
+			cs: []candidate{
+				{application: app1, kind: model.TriggerKind_ON_COMMIT},
+				{application: app2, kind: model.TriggerKind_ON_COMMIT},
+			},
+		},
+		{
+			name: "command",
+			key:  key,
+			cs: []candidate{
+				{application: app1, kind: model.TriggerKind_ON_COMMIT},
+				{application: app1, kind: model.TriggerKind_ON_COMMAND},
+			},
+		},
+		{
+			name:     "no schedule is configured",
+			key:      key,
+			cs:       []candidate{{application: app1, kind: model.TriggerKind_ON_COMMIT, mergedKinds: []model.TriggerKind{model.TriggerKind_ON_SCHEDULE}}},
+			expected: true,
+		},
+		{
+			name: "schedule is unknown",
+			key:  key,
+			cs:   []candidate{{application: app3, kind: model.TriggerKind_ON_COMMIT, mergedKinds: []model.TriggerKind{model.TriggerKind_ON_SCHEDULE}}},
+		},
+		{
+			name: "schedule has been reached",
+			key:  key,
+			cs:   []candidate{{application: app4, kind: model.TriggerKind_ON_COMMIT, mergedKinds: []model.TriggerKind{model.TriggerKind_ON_SCHEDULE}}},
+		},
+		{
+			name: "configuration drift",
+			key:  key,
+			cs:   []candidate{{application: app1, kind: model.TriggerKind_ON_OUT_OF_SYNC}},
+		},
+		{
+			name: "repository using tags",
+			key:  gitRepoKey{repoID: "repo-2", branch: "main"},
+			cs:   []candidate{{application: app1, kind: model.TriggerKind_ON_COMMIT}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tr.skipUnchangedHead(context.Background(), tc.key, "commit-2", tc.cs))
+		})
+	}
+
+	// The skipped candidates are recorded as not triggered.
+	d, ok := tr.decisions.Get(app1.Id)
+	require.True(t, ok)
+	assert.Equal(t, "no new commit since the last triggered commit commit-2", d.Reason)
+}
@@ -0,0 +1,237 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+// maxWebhookPayloadSize is the maximum size of the push event payload read by the webhook.
+const maxWebhookPayloadSize = 10 << 20
+
+// repoEventQueue keeps the repositories notified to be changed
+// until they are picked up by the trigger loop.
+// The repositories notified again before being picked up are merged into one.
+type repoEventQueue struct {
+	mu      sync.Mutex
+	repoIDs map[string]struct{}
+	ch      chan struct{}
+}
+
+func newRepoEventQueue() *repoEventQueue {
+	return &repoEventQueue{
+		repoIDs: make(map[string]struct{}),
+		ch:      make(chan struct{}, 1),
+	}
+}
+
+// Push adds the given repositories to the queue without blocking.
+func (q *repoEventQueue) Push(repoIDs ...string) {
+	q.mu.Lock()
+	for _, id := range repoIDs {
+		q.repoIDs[id] = struct{}{}
+	}
+	q.mu.Unlock()
+
+	select {
+	case q.ch <- struct{}{}:
+	default:
+	}
+}
+
+// C returns the channel receiving a value when some repositories were pushed.
+func (q *repoEventQueue) C() <-chan struct{} {
+	return q.ch
+}
+
+// Pop removes and returns all the repositories in the queue.
+func (q *repoEventQueue) Pop() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	repoIDs := make([]string, 0, len(q.repoIDs))
+	for id := range q.repoIDs {
+		repoIDs = append(repoIDs, id)
+	}
+	sort.Strings(repoIDs)
+	q.repoIDs = make(map[string]struct{})
+	return repoIDs
+}
+
+// pushEvent contains the fields of the push event payloads
+// sent by GitHub and GitLab used to find the changed repository.
+type pushEvent struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		// GitHub.
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		// GitLab.
+		GitHTTPURL string `json:"git_http_url"`
+		GitSSHURL  string `json:"git_ssh_url"`
+	} `json:"repository"`
+}
+
+type webhookHandler struct {
+	trigger *Trigger
+	secret  string
+	logger  *zap.Logger
+}
+
+// NewWebhookHandler returns the handler receiving the push events of the Git repositories
+// to let them be checked immediately instead of waiting for the next sync.
+// The repository can be specified by the "repoId" query parameter
+// or it is found from the remote address in the payload of GitHub and GitLab.
+func (t *Trigger) NewWebhookHandler(secret string) http.Handler {
+	return &webhookHandler{
+		trigger: t,
+		secret:  secret,
+		logger:  t.logger.Named("webhook"),
+	}
+}
+
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read the payload", http.StatusBadRequest)
+		return
+	}
+	if !h.verify(r.Header, body) {
+		h.logger.Warn("received an unverified webhook event")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	repoIDs, err := h.findRepos(r.URL.Query().Get("repoId"), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(repoIDs) == 0 {
+		h.logger.Debug("received a webhook event for no registered repository")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	h.logger.Info(fmt.Sprintf("received a webhook event for repositories %s", strings.Join(repoIDs, ", ")))
+	h.trigger.repoEvents.Push(repoIDs...)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// verify checks whether the given event was sent by the one knowing the secret.
+// GitHub signs the payload by the secret while GitLab sends the secret as is.
+func (h *webhookHandler) verify(header http.Header, body []byte) bool {
+	if h.secret == "" {
+		return true
+	}
+	if sig := header.Get("X-Hub-Signature-256"); sig != "" {
+		mac := hmac.New(sha256.New, []byte(h.secret))
+		mac.Write(body)
+		expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		return hmac.Equal([]byte(sig), []byte(expected))
+	}
+	if token := header.Get("X-Gitlab-Token"); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(h.secret)) == 1
+	}
+	return false
+}
+
+// findRepos returns the registered repositories changed by the given event.
+func (h *webhookHandler) findRepos(repoID string, body []byte) ([]string, error) {
+	if repoID != "" {
+		if _, ok := h.trigger.config.GetRepository(repoID); !ok {
+			return nil, nil
+		}
+		return []string{repoID}, nil
+	}
+
+	var e pushEvent
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse the payload: %w", err)
+	}
+	remotes := make(map[string]struct{})
+	for _, u := range []string{e.Repository.CloneURL, e.Repository.SSHURL, e.Repository.GitHTTPURL, e.Repository.GitSSHURL} {
+		if r := normalizeGitRemote(u); r != "" {
+			remotes[r] = struct{}{}
+		}
+	}
+
+	// The pushes to the branches no application is using are ignored
+	// while the ones of tags are not since the repository may be deployed by tags.
+	const branchPrefix = "refs/heads/"
+	var (
+		isBranch = strings.HasPrefix(e.Ref, branchPrefix)
+		branch   = strings.TrimPrefix(e.Ref, branchPrefix)
+	)
+	repoIDs := make([]string, 0)
+	for _, r := range h.trigger.config.Repositories {
+		if _, ok := remotes[normalizeGitRemote(r.Remote)]; !ok {
+			continue
+		}
+		if isBranch && !h.trigger.isBranchUsed(r.RepoID, branch) {
+			continue
+		}
+		repoIDs = append(repoIDs, r.RepoID)
+	}
+	return repoIDs, nil
+}
+
+// isBranchUsed reports whether the given branch of the given repository is checked by the trigger.
+func (t *Trigger) isBranchUsed(repoID, branch string) bool {
+	if r, ok := t.config.GetRepository(repoID); ok && r.Branch == branch {
+		return true
+	}
+	for _, app := range t.applicationLister.List() {
+		if key := t.gitRepoKeyOf(app); key.repoID == repoID && key.branch == branch {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeGitRemote returns the host and path of the given Git remote address
+// to compare the addresses of the same repository using different transports,
+// e.g. both "https://github.com/org/repo.git" and "git@github.com:org/repo.git"
+// are normalized to "github.com/org/repo".
+func normalizeGitRemote(remote string) string {
+	if remote == "" {
+		return ""
+	}
+	u, err := git.ParseGitURL(remote)
+	if err != nil {
+		return ""
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	return strings.ToLower(u.Hostname()) + "/" + path
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestRepoEventQueue(t *testing.T) {
+	t.Parallel()
+
+	q := newRepoEventQueue()
+	q.Push("repo-2")
+	q.Push("repo-1", "repo-2")
+
+	select {
+	case <-q.C():
+	default:
+		t.Fatal("expected to be notified")
+	}
+	// The repositories pushed several times are notified once.
+	select {
+	case <-q.C():
+		t.Fatal("unexpected notification")
+	default:
+	}
+	assert.Equal(t, []string{"repo-1", "repo-2"}, q.Pop())
+	assert.Empty(t, q.Pop())
+}
+
+func TestNormalizeGitRemote(t *testing.T) {
+	t.Parallel()
+
+	for _, remote := range []string{
+		"https://github.com/org/repo.git",
+		"https://github.com/org/repo",
+		"git@github.com:org/repo.git",
+		"ssh://git@GitHub.com:22/org/repo.git",
+	} {
+		assert.Equal(t, "github.com/org/repo", normalizeGitRemote(remote), remote)
+	}
+	assert.Equal(t, "", normalizeGitRemote(""))
+}
+
+func TestWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secret        = "secret"
+		githubPayload = `{"ref": "refs/heads/main", "repository": {"clone_url": "https://github.com/org/repo-1.git", "ssh_url": "git@github.com:org/repo-1.git"}}`
+	)
+	sign := func(body string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	testcases := []struct {
+		name            string
+		method          string
+		query           string
+		header          map[string]string
+		body            string
+		expectedStatus  int
+		expectedRepoIDs []string
+	}{
+		{
+			name:           "not allowed method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "missing signature",
+			body:           githubPayload,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid signature",
+			header:         map[string]string{"X-Hub-Signature-256": sign("another")},
+			body:           githubPayload,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:            "github push event",
+			header:          map[string]string{"X-Hub-Signature-256": sign(githubPayload)},
+			body:            githubPayload,
+			expectedStatus:  http.StatusAccepted,
+			expectedRepoIDs: []string{"repo-1"},
+		},
+		{
+			name:            "gitlab push event to the branch used by an application",
+			header:          map[string]string{"X-Gitlab-Token": secret},
+			body:            `{"ref": "refs/heads/release", "repository": {"git_http_url": "https://gitlab.com/org/repo-2.git"}}`,
+			expectedStatus:  http.StatusAccepted,
+			expectedRepoIDs: []string{"repo-2"},
+		},
+		{
+			name:           "push event to the branch used by no application",
+			header:         map[string]string{"X-Gitlab-Token": secret},
+			body:           `{"ref": "refs/heads/feature", "repository": {"git_http_url": "https://gitlab.com/org/repo-2.git"}}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:            "push event of tag",
+			header:          map[string]string{"X-Gitlab-Token": secret},
+			body:            `{"ref": "refs/tags/v1.0.0", "repository": {"git_http_url": "https://gitlab.com/org/repo-2.git"}}`,
+			expectedStatus:  http.StatusAccepted,
+			expectedRepoIDs: []string{"repo-2"},
+		},
+		{
+			name:           "push event of unregistered repository",
+			header:         map[string]string{"X-Gitlab-Token": secret},
+			body:           `{"ref": "refs/heads/main", "repository": {"git_http_url": "https://gitlab.com/org/unknown.git"}}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "invalid payload",
+			header:         map[string]string{"X-Gitlab-Token": secret},
+			body:           `invalid`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:            "repository specified by query",
+			query:           "?repoId=repo-2",
+			header:          map[string]string{"X-Gitlab-Token": secret},
+			expectedStatus:  http.StatusAccepted,
+			expectedRepoIDs: []string{"repo-2"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &Trigger{
+				config: &config.PipedSpec{
+					Repositories: []config.PipedRepository{
+						{RepoID: "repo-1", Remote: "git@github.com:org/repo-1.git", Branch: "main"},
+						{RepoID: "repo-2", Remote: "git@gitlab.com:org/repo-2.git", Branch: "main"},
+					},
+				},
+				applicationLister: &fakeApplicationLister{apps: []*model.Application{
+					{
+						Id: "app-1",
+						GitPath: &model.ApplicationGitPath{
+							Repo: &model.ApplicationGitRepository{Id: "repo-2", Branch: "release"},
+						},
+					},
+				}},
+				repoEvents: newRepoEventQueue(),
+				logger:     zap.NewNop(),
+			}
+			h := tr.NewWebhookHandler(secret)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/trigger/webhook"+tc.query, strings.NewReader(tc.body))
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			repoIDs := tr.repoEvents.Pop()
+			if len(tc.expectedRepoIDs) == 0 {
+				assert.Empty(t, repoIDs)
+				return
+			}
+			assert.Equal(t, tc.expectedRepoIDs, repoIDs)
+		})
+	}
+}
@@ -53,6 +53,11 @@ type apiPipedStore interface {
 	DisablePiped(ctx context.Context, id string) error
 }
 
+type apiProjectStore interface {
+	PauseTriggering(ctx context.Context, id string) error
+	ResumeTriggering(ctx context.Context, id string) error
+}
+
 type apiEventStore interface {
 	Add(ctx context.Context, event model.Event) error
 }
@@ -68,6 +73,7 @@ type API struct {
 	applicationStore    apiApplicationStore
 	deploymentStore     apiDeploymentStore
 	pipedStore          apiPipedStore
+	projectStore        apiProjectStore
 	eventStore          apiEventStore
 	commandStore        commandstore.Store
 	commandOutputGetter commandOutputGetter
@@ -94,6 +100,7 @@ func NewAPI(
 		applicationStore:    datastore.NewApplicationStore(ds, w),
 		deploymentStore:     datastore.NewDeploymentStore(ds, w),
 		pipedStore:          datastore.NewPipedStore(ds, w),
+		projectStore:        datastore.NewProjectStore(ds, w),
 		eventStore:          datastore.NewEventStore(ds, w),
 		commandStore:        commandstore.NewStore(w, ds, sc, logger),
 		commandOutputGetter: cog,
@@ -130,6 +137,7 @@ func (a *API) AddApplication(ctx context.Context, req *apiservice.AddApplication
 		req.GitPath.Repo.Id,
 		req.GitPath.Path,
 		req.GitPath.ConfigFilename,
+		req.GitPath.ConfigFilePath,
 		piped,
 		a.logger,
 	)
@@ -171,6 +179,14 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if !req.SyncStrategy.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid sync strategy %d", req.SyncStrategy))
+	}
+
+	if req.ExternalSource != "" && req.TargetCommitHash != "" {
+		return nil, status.Error(codes.InvalidArgument, "Target commit hash cannot be specified with external source")
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
@@ -179,8 +195,11 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     key.Id,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  model.SyncStrategy_AUTO,
+			ApplicationId:    app.Id,
+			SyncStrategy:     req.SyncStrategy,
+			TargetCommitHash: req.TargetCommitHash,
+			ExternalSource:   req.ExternalSource,
+			Description:      req.Description,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -312,6 +331,43 @@ func (a *API) RenameApplicationConfigFile(ctx context.Context, req *apiservice.R
 	return &apiservice.RenameApplicationConfigFileResponse{}, nil
 }
 
+// RefreshLastTriggeredCommit adds a command to let the piped of the given application
+// re-fetch the commit of its most recently triggered deployment instead of using the cached one.
+func (a *API) RefreshLastTriggeredCommit(ctx context.Context, req *apiservice.RefreshLastTriggeredCommitRequest) (*apiservice.RefreshLastTriggeredCommitResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := getApplication(ctx, a.applicationStore, req.ApplicationId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != app.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       app.PipedId,
+		ApplicationId: app.Id,
+		ProjectId:     app.ProjectId,
+		Type:          model.Command_REFRESH_LAST_TRIGGERED_COMMIT,
+		Commander:     key.Id,
+		RefreshLastTriggeredCommit: &model.Command_RefreshLastTriggeredCommit{
+			ApplicationId: app.Id,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.RefreshLastTriggeredCommitResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
 func (a *API) GetDeployment(ctx context.Context, req *apiservice.GetDeploymentRequest) (*apiservice.GetDeploymentResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -383,6 +439,32 @@ func (a *API) updatePiped(ctx context.Context, pipedID string, updater func(cont
 	return nil
 }
 
+// PauseTriggering pauses triggering new deployments in all pipeds of the project.
+func (a *API) PauseTriggering(ctx context.Context, _ *apiservice.PauseTriggeringRequest) (*apiservice.PauseTriggeringResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.PauseTriggering(ctx, key.ProjectId); err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("pause triggering in project %s", key.ProjectId))
+	}
+	return &apiservice.PauseTriggeringResponse{}, nil
+}
+
+// ResumeTriggering resumes triggering new deployments paused by PauseTriggering.
+func (a *API) ResumeTriggering(ctx context.Context, _ *apiservice.ResumeTriggeringRequest) (*apiservice.ResumeTriggeringResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.projectStore.ResumeTriggering(ctx, key.ProjectId); err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("resume triggering in project %s", key.ProjectId))
+	}
+	return &apiservice.ResumeTriggeringResponse{}, nil
+}
+
 func (a *API) RegisterEvent(ctx context.Context, req *apiservice.RegisterEventRequest) (*apiservice.RegisterEventResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
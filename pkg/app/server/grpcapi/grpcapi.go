@@ -105,7 +105,7 @@ func addCommand(ctx context.Context, store commandstore.Store, cmd *model.Comman
 }
 
 // makeGitPath returns an ApplicationGitPath by adding Repository info and GitPath URL to given args.
-func makeGitPath(repoID, path, cfgFilename string, piped *model.Piped, logger *zap.Logger) (*model.ApplicationGitPath, error) {
+func makeGitPath(repoID, path, cfgFilename, cfgFilePath string, piped *model.Piped, logger *zap.Logger) (*model.ApplicationGitPath, error) {
 	var repo *model.ApplicationGitRepository
 	for _, r := range piped.Repositories {
 		if r.Id == repoID {
@@ -123,12 +123,17 @@ func makeGitPath(repoID, path, cfgFilename string, piped *model.Piped, logger *z
 		return nil, status.Error(codes.Internal, "Failed to make GitPath URL")
 	}
 
-	return &model.ApplicationGitPath{
+	gitPath := &model.ApplicationGitPath{
 		Repo:           repo,
 		Path:           path,
 		ConfigFilename: cfgFilename,
+		ConfigFilePath: cfgFilePath,
 		Url:            u,
-	}, nil
+	}
+	if err := gitPath.ValidateConfigFilePath(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid config file path: %v", err))
+	}
+	return gitPath, nil
 }
 
 func encrypt(plaintext string, key []byte, base64Encoding bool, logger *zap.Logger) (string, error) {
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -37,6 +38,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
@@ -48,6 +50,9 @@ type pipedApiApplicationStore interface {
 	UpdateDeployingStatus(ctx context.Context, id string, deploying bool) error
 	UpdateBasicInfo(ctx context.Context, id, name, desc string, labels map[string]string) error
 	UpdateMostRecentDeployment(ctx context.Context, id string, status model.DeploymentStatus, d *model.ApplicationDeploymentReference) error
+	UpdateLastHandledCommit(ctx context.Context, id, commit string, handledAt int64) error
+	Add(ctx context.Context, app *model.Application) error
+	Delete(ctx context.Context, id string) error
 }
 
 type pipedApiDeploymentStore interface {
@@ -72,6 +77,10 @@ type pipedApiPipedStore interface {
 	UpdateMetadata(ctx context.Context, id, version string, cps []*model.Piped_CloudProvider, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error
 }
 
+type pipedApiProjectStore interface {
+	Get(ctx context.Context, id string) (*model.Project, error)
+}
+
 type pipedApiEventStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Event, string, error)
 	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
@@ -89,6 +98,7 @@ type PipedAPI struct {
 	deploymentStore           pipedApiDeploymentStore
 	deploymentChainStore      pipedApiDeploymentChainStore
 	pipedStore                pipedApiPipedStore
+	projectStore              pipedApiProjectStore
 	eventStore                pipedApiEventStore
 	stageLogStore             stagelogstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
@@ -113,6 +123,7 @@ func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sl
 		deploymentStore:           datastore.NewDeploymentStore(ds, w),
 		deploymentChainStore:      datastore.NewDeploymentChainStore(ds, w),
 		pipedStore:                datastore.NewPipedStore(ds, w),
+		projectStore:              datastore.NewProjectStore(ds, w),
 		eventStore:                datastore.NewEventStore(ds, w),
 		stageLogStore:             sls,
 		applicationLiveStateStore: alss,
@@ -284,6 +295,24 @@ func (a *PipedAPI) ReportApplicationMostRecentDeployment(ctx context.Context, re
 	return &pipedservice.ReportApplicationMostRecentDeploymentResponse{}, nil
 }
 
+// ReportApplicationLastHandledCommit is used to update the commit handled most recently
+// by piped for a specific application, regardless of whether a deployment was triggered.
+func (a *PipedAPI) ReportApplicationLastHandledCommit(ctx context.Context, req *pipedservice.ReportApplicationLastHandledCommitRequest) (*pipedservice.ReportApplicationLastHandledCommitResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateAppBelongsToPiped(ctx, req.ApplicationId, pipedID); err != nil {
+		return nil, err
+	}
+
+	err = a.applicationStore.UpdateLastHandledCommit(ctx, req.ApplicationId, req.Commit, req.HandledAt)
+	if err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("update last handled commit of application %s", req.ApplicationId))
+	}
+	return &pipedservice.ReportApplicationLastHandledCommitResponse{}, nil
+}
+
 // GetApplicationMostRecentDeployment returns the most recent deployment of the given application.
 func (a *PipedAPI) GetApplicationMostRecentDeployment(ctx context.Context, req *pipedservice.GetApplicationMostRecentDeploymentRequest) (*pipedservice.GetApplicationMostRecentDeploymentResponse, error) {
 	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
@@ -310,6 +339,60 @@ func (a *PipedAPI) GetApplicationMostRecentDeployment(ctx context.Context, req *
 	return nil, status.Error(codes.NotFound, "deployment is not found")
 }
 
+// ListApplicationMostRecentDeployments returns the most recent deployments of the given applications at once.
+// The applications having no deployment or not belonging to the piped are not contained in the response.
+func (a *PipedAPI) ListApplicationMostRecentDeployments(ctx context.Context, req *pipedservice.ListApplicationMostRecentDeploymentsRequest) (*pipedservice.ListApplicationMostRecentDeploymentsResponse, error) {
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The applications of the piped are fetched by one query
+	// instead of fetching the requested ones one by one.
+	opts := datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+			{
+				Field:    "PipedId",
+				Operator: datastore.OperatorEqual,
+				Value:    pipedID,
+			},
+		},
+	}
+	apps, _, err := a.applicationStore.List(ctx, opts)
+	if err != nil {
+		return nil, gRPCEntityOperationError(err, "fetch applications")
+	}
+
+	requested := make(map[string]struct{}, len(req.ApplicationIds))
+	for _, id := range req.ApplicationIds {
+		requested[id] = struct{}{}
+	}
+	deployments := make(map[string]*model.ApplicationDeploymentReference, len(requested))
+	for _, app := range apps {
+		if _, ok := requested[app.Id]; !ok {
+			continue
+		}
+		var d *model.ApplicationDeploymentReference
+		switch req.Status {
+		case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
+			d = app.MostRecentlySuccessfulDeployment
+		case model.DeploymentStatus_DEPLOYMENT_PENDING:
+			d = app.MostRecentlyTriggeredDeployment
+		}
+		if d != nil {
+			deployments[app.Id] = d
+		}
+	}
+
+	return &pipedservice.ListApplicationMostRecentDeploymentsResponse{
+		Deployments: deployments,
+	}, nil
+}
+
 func (a *PipedAPI) GetDeployment(ctx context.Context, req *pipedservice.GetDeploymentRequest) (*pipedservice.GetDeploymentResponse, error) {
 	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
@@ -1135,6 +1218,104 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 	}, nil
 }
 
+// RegisterBranchApplication registers a new application deploying the given Git branch
+// by copying the repository, directory, kind and cloud provider of the given template application.
+// The new application is labeled with its template and branch to be found by the piped handling them.
+func (a *PipedAPI) RegisterBranchApplication(ctx context.Context, req *pipedservice.RegisterBranchApplicationRequest) (*pipedservice.RegisterBranchApplicationResponse, error) {
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := a.applicationStore.Get(ctx, req.TemplateApplicationId)
+	if err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("get application %s", req.TemplateApplicationId))
+	}
+	if template.ProjectId != projectID || template.PipedId != pipedID {
+		return nil, status.Error(codes.PermissionDenied, "requested application doesn't belong to the piped")
+	}
+	if template.IsBranchApplication() {
+		return nil, status.Error(codes.InvalidArgument, "an application registered for a branch cannot be used as the template")
+	}
+
+	app, err := newBranchApplication(template, req.Branch)
+	if err != nil {
+		a.logger.Error("failed to make the application for branch", zap.String("branch", req.Branch), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to make the application for the branch")
+	}
+	if err := a.applicationStore.Add(ctx, app); err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("add application %s", app.Id))
+	}
+
+	return &pipedservice.RegisterBranchApplicationResponse{
+		ApplicationId: app.Id,
+	}, nil
+}
+
+// UnregisterBranchApplication deletes the given application registered by RegisterBranchApplication.
+// The other applications cannot be deleted by piped.
+func (a *PipedAPI) UnregisterBranchApplication(ctx context.Context, req *pipedservice.UnregisterBranchApplicationRequest) (*pipedservice.UnregisterBranchApplicationResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := a.applicationStore.Get(ctx, req.ApplicationId)
+	if err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("get application %s", req.ApplicationId))
+	}
+	if app.PipedId != pipedID {
+		return nil, status.Error(codes.PermissionDenied, "requested application doesn't belong to the piped")
+	}
+	if !app.IsBranchApplication() {
+		return nil, status.Error(codes.InvalidArgument, "requested application was not registered for a branch")
+	}
+
+	if err := a.applicationStore.Delete(ctx, app.Id); err != nil {
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("delete application %s", app.Id))
+	}
+	return &pipedservice.UnregisterBranchApplicationResponse{}, nil
+}
+
+// newBranchApplication returns a new application deploying the given branch
+// of the repository of the given template application.
+func newBranchApplication(template *model.Application, branch string) (*model.Application, error) {
+	repo := &model.ApplicationGitRepository{
+		Id:     template.GitPath.Repo.Id,
+		Remote: template.GitPath.Repo.Remote,
+		Branch: branch,
+	}
+	u, err := git.MakeDirURL(repo.Remote, template.GitPath.Path, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string, len(template.Labels)+2)
+	for k, v := range template.Labels {
+		labels[k] = v
+	}
+	labels[model.BranchApplicationTemplateLabelKey] = template.Id
+	labels[model.BranchApplicationBranchLabelKey] = branch
+
+	return &model.Application{
+		Id:        uuid.New().String(),
+		Name:      fmt.Sprintf("%s-%s", template.Name, strings.ReplaceAll(branch, "/", "-")),
+		PipedId:   template.PipedId,
+		ProjectId: template.ProjectId,
+		Kind:      template.Kind,
+		GitPath: &model.ApplicationGitPath{
+			Repo:           repo,
+			Path:           template.GitPath.Path,
+			ConfigFilename: template.GitPath.ConfigFilename,
+			ConfigFilePath: template.GitPath.ConfigFilePath,
+			Url:            u,
+		},
+		CloudProvider: template.CloudProvider,
+		Description:   template.Description,
+		Labels:        labels,
+	}, nil
+}
+
 // validateAppBelongsToPiped checks if the given application belongs to the given piped.
 // It gives back an error unless the application belongs to the piped.
 func (a *PipedAPI) validateAppBelongsToPiped(ctx context.Context, appID, pipedID string) error {
@@ -1183,3 +1364,26 @@ func (a *PipedAPI) validateDeploymentBelongsToPiped(ctx context.Context, deploym
 
 	return nil
 }
+
+// GetTriggeringPaused returns whether triggering new deployments is paused in all pipeds of the project.
+// The projects not stored in the datastore, e.g. the ones defined in the control-plane configuration,
+// are never paused.
+func (a *PipedAPI) GetTriggeringPaused(ctx context.Context, _ *pipedservice.GetTriggeringPausedRequest) (*pipedservice.GetTriggeringPausedResponse, error) {
+	projectID, _, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := a.projectStore.Get(ctx, projectID)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return &pipedservice.GetTriggeringPausedResponse{}, nil
+	}
+	if err != nil {
+		a.logger.Error("failed to get project", zap.String("project-id", projectID), zap.Error(err))
+		return nil, gRPCEntityOperationError(err, fmt.Sprintf("get project %s", projectID))
+	}
+
+	return &pipedservice.GetTriggeringPausedResponse{
+		Paused: project.TriggerPaused,
+	}, nil
+}
@@ -212,3 +212,46 @@ func TestValidateDeploymentBelongsToPiped(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBranchApplication(t *testing.T) {
+	template := &model.Application{
+		Id:        "template",
+		Name:      "app",
+		PipedId:   "piped",
+		ProjectId: "project",
+		Kind:      model.ApplicationKind_KUBERNETES,
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id:     "repo",
+				Remote: "git@github.com:org/repo.git",
+				Branch: "main",
+			},
+			Path:           "apps/app",
+			ConfigFilename: "app.pipecd.yaml",
+			ConfigFilePath: ".pipecd/app.yaml",
+			Url:            "https://github.com/org/repo/tree/main/apps/app",
+		},
+		CloudProvider: "kubernetes",
+		Labels:        map[string]string{"team": "a"},
+	}
+
+	app, err := newBranchApplication(template, "feature/new")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, app.Id)
+	assert.Equal(t, "app-feature-new", app.Name)
+	assert.Equal(t, "feature/new", app.GitPath.Repo.Branch)
+	assert.Equal(t, "https://github.com/org/repo/tree/feature/new/apps/app", app.GitPath.Url)
+	// The explicitly specified config file is loaded from the branch as well.
+	assert.Equal(t, ".pipecd/app.yaml", app.GitPath.ConfigFilePath)
+	assert.Equal(t, ".pipecd/app.yaml", app.GitPath.GetApplicationConfigFilePath())
+	assert.Equal(t, map[string]string{
+		"team":                                  "a",
+		model.BranchApplicationTemplateLabelKey: "template",
+		model.BranchApplicationBranchLabelKey:   "feature/new",
+	}, app.Labels)
+	assert.True(t, app.IsBranchApplication())
+
+	// The template application is left as is.
+	assert.Equal(t, "main", template.GitPath.Repo.Branch)
+	assert.False(t, template.IsBranchApplication())
+}
@@ -454,6 +454,7 @@ func (a *WebAPI) AddApplication(ctx context.Context, req *webservice.AddApplicat
 		req.GitPath.Repo.Id,
 		req.GitPath.Path,
 		req.GitPath.ConfigFilename,
+		req.GitPath.ConfigFilePath,
 		piped,
 		a.logger,
 	)
@@ -663,6 +664,10 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 		return nil, status.Error(codes.PermissionDenied, "Requested application does not belong to your project")
 	}
 
+	if !req.SyncStrategy.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid sync strategy %d", req.SyncStrategy))
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
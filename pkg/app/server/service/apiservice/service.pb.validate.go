@@ -375,6 +375,14 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TargetCommitHash
+
+	// no validation rules for SyncStrategy
+
+	// no validation rules for ExternalSource
+
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -1287,6 +1295,229 @@ var _ interface {
 	ErrorName() string
 } = RenameApplicationConfigFileResponseValidationError{}
 
+// Validate checks the field values on RefreshLastTriggeredCommitRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RefreshLastTriggeredCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshLastTriggeredCommitRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RefreshLastTriggeredCommitRequestMultiError, or nil if none found.
+func (m *RefreshLastTriggeredCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshLastTriggeredCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := RefreshLastTriggeredCommitRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshLastTriggeredCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshLastTriggeredCommitRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RefreshLastTriggeredCommitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshLastTriggeredCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshLastTriggeredCommitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshLastTriggeredCommitRequestMultiError) AllErrors() []error { return m }
+
+// RefreshLastTriggeredCommitRequestValidationError is the validation error
+// returned by RefreshLastTriggeredCommitRequest.Validate if the designated
+// constraints aren't met.
+type RefreshLastTriggeredCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshLastTriggeredCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshLastTriggeredCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshLastTriggeredCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshLastTriggeredCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshLastTriggeredCommitRequestValidationError) ErrorName() string {
+	return "RefreshLastTriggeredCommitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshLastTriggeredCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshLastTriggeredCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshLastTriggeredCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshLastTriggeredCommitRequestValidationError{}
+
+// Validate checks the field values on RefreshLastTriggeredCommitResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RefreshLastTriggeredCommitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshLastTriggeredCommitResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RefreshLastTriggeredCommitResponseMultiError, or nil if none found.
+func (m *RefreshLastTriggeredCommitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshLastTriggeredCommitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return RefreshLastTriggeredCommitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshLastTriggeredCommitResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RefreshLastTriggeredCommitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshLastTriggeredCommitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshLastTriggeredCommitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshLastTriggeredCommitResponseMultiError) AllErrors() []error { return m }
+
+// RefreshLastTriggeredCommitResponseValidationError is the validation error
+// returned by RefreshLastTriggeredCommitResponse.Validate if the designated
+// constraints aren't met.
+type RefreshLastTriggeredCommitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshLastTriggeredCommitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshLastTriggeredCommitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshLastTriggeredCommitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshLastTriggeredCommitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshLastTriggeredCommitResponseValidationError) ErrorName() string {
+	return "RefreshLastTriggeredCommitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshLastTriggeredCommitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshLastTriggeredCommitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshLastTriggeredCommitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshLastTriggeredCommitResponseValidationError{}
+
 // Validate checks the field values on GetDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2196,6 +2427,414 @@ var _ interface {
 	ErrorName() string
 } = DisablePipedResponseValidationError{}
 
+// Validate checks the field values on PauseTriggeringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseTriggeringRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseTriggeringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseTriggeringRequestMultiError, or nil if none found.
+func (m *PauseTriggeringRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseTriggeringRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PauseTriggeringRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseTriggeringRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseTriggeringRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseTriggeringRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseTriggeringRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseTriggeringRequestMultiError) AllErrors() []error { return m }
+
+// PauseTriggeringRequestValidationError is the validation error returned by
+// PauseTriggeringRequest.Validate if the designated constraints aren't met.
+type PauseTriggeringRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseTriggeringRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseTriggeringRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseTriggeringRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseTriggeringRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseTriggeringRequestValidationError) ErrorName() string {
+	return "PauseTriggeringRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseTriggeringRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseTriggeringRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseTriggeringRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseTriggeringRequestValidationError{}
+
+// Validate checks the field values on PauseTriggeringResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseTriggeringResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseTriggeringResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseTriggeringResponseMultiError, or nil if none found.
+func (m *PauseTriggeringResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseTriggeringResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PauseTriggeringResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseTriggeringResponseMultiError is an error wrapping multiple validation
+// errors returned by PauseTriggeringResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PauseTriggeringResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseTriggeringResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseTriggeringResponseMultiError) AllErrors() []error { return m }
+
+// PauseTriggeringResponseValidationError is the validation error returned by
+// PauseTriggeringResponse.Validate if the designated constraints aren't met.
+type PauseTriggeringResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseTriggeringResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseTriggeringResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseTriggeringResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseTriggeringResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseTriggeringResponseValidationError) ErrorName() string {
+	return "PauseTriggeringResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseTriggeringResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseTriggeringResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseTriggeringResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseTriggeringResponseValidationError{}
+
+// Validate checks the field values on ResumeTriggeringRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTriggeringRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTriggeringRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTriggeringRequestMultiError, or nil if none found.
+func (m *ResumeTriggeringRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTriggeringRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResumeTriggeringRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTriggeringRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeTriggeringRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeTriggeringRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTriggeringRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTriggeringRequestMultiError) AllErrors() []error { return m }
+
+// ResumeTriggeringRequestValidationError is the validation error returned by
+// ResumeTriggeringRequest.Validate if the designated constraints aren't met.
+type ResumeTriggeringRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTriggeringRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTriggeringRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTriggeringRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTriggeringRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTriggeringRequestValidationError) ErrorName() string {
+	return "ResumeTriggeringRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTriggeringRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTriggeringRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTriggeringRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTriggeringRequestValidationError{}
+
+// Validate checks the field values on ResumeTriggeringResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTriggeringResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTriggeringResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTriggeringResponseMultiError, or nil if none found.
+func (m *ResumeTriggeringResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTriggeringResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResumeTriggeringResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTriggeringResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeTriggeringResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeTriggeringResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTriggeringResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTriggeringResponseMultiError) AllErrors() []error { return m }
+
+// ResumeTriggeringResponseValidationError is the validation error returned by
+// ResumeTriggeringResponse.Validate if the designated constraints aren't met.
+type ResumeTriggeringResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTriggeringResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTriggeringResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTriggeringResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTriggeringResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTriggeringResponseValidationError) ErrorName() string {
+	return "ResumeTriggeringResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTriggeringResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTriggeringResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTriggeringResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTriggeringResponseValidationError{}
+
 // Validate checks the field values on RegisterEventRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -27,10 +27,13 @@ type APIServiceClient interface {
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
 	RenameApplicationConfigFile(ctx context.Context, in *RenameApplicationConfigFileRequest, opts ...grpc.CallOption) (*RenameApplicationConfigFileResponse, error)
+	RefreshLastTriggeredCommit(ctx context.Context, in *RefreshLastTriggeredCommitRequest, opts ...grpc.CallOption) (*RefreshLastTriggeredCommitResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	EnablePiped(ctx context.Context, in *EnablePipedRequest, opts ...grpc.CallOption) (*EnablePipedResponse, error)
 	DisablePiped(ctx context.Context, in *DisablePipedRequest, opts ...grpc.CallOption) (*DisablePipedResponse, error)
+	PauseTriggering(ctx context.Context, in *PauseTriggeringRequest, opts ...grpc.CallOption) (*PauseTriggeringResponse, error)
+	ResumeTriggering(ctx context.Context, in *ResumeTriggeringRequest, opts ...grpc.CallOption) (*ResumeTriggeringResponse, error)
 	RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error)
 	RequestPlanPreview(ctx context.Context, in *RequestPlanPreviewRequest, opts ...grpc.CallOption) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(ctx context.Context, in *GetPlanPreviewResultsRequest, opts ...grpc.CallOption) (*GetPlanPreviewResultsResponse, error)
@@ -90,6 +93,15 @@ func (c *aPIServiceClient) RenameApplicationConfigFile(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *aPIServiceClient) RefreshLastTriggeredCommit(ctx context.Context, in *RefreshLastTriggeredCommitRequest, opts ...grpc.CallOption) (*RefreshLastTriggeredCommitResponse, error) {
+	out := new(RefreshLastTriggeredCommitResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RefreshLastTriggeredCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error) {
 	out := new(GetDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetDeployment", in, out, opts...)
@@ -126,6 +138,24 @@ func (c *aPIServiceClient) DisablePiped(ctx context.Context, in *DisablePipedReq
 	return out, nil
 }
 
+func (c *aPIServiceClient) PauseTriggering(ctx context.Context, in *PauseTriggeringRequest, opts ...grpc.CallOption) (*PauseTriggeringResponse, error) {
+	out := new(PauseTriggeringResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/PauseTriggering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ResumeTriggering(ctx context.Context, in *ResumeTriggeringRequest, opts ...grpc.CallOption) (*ResumeTriggeringResponse, error) {
+	out := new(ResumeTriggeringResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ResumeTriggering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error) {
 	out := new(RegisterEventResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RegisterEvent", in, out, opts...)
@@ -171,10 +201,13 @@ type APIServiceServer interface {
 	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
 	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
 	RenameApplicationConfigFile(context.Context, *RenameApplicationConfigFileRequest) (*RenameApplicationConfigFileResponse, error)
+	RefreshLastTriggeredCommit(context.Context, *RefreshLastTriggeredCommitRequest) (*RefreshLastTriggeredCommitResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	EnablePiped(context.Context, *EnablePipedRequest) (*EnablePipedResponse, error)
 	DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error)
+	PauseTriggering(context.Context, *PauseTriggeringRequest) (*PauseTriggeringResponse, error)
+	ResumeTriggering(context.Context, *ResumeTriggeringRequest) (*ResumeTriggeringResponse, error)
 	RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error)
 	RequestPlanPreview(context.Context, *RequestPlanPreviewRequest) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(context.Context, *GetPlanPreviewResultsRequest) (*GetPlanPreviewResultsResponse, error)
@@ -201,6 +234,9 @@ func (UnimplementedAPIServiceServer) ListApplications(context.Context, *ListAppl
 func (UnimplementedAPIServiceServer) RenameApplicationConfigFile(context.Context, *RenameApplicationConfigFileRequest) (*RenameApplicationConfigFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameApplicationConfigFile not implemented")
 }
+func (UnimplementedAPIServiceServer) RefreshLastTriggeredCommit(context.Context, *RefreshLastTriggeredCommitRequest) (*RefreshLastTriggeredCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshLastTriggeredCommit not implemented")
+}
 func (UnimplementedAPIServiceServer) GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
 }
@@ -213,6 +249,12 @@ func (UnimplementedAPIServiceServer) EnablePiped(context.Context, *EnablePipedRe
 func (UnimplementedAPIServiceServer) DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisablePiped not implemented")
 }
+func (UnimplementedAPIServiceServer) PauseTriggering(context.Context, *PauseTriggeringRequest) (*PauseTriggeringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTriggering not implemented")
+}
+func (UnimplementedAPIServiceServer) ResumeTriggering(context.Context, *ResumeTriggeringRequest) (*ResumeTriggeringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTriggering not implemented")
+}
 func (UnimplementedAPIServiceServer) RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEvent not implemented")
 }
@@ -328,6 +370,24 @@ func _APIService_RenameApplicationConfigFile_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_RefreshLastTriggeredCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshLastTriggeredCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RefreshLastTriggeredCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/RefreshLastTriggeredCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RefreshLastTriggeredCommit(ctx, req.(*RefreshLastTriggeredCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -400,6 +460,42 @@ func _APIService_DisablePiped_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_PauseTriggering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTriggeringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).PauseTriggering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/PauseTriggering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).PauseTriggering(ctx, req.(*PauseTriggeringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ResumeTriggering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTriggeringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ResumeTriggering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ResumeTriggering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ResumeTriggering(ctx, req.(*ResumeTriggeringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_RegisterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterEventRequest)
 	if err := dec(in); err != nil {
@@ -499,6 +595,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameApplicationConfigFile",
 			Handler:    _APIService_RenameApplicationConfigFile_Handler,
 		},
+		{
+			MethodName: "RefreshLastTriggeredCommit",
+			Handler:    _APIService_RefreshLastTriggeredCommit_Handler,
+		},
 		{
 			MethodName: "GetDeployment",
 			Handler:    _APIService_GetDeployment_Handler,
@@ -515,6 +615,14 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisablePiped",
 			Handler:    _APIService_DisablePiped_Handler,
 		},
+		{
+			MethodName: "PauseTriggering",
+			Handler:    _APIService_PauseTriggering_Handler,
+		},
+		{
+			MethodName: "ResumeTriggering",
+			Handler:    _APIService_ResumeTriggering_Handler,
+		},
 		{
 			MethodName: "RegisterEvent",
 			Handler:    _APIService_RegisterEvent_Handler,
@@ -1839,6 +1839,288 @@ var _ interface {
 	ErrorName() string
 } = GetApplicationMostRecentDeploymentResponseValidationError{}
 
+// Validate checks the field values on
+// ListApplicationMostRecentDeploymentsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationMostRecentDeploymentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListApplicationMostRecentDeploymentsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ListApplicationMostRecentDeploymentsRequestMultiError, or nil if none found.
+func (m *ListApplicationMostRecentDeploymentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationMostRecentDeploymentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetApplicationIds()) < 1 {
+		err := ListApplicationMostRecentDeploymentsRequestValidationError{
+			field:  "ApplicationIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := model.DeploymentStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListApplicationMostRecentDeploymentsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListApplicationMostRecentDeploymentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationMostRecentDeploymentsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ListApplicationMostRecentDeploymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationMostRecentDeploymentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationMostRecentDeploymentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationMostRecentDeploymentsRequestMultiError) AllErrors() []error { return m }
+
+// ListApplicationMostRecentDeploymentsRequestValidationError is the validation
+// error returned by ListApplicationMostRecentDeploymentsRequest.Validate if
+// the designated constraints aren't met.
+type ListApplicationMostRecentDeploymentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) ErrorName() string {
+	return "ListApplicationMostRecentDeploymentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationMostRecentDeploymentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationMostRecentDeploymentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationMostRecentDeploymentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationMostRecentDeploymentsRequestValidationError{}
+
+// Validate checks the field values on
+// ListApplicationMostRecentDeploymentsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationMostRecentDeploymentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListApplicationMostRecentDeploymentsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ListApplicationMostRecentDeploymentsResponseMultiError, or nil if none
+// found.
+func (m *ListApplicationMostRecentDeploymentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationMostRecentDeploymentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetDeployments()))
+		i := 0
+		for key := range m.GetDeployments() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetDeployments()[key]
+			_ = val
+
+			// no validation rules for Deployments[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ListApplicationMostRecentDeploymentsResponseValidationError{
+							field:  fmt.Sprintf("Deployments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ListApplicationMostRecentDeploymentsResponseValidationError{
+							field:  fmt.Sprintf("Deployments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ListApplicationMostRecentDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListApplicationMostRecentDeploymentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationMostRecentDeploymentsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListApplicationMostRecentDeploymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationMostRecentDeploymentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationMostRecentDeploymentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationMostRecentDeploymentsResponseMultiError) AllErrors() []error { return m }
+
+// ListApplicationMostRecentDeploymentsResponseValidationError is the
+// validation error returned by
+// ListApplicationMostRecentDeploymentsResponse.Validate if the designated
+// constraints aren't met.
+type ListApplicationMostRecentDeploymentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) ErrorName() string {
+	return "ListApplicationMostRecentDeploymentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationMostRecentDeploymentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationMostRecentDeploymentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationMostRecentDeploymentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationMostRecentDeploymentsResponseValidationError{}
+
 // Validate checks the field values on GetDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -8319,6 +8601,902 @@ var _ interface {
 	ErrorName() string
 } = InChainDeploymentPlannableResponseValidationError{}
 
+// Validate checks the field values on RegisterBranchApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RegisterBranchApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterBranchApplicationRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterBranchApplicationRequestMultiError, or nil if none found.
+func (m *RegisterBranchApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterBranchApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateApplicationId()) < 1 {
+		err := RegisterBranchApplicationRequestValidationError{
+			field:  "TemplateApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := RegisterBranchApplicationRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegisterBranchApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterBranchApplicationRequestMultiError is an error wrapping multiple
+// validation errors returned by RegisterBranchApplicationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RegisterBranchApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterBranchApplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterBranchApplicationRequestMultiError) AllErrors() []error { return m }
+
+// RegisterBranchApplicationRequestValidationError is the validation error
+// returned by RegisterBranchApplicationRequest.Validate if the designated
+// constraints aren't met.
+type RegisterBranchApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterBranchApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterBranchApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterBranchApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterBranchApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterBranchApplicationRequestValidationError) ErrorName() string {
+	return "RegisterBranchApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterBranchApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterBranchApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterBranchApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterBranchApplicationRequestValidationError{}
+
+// Validate checks the field values on RegisterBranchApplicationResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RegisterBranchApplicationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterBranchApplicationResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterBranchApplicationResponseMultiError, or nil if none found.
+func (m *RegisterBranchApplicationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterBranchApplicationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationId
+
+	if len(errors) > 0 {
+		return RegisterBranchApplicationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterBranchApplicationResponseMultiError is an error wrapping multiple
+// validation errors returned by RegisterBranchApplicationResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RegisterBranchApplicationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterBranchApplicationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterBranchApplicationResponseMultiError) AllErrors() []error { return m }
+
+// RegisterBranchApplicationResponseValidationError is the validation error
+// returned by RegisterBranchApplicationResponse.Validate if the designated
+// constraints aren't met.
+type RegisterBranchApplicationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterBranchApplicationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterBranchApplicationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterBranchApplicationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterBranchApplicationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterBranchApplicationResponseValidationError) ErrorName() string {
+	return "RegisterBranchApplicationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterBranchApplicationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterBranchApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterBranchApplicationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterBranchApplicationResponseValidationError{}
+
+// Validate checks the field values on UnregisterBranchApplicationRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UnregisterBranchApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnregisterBranchApplicationRequest
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UnregisterBranchApplicationRequestMultiError, or nil if none found.
+func (m *UnregisterBranchApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnregisterBranchApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := UnregisterBranchApplicationRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnregisterBranchApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnregisterBranchApplicationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UnregisterBranchApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnregisterBranchApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnregisterBranchApplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnregisterBranchApplicationRequestMultiError) AllErrors() []error { return m }
+
+// UnregisterBranchApplicationRequestValidationError is the validation error
+// returned by UnregisterBranchApplicationRequest.Validate if the designated
+// constraints aren't met.
+type UnregisterBranchApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnregisterBranchApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnregisterBranchApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnregisterBranchApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnregisterBranchApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnregisterBranchApplicationRequestValidationError) ErrorName() string {
+	return "UnregisterBranchApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnregisterBranchApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnregisterBranchApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnregisterBranchApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnregisterBranchApplicationRequestValidationError{}
+
+// Validate checks the field values on UnregisterBranchApplicationResponse with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UnregisterBranchApplicationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnregisterBranchApplicationResponse
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UnregisterBranchApplicationResponseMultiError, or nil if none found.
+func (m *UnregisterBranchApplicationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnregisterBranchApplicationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnregisterBranchApplicationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnregisterBranchApplicationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UnregisterBranchApplicationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnregisterBranchApplicationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnregisterBranchApplicationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnregisterBranchApplicationResponseMultiError) AllErrors() []error { return m }
+
+// UnregisterBranchApplicationResponseValidationError is the validation error
+// returned by UnregisterBranchApplicationResponse.Validate if the designated
+// constraints aren't met.
+type UnregisterBranchApplicationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnregisterBranchApplicationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnregisterBranchApplicationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnregisterBranchApplicationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnregisterBranchApplicationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnregisterBranchApplicationResponseValidationError) ErrorName() string {
+	return "UnregisterBranchApplicationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnregisterBranchApplicationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnregisterBranchApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnregisterBranchApplicationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnregisterBranchApplicationResponseValidationError{}
+
+// Validate checks the field values on GetTriggeringPausedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTriggeringPausedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTriggeringPausedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTriggeringPausedRequestMultiError, or nil if none found.
+func (m *GetTriggeringPausedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTriggeringPausedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTriggeringPausedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTriggeringPausedRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTriggeringPausedRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTriggeringPausedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTriggeringPausedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTriggeringPausedRequestMultiError) AllErrors() []error { return m }
+
+// GetTriggeringPausedRequestValidationError is the validation error returned
+// by GetTriggeringPausedRequest.Validate if the designated constraints aren't met.
+type GetTriggeringPausedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTriggeringPausedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTriggeringPausedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTriggeringPausedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTriggeringPausedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTriggeringPausedRequestValidationError) ErrorName() string {
+	return "GetTriggeringPausedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTriggeringPausedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTriggeringPausedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTriggeringPausedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTriggeringPausedRequestValidationError{}
+
+// Validate checks the field values on GetTriggeringPausedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTriggeringPausedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTriggeringPausedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTriggeringPausedResponseMultiError, or nil if none found.
+func (m *GetTriggeringPausedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTriggeringPausedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return GetTriggeringPausedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTriggeringPausedResponseMultiError is an error wrapping multiple
+// validation errors returned by GetTriggeringPausedResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetTriggeringPausedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTriggeringPausedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTriggeringPausedResponseMultiError) AllErrors() []error { return m }
+
+// GetTriggeringPausedResponseValidationError is the validation error returned
+// by GetTriggeringPausedResponse.Validate if the designated constraints
+// aren't met.
+type GetTriggeringPausedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTriggeringPausedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTriggeringPausedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTriggeringPausedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTriggeringPausedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTriggeringPausedResponseValidationError) ErrorName() string {
+	return "GetTriggeringPausedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTriggeringPausedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTriggeringPausedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTriggeringPausedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTriggeringPausedResponseValidationError{}
+
+// Validate checks the field values on ReportApplicationLastHandledCommitRequest
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReportApplicationLastHandledCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ReportApplicationLastHandledCommitRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a list
+// of violation errors wrapped in
+// ReportApplicationLastHandledCommitRequestMultiError, or nil if none found.
+func (m *ReportApplicationLastHandledCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportApplicationLastHandledCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := ReportApplicationLastHandledCommitRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCommit()) < 1 {
+		err := ReportApplicationLastHandledCommitRequestValidationError{
+			field:  "Commit",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HandledAt
+
+	if len(errors) > 0 {
+		return ReportApplicationLastHandledCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportApplicationLastHandledCommitRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ReportApplicationLastHandledCommitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportApplicationLastHandledCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportApplicationLastHandledCommitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportApplicationLastHandledCommitRequestMultiError) AllErrors() []error { return m }
+
+// ReportApplicationLastHandledCommitRequestValidationError is the validation
+// error returned by ReportApplicationLastHandledCommitRequest.Validate if the
+// designated constraints aren't met.
+type ReportApplicationLastHandledCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportApplicationLastHandledCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportApplicationLastHandledCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportApplicationLastHandledCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportApplicationLastHandledCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportApplicationLastHandledCommitRequestValidationError) ErrorName() string {
+	return "ReportApplicationLastHandledCommitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportApplicationLastHandledCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportApplicationLastHandledCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportApplicationLastHandledCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportApplicationLastHandledCommitRequestValidationError{}
+
+// Validate checks the field values on
+// ReportApplicationLastHandledCommitResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *ReportApplicationLastHandledCommitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ReportApplicationLastHandledCommitResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ReportApplicationLastHandledCommitResponseMultiError, or nil if none found.
+func (m *ReportApplicationLastHandledCommitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportApplicationLastHandledCommitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReportApplicationLastHandledCommitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportApplicationLastHandledCommitResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ReportApplicationLastHandledCommitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportApplicationLastHandledCommitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportApplicationLastHandledCommitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportApplicationLastHandledCommitResponseMultiError) AllErrors() []error { return m }
+
+// ReportApplicationLastHandledCommitResponseValidationError is the validation
+// error returned by ReportApplicationLastHandledCommitResponse.Validate if the
+// designated constraints aren't met.
+type ReportApplicationLastHandledCommitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportApplicationLastHandledCommitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportApplicationLastHandledCommitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportApplicationLastHandledCommitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportApplicationLastHandledCommitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportApplicationLastHandledCommitResponseValidationError) ErrorName() string {
+	return "ReportApplicationLastHandledCommitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportApplicationLastHandledCommitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportApplicationLastHandledCommitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportApplicationLastHandledCommitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportApplicationLastHandledCommitResponseValidationError{}
+
 // Validate checks the field values on ReportEventStatusesRequest_Event with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
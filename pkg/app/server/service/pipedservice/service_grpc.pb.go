@@ -42,6 +42,9 @@ type PipedServiceClient interface {
 	ReportApplicationMostRecentDeployment(ctx context.Context, in *ReportApplicationMostRecentDeploymentRequest, opts ...grpc.CallOption) (*ReportApplicationMostRecentDeploymentResponse, error)
 	// GetApplicationMostRecentDeployment returns the most recent deployment of the given application.
 	GetApplicationMostRecentDeployment(ctx context.Context, in *GetApplicationMostRecentDeploymentRequest, opts ...grpc.CallOption) (*GetApplicationMostRecentDeploymentResponse, error)
+	// ListApplicationMostRecentDeployments returns the most recent deployments of the given applications at once.
+	// The applications having no deployment or not belonging to the piped are not contained in the response.
+	ListApplicationMostRecentDeployments(ctx context.Context, in *ListApplicationMostRecentDeploymentsRequest, opts ...grpc.CallOption) (*ListApplicationMostRecentDeploymentsResponse, error)
 	// GetDeployment returns the deployment for given deployment ID.
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	// ListNotCompletedDeployments returns a list of not completed deployments
@@ -133,6 +136,19 @@ type PipedServiceClient interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(ctx context.Context, in *InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*InChainDeploymentPlannableResponse, error)
+	// RegisterBranchApplication registers a new application deploying the given Git branch
+	// by copying the given template application.
+	RegisterBranchApplication(ctx context.Context, in *RegisterBranchApplicationRequest, opts ...grpc.CallOption) (*RegisterBranchApplicationResponse, error)
+	// UnregisterBranchApplication deletes the given application registered by RegisterBranchApplication
+	// after its Git branch was deleted.
+	UnregisterBranchApplication(ctx context.Context, in *UnregisterBranchApplicationRequest, opts ...grpc.CallOption) (*UnregisterBranchApplicationResponse, error)
+	// GetTriggeringPaused returns whether triggering new deployments
+	// is paused in all pipeds of the project.
+	GetTriggeringPaused(ctx context.Context, in *GetTriggeringPausedRequest, opts ...grpc.CallOption) (*GetTriggeringPausedResponse, error)
+	// ReportApplicationLastHandledCommit is used to update the commit handled most recently by piped
+	// regardless of whether a deployment was triggered.
+	// The report older than the saved one is ignored.
+	ReportApplicationLastHandledCommit(ctx context.Context, in *ReportApplicationLastHandledCommitRequest, opts ...grpc.CallOption) (*ReportApplicationLastHandledCommitResponse, error)
 }
 
 type pipedServiceClient struct {
@@ -206,6 +222,15 @@ func (c *pipedServiceClient) GetApplicationMostRecentDeployment(ctx context.Cont
 	return out, nil
 }
 
+func (c *pipedServiceClient) ListApplicationMostRecentDeployments(ctx context.Context, in *ListApplicationMostRecentDeploymentsRequest, opts ...grpc.CallOption) (*ListApplicationMostRecentDeploymentsResponse, error) {
+	out := new(ListApplicationMostRecentDeploymentsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/ListApplicationMostRecentDeployments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipedServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error) {
 	out := new(GetDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetDeployment", in, out, opts...)
@@ -440,6 +465,42 @@ func (c *pipedServiceClient) InChainDeploymentPlannable(ctx context.Context, in
 	return out, nil
 }
 
+func (c *pipedServiceClient) RegisterBranchApplication(ctx context.Context, in *RegisterBranchApplicationRequest, opts ...grpc.CallOption) (*RegisterBranchApplicationResponse, error) {
+	out := new(RegisterBranchApplicationResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/RegisterBranchApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) UnregisterBranchApplication(ctx context.Context, in *UnregisterBranchApplicationRequest, opts ...grpc.CallOption) (*UnregisterBranchApplicationResponse, error) {
+	out := new(UnregisterBranchApplicationResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/UnregisterBranchApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) GetTriggeringPaused(ctx context.Context, in *GetTriggeringPausedRequest, opts ...grpc.CallOption) (*GetTriggeringPausedResponse, error) {
+	out := new(GetTriggeringPausedResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetTriggeringPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) ReportApplicationLastHandledCommit(ctx context.Context, in *ReportApplicationLastHandledCommitRequest, opts ...grpc.CallOption) (*ReportApplicationLastHandledCommitResponse, error) {
+	out := new(ReportApplicationLastHandledCommitResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/ReportApplicationLastHandledCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipedServiceServer is the server API for PipedService service.
 // All implementations must embed UnimplementedPipedServiceServer
 // for forward compatibility
@@ -464,6 +525,9 @@ type PipedServiceServer interface {
 	ReportApplicationMostRecentDeployment(context.Context, *ReportApplicationMostRecentDeploymentRequest) (*ReportApplicationMostRecentDeploymentResponse, error)
 	// GetApplicationMostRecentDeployment returns the most recent deployment of the given application.
 	GetApplicationMostRecentDeployment(context.Context, *GetApplicationMostRecentDeploymentRequest) (*GetApplicationMostRecentDeploymentResponse, error)
+	// ListApplicationMostRecentDeployments returns the most recent deployments of the given applications at once.
+	// The applications having no deployment or not belonging to the piped are not contained in the response.
+	ListApplicationMostRecentDeployments(context.Context, *ListApplicationMostRecentDeploymentsRequest) (*ListApplicationMostRecentDeploymentsResponse, error)
 	// GetDeployment returns the deployment for given deployment ID.
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	// ListNotCompletedDeployments returns a list of not completed deployments
@@ -555,6 +619,19 @@ type PipedServiceServer interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error)
+	// RegisterBranchApplication registers a new application deploying the given Git branch
+	// by copying the given template application.
+	RegisterBranchApplication(context.Context, *RegisterBranchApplicationRequest) (*RegisterBranchApplicationResponse, error)
+	// UnregisterBranchApplication deletes the given application registered by RegisterBranchApplication
+	// after its Git branch was deleted.
+	UnregisterBranchApplication(context.Context, *UnregisterBranchApplicationRequest) (*UnregisterBranchApplicationResponse, error)
+	// GetTriggeringPaused returns whether triggering new deployments
+	// is paused in all pipeds of the project.
+	GetTriggeringPaused(context.Context, *GetTriggeringPausedRequest) (*GetTriggeringPausedResponse, error)
+	// ReportApplicationLastHandledCommit is used to update the commit handled most recently by piped
+	// regardless of whether a deployment was triggered.
+	// The report older than the saved one is ignored.
+	ReportApplicationLastHandledCommit(context.Context, *ReportApplicationLastHandledCommitRequest) (*ReportApplicationLastHandledCommitResponse, error)
 	mustEmbedUnimplementedPipedServiceServer()
 }
 
@@ -583,6 +660,9 @@ func (UnimplementedPipedServiceServer) ReportApplicationMostRecentDeployment(con
 func (UnimplementedPipedServiceServer) GetApplicationMostRecentDeployment(context.Context, *GetApplicationMostRecentDeploymentRequest) (*GetApplicationMostRecentDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationMostRecentDeployment not implemented")
 }
+func (UnimplementedPipedServiceServer) ListApplicationMostRecentDeployments(context.Context, *ListApplicationMostRecentDeploymentsRequest) (*ListApplicationMostRecentDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicationMostRecentDeployments not implemented")
+}
 func (UnimplementedPipedServiceServer) GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
 }
@@ -661,6 +741,18 @@ func (UnimplementedPipedServiceServer) CreateDeploymentChain(context.Context, *C
 func (UnimplementedPipedServiceServer) InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InChainDeploymentPlannable not implemented")
 }
+func (UnimplementedPipedServiceServer) RegisterBranchApplication(context.Context, *RegisterBranchApplicationRequest) (*RegisterBranchApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBranchApplication not implemented")
+}
+func (UnimplementedPipedServiceServer) UnregisterBranchApplication(context.Context, *UnregisterBranchApplicationRequest) (*UnregisterBranchApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterBranchApplication not implemented")
+}
+func (UnimplementedPipedServiceServer) GetTriggeringPaused(context.Context, *GetTriggeringPausedRequest) (*GetTriggeringPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriggeringPaused not implemented")
+}
+func (UnimplementedPipedServiceServer) ReportApplicationLastHandledCommit(context.Context, *ReportApplicationLastHandledCommitRequest) (*ReportApplicationLastHandledCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportApplicationLastHandledCommit not implemented")
+}
 func (UnimplementedPipedServiceServer) mustEmbedUnimplementedPipedServiceServer() {}
 
 // UnsafePipedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -800,6 +892,24 @@ func _PipedService_GetApplicationMostRecentDeployment_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_ListApplicationMostRecentDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationMostRecentDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).ListApplicationMostRecentDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/ListApplicationMostRecentDeployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).ListApplicationMostRecentDeployments(ctx, req.(*ListApplicationMostRecentDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipedService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -1268,6 +1378,78 @@ func _PipedService_InChainDeploymentPlannable_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_RegisterBranchApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterBranchApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).RegisterBranchApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/RegisterBranchApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).RegisterBranchApplication(ctx, req.(*RegisterBranchApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_UnregisterBranchApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterBranchApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).UnregisterBranchApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/UnregisterBranchApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).UnregisterBranchApplication(ctx, req.(*UnregisterBranchApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_GetTriggeringPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriggeringPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).GetTriggeringPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/GetTriggeringPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).GetTriggeringPaused(ctx, req.(*GetTriggeringPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_ReportApplicationLastHandledCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportApplicationLastHandledCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).ReportApplicationLastHandledCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/ReportApplicationLastHandledCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).ReportApplicationLastHandledCommit(ctx, req.(*ReportApplicationLastHandledCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PipedService_ServiceDesc is the grpc.ServiceDesc for PipedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1303,6 +1485,10 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApplicationMostRecentDeployment",
 			Handler:    _PipedService_GetApplicationMostRecentDeployment_Handler,
 		},
+		{
+			MethodName: "ListApplicationMostRecentDeployments",
+			Handler:    _PipedService_ListApplicationMostRecentDeployments_Handler,
+		},
 		{
 			MethodName: "GetDeployment",
 			Handler:    _PipedService_GetDeployment_Handler,
@@ -1407,6 +1593,22 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InChainDeploymentPlannable",
 			Handler:    _PipedService_InChainDeploymentPlannable_Handler,
 		},
+		{
+			MethodName: "RegisterBranchApplication",
+			Handler:    _PipedService_RegisterBranchApplication_Handler,
+		},
+		{
+			MethodName: "UnregisterBranchApplication",
+			Handler:    _PipedService_UnregisterBranchApplication_Handler,
+		},
+		{
+			MethodName: "GetTriggeringPaused",
+			Handler:    _PipedService_GetTriggeringPaused_Handler,
+		},
+		{
+			MethodName: "ReportApplicationLastHandledCommit",
+			Handler:    _PipedService_ReportApplicationLastHandledCommit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/pipedservice/service.proto",
@@ -23,6 +23,8 @@ import (
 
 type LRUCache struct {
 	cache *lru.Cache
+	// Optional function called every time the oldest item was evicted to add a new one.
+	onEvicted func()
 }
 
 func NewLRUCache(size int) (*LRUCache, error) {
@@ -35,6 +37,18 @@ func NewLRUCache(size int) (*LRUCache, error) {
 	}, nil
 }
 
+// NewLRUCacheWithEvictionHandler is the same as NewLRUCache
+// but calls the given function every time the oldest item was evicted to add a new one.
+// It is not called for the items removed by Delete.
+func NewLRUCacheWithEvictionHandler(size int, onEvicted func()) (*LRUCache, error) {
+	c, err := NewLRUCache(size)
+	if err != nil {
+		return nil, err
+	}
+	c.onEvicted = onEvicted
+	return c, nil
+}
+
 func (c *LRUCache) Get(key string) (interface{}, error) {
 	item, ok := c.cache.Get(key)
 	if !ok {
@@ -52,7 +66,9 @@ func (c *LRUCache) Get(key string) (interface{}, error) {
 }
 
 func (c *LRUCache) Put(key string, value interface{}) error {
-	c.cache.Add(key, value)
+	if evicted := c.cache.Add(key, value); evicted && c.onEvicted != nil {
+		c.onEvicted()
+	}
 	return nil
 }
 
@@ -64,3 +80,8 @@ func (c *LRUCache) Delete(key string) error {
 func (c *LRUCache) GetAll() (map[string]interface{}, error) {
 	return nil, cache.ErrUnimplemented
 }
+
+// Len returns the number of the items in the cache.
+func (c *LRUCache) Len() int {
+	return c.cache.Len()
+}
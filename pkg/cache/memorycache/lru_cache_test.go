@@ -0,0 +1,46 @@
+// Copyright 2020 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorycache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+)
+
+func TestLRUCacheWithEvictionHandler(t *testing.T) {
+	evictions := 0
+	c, err := NewLRUCacheWithEvictionHandler(2, func() { evictions++ })
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put("key-1", "value-1"))
+	require.NoError(t, c.Put("key-2", "value-2"))
+	require.NoError(t, c.Put("key-2", "value-2-updated"))
+	assert.Equal(t, 0, evictions)
+	assert.Equal(t, 2, c.Len())
+
+	require.NoError(t, c.Put("key-3", "value-3"))
+	assert.Equal(t, 1, evictions)
+	assert.Equal(t, 2, c.Len())
+	_, err = c.Get("key-1")
+	assert.Equal(t, cache.ErrNotFound, err)
+
+	require.NoError(t, c.Delete("key-2"))
+	assert.Equal(t, 1, evictions)
+	assert.Equal(t, 1, c.Len())
+}
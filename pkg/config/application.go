@@ -17,8 +17,12 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/robfig/cron/v3"
+
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -85,6 +89,152 @@ type Trigger struct {
 	// Configurable fields used while deciding the application
 	// should be triggered based on received CHAIN_SYNC command.
 	OnChain OnChain `json:"onChain"`
+	// Configurable fields used while deciding the application
+	// should be triggered periodically regardless of commit changes.
+	OnSchedule OnSchedule `json:"onSchedule"`
+	// Boolean combination of the above trigger kinds which must be satisfied
+	// as a unit to trigger a new deployment.
+	// When this is specified, the trigger kinds are no longer evaluated independently.
+	Condition *TriggerCondition `json:"condition,omitempty"`
+	// Whether to stop triggering new deployments by new commits, configuration drift or schedule
+	// to keep the application at its currently deployed commit.
+	// The SYNC commands can still trigger new deployments.
+	// Default is false.
+	Pinned bool `json:"pinned,omitempty"`
+	// Hook to decide whether the deployment can be triggered right before triggering it,
+	// e.g. checking an external change-freeze API.
+	PreTriggerHook *PreTriggerHook `json:"preTriggerHook,omitempty"`
+	// The names of the applications which must successfully deploy the same commit
+	// before a new deployment of this application is triggered.
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Whether to stop triggering new deployments by configuration drift
+	// when the head commit is the same as the one of the most recently triggered deployment.
+	// This is useful to leave the drift caused outside of Git to another reconciler.
+	// Default is false.
+	SkipOutOfSyncWhenCommitUnchanged bool `json:"skipOutOfSyncWhenCommitUnchanged,omitempty"`
+	// Whether to trigger new deployments by configuration drift.
+	// When false, the OUT_OF_SYNC state is still reported but never triggers any deployment
+	// regardless of trigger.onOutOfSync, while the SYNC commands still do.
+	// Default is true.
+	TriggerOnOutOfSync *bool `json:"triggerOnOutOfSync,omitempty" default:"true"`
+	// Labels added to the triggered deployments in addition to the ones of the application.
+	// The values are Go templates rendered with the application, the commit and the environment,
+	// e.g. "{{ .Commit.ShortHash }}".
+	DeploymentLabels map[string]string `json:"deploymentLabels,omitempty"`
+	// Annotations saved to the metadata of the triggered deployments.
+	// The values are Go templates rendered the same as the deployment labels.
+	// The metadata saved by piped cannot be overridden.
+	DeploymentAnnotations map[string]string `json:"deploymentAnnotations,omitempty"`
+}
+
+// validateDeploymentTemplates validates the keys and the templated values of
+// the deployment labels or annotations at the given field.
+func validateDeploymentTemplates(field string, templates map[string]string) error {
+	for k, v := range templates {
+		if k == "" {
+			return fmt.Errorf("%s must not contain an empty key", field)
+		}
+		if _, err := template.New(k).Parse(v); err != nil {
+			return fmt.Errorf("invalid template of %s.%s: %w", field, k, err)
+		}
+	}
+	return nil
+}
+
+// PreTriggerHook represents a command or an HTTP endpoint used to gate the triggering.
+// Exactly one of Command or URL must be specified.
+type PreTriggerHook struct {
+	// Shell command to run in the application directory.
+	// The triggering is denied when it exits with a non-zero code.
+	Command string `json:"command,omitempty"`
+	// URL to send a POST request containing the application and the commit.
+	// The triggering is denied when it responds with a non-2xx status code.
+	URL string `json:"url,omitempty"`
+	// How long to wait for the hook. The triggering is denied when it timed out.
+	// Default is 30s.
+	Timeout Duration `json:"timeout,omitempty"`
+}
+
+func (h *PreTriggerHook) Validate() error {
+	if (h.Command == "") == (h.URL == "") {
+		return fmt.Errorf("exactly one of \"command\" or \"url\" must be set in preTriggerHook")
+	}
+	if h.Timeout < 0 {
+		return fmt.Errorf("preTriggerHook.timeout must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// TriggerCondition represents a boolean expression over the trigger kinds.
+// Exactly one of Kind, And or Or must be specified.
+type TriggerCondition struct {
+	// The trigger kind to evaluate.
+	// Must be one of ON_COMMIT, ON_COMMAND, ON_OUT_OF_SYNC, ON_CHAIN, ON_EXTERNAL or ON_SCHEDULE.
+	Kind string `json:"kind,omitempty"`
+	// Satisfied only when all of the given conditions are satisfied.
+	And []TriggerCondition `json:"and,omitempty"`
+	// Satisfied when at least one of the given conditions is satisfied.
+	Or []TriggerCondition `json:"or,omitempty"`
+}
+
+func (c *TriggerCondition) Validate() error {
+	var specified int
+	if c.Kind != "" {
+		specified++
+	}
+	if len(c.And) != 0 {
+		specified++
+	}
+	if len(c.Or) != 0 {
+		specified++
+	}
+	if specified != 1 {
+		return fmt.Errorf("exactly one of \"kind\", \"and\" or \"or\" must be set in trigger condition")
+	}
+
+	if c.Kind != "" {
+		if _, ok := model.TriggerKind_value[c.Kind]; !ok {
+			return fmt.Errorf("kind %q is incorrect as TriggerKind", c.Kind)
+		}
+		return nil
+	}
+	for i := range c.And {
+		if err := c.And[i].Validate(); err != nil {
+			return err
+		}
+	}
+	for i := range c.Or {
+		if err := c.Or[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TriggerKind returns the trigger kind of a leaf condition.
+func (c *TriggerCondition) TriggerKind() (model.TriggerKind, bool) {
+	k, ok := model.TriggerKind_value[c.Kind]
+	return model.TriggerKind(k), ok
+}
+
+// String returns the human-readable representation of the condition,
+// e.g. (ON_COMMIT AND (ON_COMMAND OR ON_CHAIN)).
+func (c TriggerCondition) String() string {
+	if c.Kind != "" {
+		return c.Kind
+	}
+	var (
+		conds = c.And
+		op    = " AND "
+	)
+	if len(c.Or) != 0 {
+		conds, op = c.Or, " OR "
+	}
+	parts := make([]string, 0, len(conds))
+	for _, sc := range conds {
+		parts = append(parts, sc.String())
+	}
+	return "(" + strings.Join(parts, op) + ")"
 }
 
 type OnCommit struct {
@@ -93,8 +243,52 @@ type OnCommit struct {
 	// Default is false.
 	Disabled bool `json:"disabled,omitempty"`
 	// List of directories or files where their changes will trigger the deployment.
-	// Regular expression can be used.
+	// Glob patterns can be used, the ones prefixed with "!" exclude the matching files
+	// even if they are inside the application directory.
+	// The ones starting with "./" or "../" are relative to the application directory,
+	// e.g. "../base" to watch the kustomize base or the Helm values shared with the other applications.
 	Paths []string `json:"paths,omitempty"`
+	// List of files whose changes never trigger the deployment, e.g. README.md, CHANGELOG.md.
+	// Glob patterns can be used. The deployment is not triggered when all changed files were ignored.
+	Ignores []string `json:"ignores,omitempty"`
+	// Whether to trigger the deployment only when the head commit is a merge commit,
+	// e.g. to deploy only the pull requests merged into the branch
+	// but not the intermediate commits pushed directly.
+	// The changes of the other commits are treated as handled without triggering.
+	// Default is false.
+	MergeCommitsOnly bool `json:"mergeCommitsOnly,omitempty"`
+	// Whether to trigger a deployment for each new commit touching the application in order
+	// instead of only for the head commit, e.g. to keep every commit as a rollback point.
+	// Default is false.
+	DeployEachCommit bool `json:"deployEachCommit,omitempty"`
+	// Maximum number of deployments triggered for the new commits at a check when deployEachCommit is enabled.
+	// The rest of the commits are triggered at the subsequent checks.
+	// Zero means 5.
+	MaxCommitsPerCheck int `json:"maxCommitsPerCheck,omitempty"`
+	// List of fields in the YAML or JSON files whose value changes trigger the deployment,
+	// e.g. the image tag in the Helm values file.
+	// When specified, the deployment is triggered only when any of those values was changed
+	// instead of when any file matching the application directory or the paths was changed.
+	Contents []OnCommitContent `json:"contents,omitempty"`
+}
+
+// OnCommitContent represents a field in a file whose value change triggers the deployment.
+type OnCommitContent struct {
+	// The path to the YAML or JSON file, relative to the application directory.
+	File string `json:"file"`
+	// The path to the field in the file, starting with "$" which represents the root element,
+	// e.g. "$.image.tag".
+	Field string `json:"field"`
+}
+
+func (c *OnCommitContent) Validate() error {
+	if c.File == "" {
+		return fmt.Errorf("trigger.onCommit.contents.file must be set")
+	}
+	if !strings.HasPrefix(c.Field, "$") {
+		return fmt.Errorf("trigger.onCommit.contents.field must start with \"$\", got %q", c.Field)
+	}
+	return nil
 }
 
 type OnCommand struct {
@@ -112,6 +306,73 @@ type OnOutOfSync struct {
 	// Minimum amount of time must be elapsed since the last deployment.
 	// This can be used to avoid triggering unnecessary continuous deployments based on OUT_OF_SYNC status.
 	MinWindow Duration `json:"minWindow,omitempty" default:"5m"`
+	// Number of consecutive checks the application must be at OUT_OF_SYNC state before triggering.
+	// This can be used to avoid triggering by the transient drift.
+	// Zero or one means triggering at the first check.
+	ConfirmationCount int `json:"confirmationCount,omitempty"`
+	// Backoff applied before triggering again by configuration drift
+	// while the deployments keep failing at the same commit.
+	// Empty means no backoff.
+	FailureBackoff *OnOutOfSyncFailureBackoff `json:"failureBackoff,omitempty"`
+	// Whether to render the manifests again and compare them with the live state
+	// before triggering at the commit of the most recent deployment.
+	// The triggering is suppressed when no difference was found since the drift was a false one.
+	// Currently only KUBERNETES applications support this.
+	// Default is false.
+	SkipIdenticalManifests bool `json:"skipIdenticalManifests,omitempty"`
+	// Whether not to trigger when the drift is caused only by the live resources not defined in Git,
+	// e.g. the ones added by the other controllers in the cluster.
+	// Currently only KUBERNETES applications support this.
+	// Default is false.
+	IgnoreExtraResources bool `json:"ignoreExtraResources,omitempty"`
+}
+
+// OnOutOfSyncFailureBackoff represents the exponential backoff applied
+// after the consecutive failed deployments at the same commit.
+// It is reset when a new commit arrives or a deployment succeeds.
+type OnOutOfSyncFailureBackoff struct {
+	// Number of consecutive failed deployments at the same commit before applying the backoff.
+	// Default is 3.
+	Threshold int `json:"threshold,omitempty" default:"3"`
+	// The interval to wait since the last failure once the threshold was reached.
+	// It is doubled for each subsequent failure.
+	// Default is 10m.
+	BaseInterval Duration `json:"baseInterval,omitempty" default:"10m"`
+	// The maximum interval to wait since the last failure.
+	// Default is 6h.
+	MaxInterval Duration `json:"maxInterval,omitempty" default:"6h"`
+}
+
+func (b *OnOutOfSyncFailureBackoff) Validate() error {
+	if b.Threshold <= 0 {
+		return fmt.Errorf("trigger.onOutOfSync.failureBackoff.threshold must be greater than 0")
+	}
+	if b.BaseInterval <= 0 {
+		return fmt.Errorf("trigger.onOutOfSync.failureBackoff.baseInterval must be greater than 0")
+	}
+	if b.MaxInterval < b.BaseInterval {
+		return fmt.Errorf("trigger.onOutOfSync.failureBackoff.maxInterval must be greater than or equal to baseInterval")
+	}
+	return nil
+}
+
+// Interval returns how long to wait since the last failure
+// before triggering again after the given number of consecutive failures.
+func (b *OnOutOfSyncFailureBackoff) Interval(failures int) time.Duration {
+	if failures < b.Threshold {
+		return 0
+	}
+	var (
+		d   = b.BaseInterval.Duration()
+		max = b.MaxInterval.Duration()
+	)
+	for i := b.Threshold; i < failures && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		return max
+	}
+	return d
 }
 
 type OnChain struct {
@@ -121,6 +382,37 @@ type OnChain struct {
 	Disabled *bool `json:"disabled,omitempty" default:"true"`
 }
 
+// OnSchedule represents the periodic triggering at the head commit even though it has no change,
+// e.g. to rotate the short-lived secrets rendered at deploy time.
+type OnSchedule struct {
+	// The cron expression in the standard five fields format, e.g. "0 3 * * *".
+	// Empty means the application is not triggered periodically.
+	Cron string `json:"cron,omitempty"`
+	// The IANA name of the timezone of the cron expression, e.g. Asia/Tokyo.
+	// Default is UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Schedule parses the cron expression in the configured timezone.
+// Nil is returned when no cron expression was specified.
+func (s *OnSchedule) Schedule() (cron.Schedule, error) {
+	if s.Cron == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trigger.onSchedule.timezone: %w", err)
+	}
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trigger.onSchedule.cron: %w", err)
+	}
+	if ss, ok := sched.(*cron.SpecSchedule); ok {
+		ss.Location = loc
+	}
+	return sched, nil
+}
+
 func (s *GenericApplicationSpec) Validate() error {
 	if s.Pipeline != nil {
 		for _, stage := range s.Pipeline.Stages {
@@ -137,6 +429,50 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	if c := s.Trigger.Condition; c != nil {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Trigger.OnCommit.MaxCommitsPerCheck < 0 {
+		return fmt.Errorf("trigger.onCommit.maxCommitsPerCheck must be greater than or equal to 0")
+	}
+	if err := validateDeploymentTemplates("trigger.deploymentLabels", s.Trigger.DeploymentLabels); err != nil {
+		return err
+	}
+	if err := validateDeploymentTemplates("trigger.deploymentAnnotations", s.Trigger.DeploymentAnnotations); err != nil {
+		return err
+	}
+	for i := range s.Trigger.OnCommit.Contents {
+		if err := s.Trigger.OnCommit.Contents[i].Validate(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.Trigger.OnSchedule.Schedule(); err != nil {
+		return err
+	}
+	if s.Trigger.OnOutOfSync.ConfirmationCount < 0 {
+		return fmt.Errorf("trigger.onOutOfSync.confirmationCount must be greater than or equal to 0")
+	}
+	if b := s.Trigger.OnOutOfSync.FailureBackoff; b != nil {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+	}
+	if h := s.Trigger.PreTriggerHook; h != nil {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, name := range s.Trigger.DependsOn {
+		if name == "" {
+			return fmt.Errorf("trigger.dependsOn must not contain an empty application name")
+		}
+		if name == s.Name {
+			return fmt.Errorf("trigger.dependsOn must not contain the application itself")
+		}
+	}
+
 	if ps := s.PostSync; ps != nil {
 		if err := ps.Validate(); err != nil {
 			return err
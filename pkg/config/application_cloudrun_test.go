@@ -51,6 +51,7 @@ func TestCloudRunApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: CloudRunDeploymentInput{
@@ -52,6 +52,7 @@ func TestECSApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: ECSDeploymentInput{
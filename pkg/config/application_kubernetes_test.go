@@ -94,6 +94,7 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: KubernetesDeploymentInput{
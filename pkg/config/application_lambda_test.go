@@ -53,6 +53,7 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: LambdaDeploymentInput{
@@ -103,6 +104,7 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: LambdaDeploymentInput{
@@ -144,6 +146,7 @@ func TestLambdaApplicationConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: LambdaDeploymentInput{
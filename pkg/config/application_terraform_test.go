@@ -53,6 +53,7 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: TerraformDeploymentInput{},
@@ -80,6 +81,7 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: TerraformDeploymentInput{
@@ -110,6 +112,7 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 					Encryption: &SecretEncryption{
 						EncryptedSecrets: map[string]string{
@@ -169,6 +172,7 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: TerraformDeploymentInput{
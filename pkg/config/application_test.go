@@ -311,6 +311,7 @@ func TestGenericTriggerConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: KubernetesDeploymentInput{
@@ -339,6 +340,318 @@ func TestGenericTriggerConfiguration(t *testing.T) {
 	}
 }
 
+func TestValidateTriggerCondition(t *testing.T) {
+	testcases := []struct {
+		name     string
+		cond     TriggerCondition
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "valid single kind",
+			cond:     TriggerCondition{Kind: "ON_COMMIT"},
+			expected: "ON_COMMIT",
+			wantErr:  false,
+		},
+		{
+			name: "valid nested condition",
+			cond: TriggerCondition{
+				And: []TriggerCondition{
+					{Kind: "ON_COMMIT"},
+					{Or: []TriggerCondition{{Kind: "ON_COMMAND"}, {Kind: "ON_CHAIN"}}},
+				},
+			},
+			expected: "(ON_COMMIT AND (ON_COMMAND OR ON_CHAIN))",
+			wantErr:  false,
+		},
+		{
+			name:    "invalid because of unknown kind",
+			cond:    TriggerCondition{Kind: "ON_PUSH"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of empty condition",
+			cond:    TriggerCondition{},
+			wantErr: true,
+		},
+		{
+			name: "invalid because both kind and and are set",
+			cond: TriggerCondition{
+				Kind: "ON_COMMIT",
+				And:  []TriggerCondition{{Kind: "ON_COMMAND"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid because of invalid nested condition",
+			cond: TriggerCondition{
+				Or: []TriggerCondition{{Kind: "ON_COMMIT"}, {}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cond.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, tc.cond.String())
+			}
+		})
+	}
+}
+
+func TestValidatePreTriggerHook(t *testing.T) {
+	testcases := []struct {
+		name    string
+		hook    PreTriggerHook
+		wantErr bool
+	}{
+		{
+			name:    "valid command",
+			hook:    PreTriggerHook{Command: "./check-freeze.sh", Timeout: Duration(time.Minute)},
+			wantErr: false,
+		},
+		{
+			name:    "valid url",
+			hook:    PreTriggerHook{URL: "https://example.com/freeze"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid because neither command nor url is set",
+			hook:    PreTriggerHook{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because both command and url are set",
+			hook:    PreTriggerHook{Command: "./check-freeze.sh", URL: "https://example.com/freeze"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of negative timeout",
+			hook:    PreTriggerHook{Command: "./check-freeze.sh", Timeout: Duration(-time.Second)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.hook.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateTriggerDependsOn(t *testing.T) {
+	testcases := []struct {
+		name      string
+		dependsOn []string
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			dependsOn: []string{"app-a", "app-b"},
+			wantErr:   false,
+		},
+		{
+			name:      "invalid because of empty name",
+			dependsOn: []string{"app-a", ""},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid because of depending on itself",
+			dependsOn: []string{"app-c"},
+			wantErr:   true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := GenericApplicationSpec{
+				Name:    "app-c",
+				Trigger: Trigger{DependsOn: tc.dependsOn},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateOnCommitContents(t *testing.T) {
+	testcases := []struct {
+		name     string
+		contents []OnCommitContent
+		wantErr  bool
+	}{
+		{
+			name:    "not configured",
+			wantErr: false,
+		},
+		{
+			name:     "valid",
+			contents: []OnCommitContent{{File: "values.yaml", Field: "$.image.tag"}},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid because of empty file",
+			contents: []OnCommitContent{{Field: "$.image.tag"}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid because of field not starting with $",
+			contents: []OnCommitContent{{File: "values.yaml", Field: "image.tag"}},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := GenericApplicationSpec{
+				Trigger: Trigger{OnCommit: OnCommit{Contents: tc.contents}},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateDeploymentTemplates(t *testing.T) {
+	testcases := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "valid",
+			labels:      map[string]string{"commit": "{{ .Commit.ShortHash }}"},
+			annotations: map[string]string{"env": "{{ .Env.ID }}"},
+			wantErr:     false,
+		},
+		{
+			name:    "invalid because of empty key",
+			labels:  map[string]string{"": "value"},
+			wantErr: true,
+		},
+		{
+			name:        "invalid because of malformed template",
+			annotations: map[string]string{"commit": "{{ .Commit.Hash"},
+			wantErr:     true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := GenericApplicationSpec{
+				Trigger: Trigger{DeploymentLabels: tc.labels, DeploymentAnnotations: tc.annotations},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateOnOutOfSyncFailureBackoff(t *testing.T) {
+	testcases := []struct {
+		name    string
+		backoff *OnOutOfSyncFailureBackoff
+		wantErr bool
+	}{
+		{
+			name:    "not configured",
+			wantErr: false,
+		},
+		{
+			name:    "valid",
+			backoff: &OnOutOfSyncFailureBackoff{Threshold: 3, BaseInterval: Duration(time.Minute), MaxInterval: Duration(time.Hour)},
+			wantErr: false,
+		},
+		{
+			name:    "invalid because of zero threshold",
+			backoff: &OnOutOfSyncFailureBackoff{Threshold: 0, BaseInterval: Duration(time.Minute), MaxInterval: Duration(time.Hour)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid because of max interval less than base interval",
+			backoff: &OnOutOfSyncFailureBackoff{Threshold: 3, BaseInterval: Duration(time.Hour), MaxInterval: Duration(time.Minute)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := GenericApplicationSpec{
+				Trigger: Trigger{OnOutOfSync: OnOutOfSync{FailureBackoff: tc.backoff}},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestOnOutOfSyncFailureBackoffInterval(t *testing.T) {
+	b := &OnOutOfSyncFailureBackoff{
+		Threshold:    3,
+		BaseInterval: Duration(10 * time.Minute),
+		MaxInterval:  Duration(time.Hour),
+	}
+	assert.Equal(t, time.Duration(0), b.Interval(0))
+	assert.Equal(t, time.Duration(0), b.Interval(2))
+	assert.Equal(t, 10*time.Minute, b.Interval(3))
+	assert.Equal(t, 20*time.Minute, b.Interval(4))
+	assert.Equal(t, 40*time.Minute, b.Interval(5))
+	assert.Equal(t, time.Hour, b.Interval(6))
+	assert.Equal(t, time.Hour, b.Interval(100))
+}
+
+func TestValidateOnSchedule(t *testing.T) {
+	testcases := []struct {
+		name     string
+		schedule OnSchedule
+		wantErr  bool
+	}{
+		{
+			name:    "not configured",
+			wantErr: false,
+		},
+		{
+			name:     "valid",
+			schedule: OnSchedule{Cron: "0 3 * * *", Timezone: "Asia/Tokyo"},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid because of wrong cron expression",
+			schedule: OnSchedule{Cron: "0 3 * *"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid because of unknown timezone",
+			schedule: OnSchedule{Cron: "0 3 * * *", Timezone: "Mars/Olympus"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := GenericApplicationSpec{
+				Trigger: Trigger{OnSchedule: tc.schedule},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestOnScheduleSchedule(t *testing.T) {
+	s, err := (&OnSchedule{}).Schedule()
+	require.NoError(t, err)
+	assert.Nil(t, s)
+
+	// The cron expression is evaluated in UTC by default.
+	s, err = (&OnSchedule{Cron: "0 3 * * *"}).Schedule()
+	require.NoError(t, err)
+	now := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2022, 5, 1, 3, 0, 0, 0, time.UTC), s.Next(now).UTC())
+
+	s, err = (&OnSchedule{Cron: "0 3 * * *", Timezone: "Asia/Tokyo"}).Schedule()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 5, 1, 18, 0, 0, 0, time.UTC), s.Next(now).UTC())
+}
+
 func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 	testcases := []struct {
 		fileName           string
@@ -362,6 +675,7 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: KubernetesDeploymentInput{
@@ -391,6 +705,7 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: KubernetesDeploymentInput{
@@ -420,6 +735,7 @@ func TestTrueByDefaultBoolConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 				},
 				Input: KubernetesDeploymentInput{
@@ -471,6 +787,7 @@ func TestGenericPostSyncConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						TriggerOnOutOfSync: newBoolPointer(true),
 					},
 					PostSync: &PostSync{
 						DeploymentChain: &DeploymentChain{
@@ -84,6 +84,38 @@ var (
 	ErrNotFound = errors.New("not found")
 )
 
+var (
+	// The API versions of the configuration supported by this version.
+	supportedAPIVersions = []string{versionV1Beta1}
+	// The supported API versions planned to be removed in a future release
+	// with the message telling how to migrate to the newer one.
+	deprecatedAPIVersions = map[string]string{}
+)
+
+// ValidateAPIVersion checks whether the given API version of the configuration is supported.
+func ValidateAPIVersion(v string) error {
+	supported := strings.Join(supportedAPIVersions, ", ")
+	if v == "" {
+		return fmt.Errorf("apiVersion is required, supported versions are: %s", supported)
+	}
+	for _, s := range supportedAPIVersions {
+		if v == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported apiVersion %q, supported versions are: %s", v, supported)
+}
+
+// APIVersionDeprecation returns the message about the deprecation of the given API version.
+// False is returned when it is not deprecated.
+func APIVersionDeprecation(v string) (string, bool) {
+	msg, ok := deprecatedAPIVersions[v]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("apiVersion %q is deprecated and will be removed in a future release: %s", v, msg), true
+}
+
 // Config represents configuration data load from file.
 // The spec is depend on the kind of configuration.
 type Config struct {
@@ -175,6 +207,11 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 	if err := dec.Decode(&gc); err != nil {
 		return err
 	}
+	// The spec of an unsupported version may not be decoded with the current schema,
+	// so the version is checked first to give the descriptive error.
+	if err := ValidateAPIVersion(gc.APIVersion); err != nil {
+		return err
+	}
 	if err = c.init(gc.Kind, gc.APIVersion); err != nil {
 		return err
 	}
@@ -193,8 +230,8 @@ type validator interface {
 
 // Validate validates the value of all fields.
 func (c *Config) Validate() error {
-	if c.APIVersion != versionV1Beta1 {
-		return fmt.Errorf("unsupported version: %s", c.APIVersion)
+	if err := ValidateAPIVersion(c.APIVersion); err != nil {
+		return err
 	}
 	if c.Kind == "" {
 		return fmt.Errorf("kind is required")
@@ -222,6 +259,63 @@ func LoadFromYAML(file string) (*Config, error) {
 	return DecodeYAML(data)
 }
 
+// LoadFromYAMLWithOverlay reads a base yaml file and merges an overlay yaml file into it
+// before decoding them to construct the Config.
+// The values in the overlay file win against the ones in the base file, the maps are merged recursively
+// while the other values such as the lists are replaced as a whole.
+// The base file is decoded as is when the overlay file does not exist.
+func LoadFromYAMLWithOverlay(file, overlayFile string) (*Config, error) {
+	base, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	overlay, err := os.ReadFile(overlayFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DecodeYAML(base)
+		}
+		return nil, err
+	}
+
+	merged, err := mergeYAML(base, overlay)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeYAML(merged)
+}
+
+// mergeYAML merges the given overlay yaml data into the base one and returns the merged data as JSON.
+func mergeYAML(base, overlay []byte) ([]byte, error) {
+	var b, o map[string]interface{}
+	if err := yaml.Unmarshal(base, &b); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(overlay, &o); err != nil {
+		return nil, fmt.Errorf("invalid overlay: %w", err)
+	}
+	return json.Marshal(mergeMaps(b, o))
+}
+
+func mergeMaps(base, overlay map[string]interface{}) map[string]interface{} {
+	if base == nil {
+		return overlay
+	}
+	for k, ov := range overlay {
+		om, ok := ov.(map[string]interface{})
+		if !ok {
+			base[k] = ov
+			continue
+		}
+		bm, ok := base[k].(map[string]interface{})
+		if !ok {
+			base[k] = ov
+			continue
+		}
+		base[k] = mergeMaps(bm, om)
+	}
+	return base
+}
+
 // DecodeYAML unmarshals config YAML data to config struct.
 // It also validates the configuration after decoding.
 func DecodeYAML(data []byte) (*Config, error) {
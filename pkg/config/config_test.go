@@ -16,9 +16,13 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -67,6 +71,18 @@ func TestUnmarshalConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "config with unsupported apiVersion",
+			data: `{
+  "apiVersion": "pipecd.dev/v1alpha1",
+  "kind": "KubernetesApp",
+  "spec": {
+		"oldField": {}
+  }
+}`,
+			wantSpec: nil,
+			wantErr:  true,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -78,6 +94,109 @@ func TestUnmarshalConfig(t *testing.T) {
 	}
 }
 
+func TestValidateAPIVersion(t *testing.T) {
+	assert.NoError(t, ValidateAPIVersion("pipecd.dev/v1beta1"))
+	assert.EqualError(t, ValidateAPIVersion(""), "apiVersion is required, supported versions are: pipecd.dev/v1beta1")
+	assert.EqualError(t, ValidateAPIVersion("pipecd.dev/v1alpha1"), `unsupported apiVersion "pipecd.dev/v1alpha1", supported versions are: pipecd.dev/v1beta1`)
+}
+
+func TestAPIVersionDeprecation(t *testing.T) {
+	_, ok := APIVersionDeprecation("pipecd.dev/v1beta1")
+	assert.False(t, ok)
+
+	deprecatedAPIVersions["pipecd.dev/v1beta1"] = "use pipecd.dev/v1 instead"
+	defer delete(deprecatedAPIVersions, "pipecd.dev/v1beta1")
+
+	msg, ok := APIVersionDeprecation("pipecd.dev/v1beta1")
+	assert.True(t, ok)
+	assert.Equal(t, `apiVersion "pipecd.dev/v1beta1" is deprecated and will be removed in a future release: use pipecd.dev/v1 instead`, msg)
+}
+
+func TestLoadFromYAMLWithOverlay(t *testing.T) {
+	const base = `apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  name: app
+  labels:
+    team: foo
+    tier: backend
+  trigger:
+    onCommit:
+      paths:
+        - base
+        - app
+`
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "app.pipecd.yaml")
+	require.NoError(t, os.WriteFile(basePath, []byte(base), 0600))
+
+	testcases := []struct {
+		name        string
+		overlay     *string
+		wantLabels  map[string]string
+		wantPaths   []string
+		wantPinned  bool
+		expectedErr bool
+	}{
+		{
+			name:       "missing overlay",
+			wantLabels: map[string]string{"team": "foo", "tier": "backend"},
+			wantPaths:  []string{"base", "app"},
+		},
+		{
+			name: "overlay wins",
+			overlay: newStringPointer(`spec:
+  labels:
+    team: bar
+  trigger:
+    pinned: true
+    onCommit:
+      paths:
+        - app
+`),
+			wantLabels: map[string]string{"team": "bar", "tier": "backend"},
+			wantPaths:  []string{"app"},
+			wantPinned: true,
+		},
+		{
+			name:        "malformed overlay",
+			overlay:     newStringPointer("spec: [invalid"),
+			expectedErr: true,
+		},
+		{
+			name: "overlay changing kind to invalid one",
+			overlay: newStringPointer(`kind: UnknownApp
+`),
+			expectedErr: true,
+		},
+	}
+	for i, tc := range testcases {
+		tc := tc
+		overlayPath := filepath.Join(dir, fmt.Sprintf("app.pipecd.env-%d.yaml", i))
+		if tc.overlay != nil {
+			require.NoError(t, os.WriteFile(overlayPath, []byte(*tc.overlay), 0600))
+		}
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := LoadFromYAMLWithOverlay(basePath, overlayPath)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			spec, ok := cfg.GetGenericApplication()
+			require.True(t, ok)
+			assert.Equal(t, "app", spec.Name)
+			assert.Equal(t, tc.wantLabels, spec.Labels)
+			assert.Equal(t, tc.wantPaths, spec.Trigger.OnCommit.Paths)
+			assert.Equal(t, tc.wantPinned, spec.Trigger.Pinned)
+		})
+	}
+}
+
+func newStringPointer(v string) *string {
+	return &v
+}
+
 func newBoolPointer(v bool) *bool {
 	return &v
 }
@@ -20,7 +20,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -53,6 +56,107 @@ type PipedSpec struct {
 	// How often to check whether an application configuration file should be synced.
 	// Default is 1m.
 	AppConfigSyncInterval Duration `json:"appConfigSyncInterval" default:"1m"`
+	// The maximum fraction of the sync interval used to randomly delay the first sync of each piped
+	// to avoid many pipeds started at the same time from accessing the control-plane together.
+	// Must be between 0 and 1. Zero means no delay.
+	// Default is 0.1.
+	SyncJitter *float64 `json:"syncJitter" default:"0.1"`
+	// How many repositories can be checked concurrently while finding the applications should be triggered.
+	// Default is 1.
+	TriggerConcurrency int `json:"triggerConcurrency" default:"1"`
+	// The maximum number of deployments can be triggered in one check.
+	// The exceeded candidates are deferred to the next check, the ones having a command
+	// and then the ones staying at OUT_OF_SYNC state for the longest time are triggered first.
+	// Empty means no limit.
+	MaxTriggersPerTick int `json:"maxTriggersPerTick"`
+	// Minimum interval between two deployments triggered for the same application
+	// by new commits or configuration drift. The deployments triggered by commands are not affected.
+	// Empty means no cooldown.
+	TriggerCooldown Duration `json:"triggerCooldown"`
+	// Minimum interval between two deployments triggered for the same application by configuration drift.
+	// This is applied separately from triggerCooldown to stop the loop of the flapping drift detection.
+	// Empty means no limit.
+	OutOfSyncTriggerInterval Duration `json:"outOfSyncTriggerInterval"`
+	// Minimum interval between two notifications about the same application
+	// being skipped because its application configuration could not be loaded.
+	// Default is 1h.
+	InvalidConfigNotificationInterval Duration `json:"invalidConfigNotificationInterval" default:"1h"`
+	// List of application configuration file names tried in order by the trigger
+	// when the one registered with the application was not found in its directory,
+	// e.g. ["app.pipecd.yaml", ".pipe.yaml"] while migrating between the naming conventions.
+	// The deployment is triggered with the found file.
+	// Empty means only the registered one is used.
+	AppConfigFallbackFilenames []string `json:"appConfigFallbackFilenames"`
+	// How long the head commit of each Git repository fetched by the trigger is reused
+	// without pulling that repository again.
+	// Empty means the repositories are pulled at every check.
+	HeadCommitCacheTTL Duration `json:"headCommitCacheTTL"`
+	// How long the trigger waits for the Git operations to update each repository,
+	// such as cloning, pulling and getting its head commit, before giving up.
+	// The repository timed out is skipped until the next check while the others are still checked.
+	// Empty means no timeout.
+	GitOperationTimeout Duration `json:"gitOperationTimeout"`
+	// How many consecutive times pulling the same Git repository must fail
+	// before the GIT_REPO_PULL_FAILED notification is sent.
+	// The count is reset once the repository was pulled successfully.
+	// Default is 5.
+	RepoPullFailureNotificationThreshold int `json:"repoPullFailureNotificationThreshold" default:"5"`
+	// The maximum number of applications whose last triggered commit is cached in memory.
+	// This should be greater than the number of applications handled by this piped
+	// to avoid querying them from the control-plane repeatedly.
+	// Default is 500.
+	LastTriggeredCommitCacheSize int `json:"lastTriggeredCommitCacheSize" default:"500"`
+	// The maximum number of applications registered against a single repository.
+	// The repository having more applications than this, e.g. by a misconfiguration,
+	// is not checked at all to avoid triggering a storm of deployments,
+	// and the GIT_REPO_APPLICATION_LIMIT_EXCEEDED notification is sent.
+	// Default is 1000.
+	MaxApplicationsPerRepo int `json:"maxApplicationsPerRepo" default:"1000"`
+	// The path to the local file where the last triggered commit of each application is persisted
+	// to avoid querying them from the control-plane again after restarting.
+	// Empty means the last triggered commits are kept in memory only.
+	LastTriggeredCommitStoreFile string `json:"lastTriggeredCommitStoreFile"`
+	// Optional settings for reporting the commits handled without triggering a deployment
+	// to the control-plane as the last handled commit of the applications,
+	// so that the other pipeds taking them over do not check the same changes again.
+	// Empty means only the commits of the triggered deployments are reported.
+	LastTriggeredCommitReport *PipedLastTriggeredCommitReport `json:"lastTriggeredCommitReport"`
+	// Whether to record the head commit as the last triggered commit of the applications
+	// having no deployment at the first check of each repository for new commits after piped started, instead of triggering them.
+	// This avoids triggering the deployments of all applications at once
+	// when piped started to handle the repositories whose applications were already deployed.
+	SeedNeverDeployedApplications bool `json:"seedNeverDeployedApplications"`
+	// Whether to mark the deployments of the revert commits, detected by their commit message,
+	// to let them be distinguished from the normal deployments in the notifications.
+	DetectRevertCommits bool `json:"detectRevertCommits"`
+	// Whether to reject the SYNC commands for the disabled applications.
+	// The disabled applications are never triggered by new commits or configuration drift
+	// while they can still be synced by commands by default.
+	RejectCommandsForDisabledApplications bool `json:"rejectCommandsForDisabledApplications"`
+	// Whether to only log the deployments should be triggered instead of creating them.
+	// This is useful to verify the trigger configuration before actually deploying.
+	// The SYNC commands are reported as failed since no deployment is created for them.
+	DryRun bool `json:"dryRun"`
+	// List of time windows when the deployments can be triggered by new commits or configuration drift.
+	// The deployments triggered by commands are not restricted.
+	// Empty means the deployments can be triggered at any time.
+	TriggerWindows []PipedTriggerWindow `json:"triggerWindows"`
+	// Whether to keep the commands unhandled while triggering is paused from the control-plane
+	// to let them trigger after it was resumed.
+	// By default, the commands still trigger while paused.
+	QueueCommandsWhilePaused bool `json:"queueCommandsWhilePaused"`
+	// List of trigger rules applied to the applications of specific environments
+	// in addition to the trigger windows above.
+	EnvironmentTriggerRules []PipedEnvironmentTriggerRule `json:"environmentTriggerRules"`
+	// List of the template applications copied to register an application for each Git branch
+	// matching the pattern, e.g. to deploy the preview environment of each feature branch.
+	// The registered application is deleted once its branch was deleted.
+	BranchApplications []PipedBranchApplication `json:"branchApplications"`
+	// How to retry when failed to create a new deployment at the control-plane.
+	DeploymentCreationRetry PipedDeploymentCreationRetry `json:"deploymentCreationRetry"`
+	// How to retry within a check when failed to determine whether an application should be triggered
+	// due to a transient error, e.g. the control-plane or the storage being temporarily unavailable.
+	DeterminationRetry PipedDeterminationRetry `json:"determinationRetry"`
 	// Git configuration needed for git commands.
 	Git PipedGit `json:"git"`
 	// List of git repositories this piped will handle.
@@ -71,6 +175,29 @@ type PipedSpec struct {
 	EventWatcher PipedEventWatcher `json:"eventWatcher"`
 	// List of labels to filter all applications this piped will handle.
 	AppSelector map[string]string `json:"appSelector"`
+	// List of labels to filter the applications can be triggered by new commits or configuration drift.
+	// The deployments triggered by commands are not affected.
+	// Empty means all applications are matched.
+	TriggerSelector map[string]string `json:"triggerSelector"`
+	// Global limit on the number of deployments can be triggered by this piped.
+	// Empty means no limit.
+	DeploymentBudget *PipedDeploymentBudget `json:"deploymentBudget"`
+	// Optional settings for receiving the push events of the Git repositories via webhook
+	// to check them immediately instead of waiting for the next sync.
+	// The repositories are still checked at every sync interval.
+	// Empty means the webhook is disabled.
+	TriggerWebhook *PipedTriggerWebhook `json:"triggerWebhook"`
+	// Optional settings for rolling out the new commits to a part of the applications first.
+	// The rest of the applications are held until the rollout was promoted.
+	// Empty means all applications are triggered at once.
+	FleetRollout *PipedFleetRollout `json:"fleetRollout"`
+	// Glob patterns of the branches which must never trigger the deployments, e.g. "temp/*".
+	// The patterns are matched case-sensitively against the branches of the applications in all repositories.
+	// The applications whose branch matches are skipped without being triggered even by the commands.
+	DeniedBranches []string `json:"deniedBranches"`
+	// Optional settings for exporting the traces of the trigger decisions via OTLP.
+	// Empty means tracing is disabled.
+	Tracing *PipedTracing `json:"tracing"`
 }
 
 // Validate validates configured data of all fields.
@@ -93,6 +220,55 @@ func (s *PipedSpec) Validate() error {
 	if s.SyncInterval < 0 {
 		return errors.New("syncInterval must be greater than or equal to 0")
 	}
+	if s.SyncJitter != nil && (*s.SyncJitter < 0 || *s.SyncJitter > 1) {
+		return errors.New("syncJitter must be between 0 and 1")
+	}
+	if s.TriggerConcurrency < 0 {
+		return errors.New("triggerConcurrency must be greater than or equal to 0")
+	}
+	if s.MaxTriggersPerTick < 0 {
+		return errors.New("maxTriggersPerTick must be greater than or equal to 0")
+	}
+	if s.TriggerCooldown < 0 {
+		return errors.New("triggerCooldown must be greater than or equal to 0")
+	}
+	if s.OutOfSyncTriggerInterval < 0 {
+		return errors.New("outOfSyncTriggerInterval must be greater than or equal to 0")
+	}
+	if s.InvalidConfigNotificationInterval < 0 {
+		return errors.New("invalidConfigNotificationInterval must be greater than or equal to 0")
+	}
+	if s.HeadCommitCacheTTL < 0 {
+		return errors.New("headCommitCacheTTL must be greater than or equal to 0")
+	}
+	if s.GitOperationTimeout < 0 {
+		return errors.New("gitOperationTimeout must be greater than or equal to 0")
+	}
+	if s.RepoPullFailureNotificationThreshold <= 0 {
+		return errors.New("repoPullFailureNotificationThreshold must be greater than 0")
+	}
+	for _, name := range s.AppConfigFallbackFilenames {
+		if strings.ContainsRune(name, '/') || !model.IsApplicationConfigFile(name) {
+			return fmt.Errorf("appConfigFallbackFilenames contains invalid application config file name %q", name)
+		}
+	}
+	if s.LastTriggeredCommitCacheSize <= 0 {
+		return errors.New("lastTriggeredCommitCacheSize must be greater than 0")
+	}
+	if s.MaxApplicationsPerRepo <= 0 {
+		return errors.New("maxApplicationsPerRepo must be greater than 0")
+	}
+	for _, r := range s.Repositories {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := s.validateRepositoryAliases(); err != nil {
+		return err
+	}
+	if err := s.validateRepositorySSHKeys(); err != nil {
+		return err
+	}
 	for _, r := range s.ChartRepositories {
 		if err := r.Validate(); err != nil {
 			return err
@@ -111,6 +287,65 @@ func (s *PipedSpec) Validate() error {
 			return err
 		}
 	}
+	if s.LastTriggeredCommitReport != nil {
+		if err := s.LastTriggeredCommitReport.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.DeploymentBudget != nil {
+		if err := s.DeploymentBudget.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.TriggerWebhook != nil {
+		if err := s.TriggerWebhook.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.FleetRollout != nil {
+		if err := s.FleetRollout.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := validateBranchPatterns(s.DeniedBranches); err != nil {
+		return fmt.Errorf("deniedBranches %w", err)
+	}
+	if s.Tracing != nil {
+		if err := s.Tracing.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := s.DeploymentCreationRetry.Validate(); err != nil {
+		return err
+	}
+	if err := s.DeterminationRetry.Validate(); err != nil {
+		return err
+	}
+	for _, w := range s.TriggerWindows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	envIDs := make(map[string]struct{}, len(s.EnvironmentTriggerRules))
+	for _, r := range s.EnvironmentTriggerRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := envIDs[r.EnvID]; ok {
+			return fmt.Errorf("environmentTriggerRules must not contain duplicated envId: %s", r.EnvID)
+		}
+		envIDs[r.EnvID] = struct{}{}
+	}
+	templateIDs := make(map[string]struct{}, len(s.BranchApplications))
+	for _, b := range s.BranchApplications {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if _, ok := templateIDs[b.TemplateApplicationID]; ok {
+			return fmt.Errorf("branchApplications must not contain duplicated templateApplicationId: %s", b.TemplateApplicationID)
+		}
+		templateIDs[b.TemplateApplicationID] = struct{}{}
+	}
 	return nil
 }
 
@@ -171,6 +406,106 @@ func (s *PipedSpec) GetRepository(id string) (PipedRepository, bool) {
 	return PipedRepository{}, false
 }
 
+// IsDeniedBranch reports whether the given branch of the given repository
+// matches the deniedBranches of the piped or of that repository.
+func (s *PipedSpec) IsDeniedBranch(repoID, branch string) bool {
+	if matchBranchPatterns(s.DeniedBranches, branch) {
+		return true
+	}
+	r, ok := s.GetRepository(repoID)
+	return ok && r.IsDeniedBranch(branch)
+}
+
+// MaxApplications returns the maximum number of applications registered against the given repository.
+// Zero means no limit.
+func (s *PipedSpec) MaxApplications(repoID string) int {
+	if r, ok := s.GetRepository(repoID); ok && r.MaxApplications > 0 {
+		return r.MaxApplications
+	}
+	return s.MaxApplicationsPerRepo
+}
+
+func matchBranchPatterns(patterns []string, branch string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, branch); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveRepository finds a repository referred by the given ID from the configured list.
+// Besides the exact ID, the configured aliases and the IDs differing only in case are also resolved
+// to let the applications registered with a slightly different ID be handled.
+func (s *PipedSpec) ResolveRepository(id string) (PipedRepository, bool) {
+	if repo, ok := s.GetRepository(id); ok {
+		return repo, true
+	}
+	for _, repo := range s.Repositories {
+		for _, a := range repo.Aliases {
+			if a == id {
+				return repo, true
+			}
+		}
+	}
+	id = strings.TrimSpace(id)
+	for _, repo := range s.Repositories {
+		if strings.EqualFold(repo.RepoID, id) {
+			return repo, true
+		}
+		for _, a := range repo.Aliases {
+			if strings.EqualFold(a, id) {
+				return repo, true
+			}
+		}
+	}
+	return PipedRepository{}, false
+}
+
+// validateRepositoryAliases ensures that each alias refers to only one repository.
+func (s *PipedSpec) validateRepositoryAliases() error {
+	ids := make(map[string]string, len(s.Repositories))
+	for _, r := range s.Repositories {
+		ids[r.RepoID] = r.RepoID
+	}
+	for _, r := range s.Repositories {
+		for _, a := range r.Aliases {
+			if owner, ok := ids[a]; ok && owner != r.RepoID {
+				return fmt.Errorf("alias %s of repository %s is already used by repository %s", a, r.RepoID, owner)
+			}
+			ids[a] = r.RepoID
+		}
+	}
+	return nil
+}
+
+// validateRepositorySSHKeys ensures that the repositories sharing the same remote use the same SSH key
+// since the key is bound to the remote while accessing it.
+func (s *PipedSpec) validateRepositorySSHKeys() error {
+	owners := make(map[string]PipedRepository, len(s.Repositories))
+	for _, r := range s.Repositories {
+		owner, ok := owners[r.Remote]
+		if !ok {
+			owners[r.Remote] = r
+			continue
+		}
+		if owner.SSHKeyFile != r.SSHKeyFile {
+			return fmt.Errorf("repositories %s and %s must use the same sshKeyFile since they share the remote %s", owner.RepoID, r.RepoID, r.Remote)
+		}
+	}
+	return nil
+}
+
+// GetEnvironmentTriggerRule finds the trigger rule of the given environment from the configured list.
+func (s *PipedSpec) GetEnvironmentTriggerRule(envID string) (PipedEnvironmentTriggerRule, bool) {
+	for _, r := range s.EnvironmentTriggerRules {
+		if r.EnvID == envID {
+			return r, true
+		}
+	}
+	return PipedEnvironmentTriggerRule{}, false
+}
+
 // GetAnalysisProvider finds and returns an Analysis Provider config whose name is the given string.
 func (s *PipedSpec) GetAnalysisProvider(name string) (PipedAnalysisProvider, bool) {
 	for _, p := range s.AnalysisProviders {
@@ -223,6 +558,13 @@ type PipedGit struct {
 	SSHKeyFile string `json:"sshKeyFile"`
 	// Base64 encoded string of ssh-key.
 	SSHKeyData string `json:"sshKeyData"`
+	// The path to the GnuPG home directory whose keyring contains the GPG keys
+	// trusted to sign the commits of the repositories requiring signed commits.
+	// Empty means the default GnuPG home directory is used.
+	GPGHome string `json:"gpgHome"`
+	// The path to the file listing the SSH keys trusted to sign the commits
+	// of the repositories requiring signed commits, in the format of gpg.ssh.allowedSignersFile of git.
+	SSHAllowedSignersFile string `json:"sshAllowedSignersFile"`
 }
 
 func (g PipedGit) ShouldConfigureSSHConfig() bool {
@@ -251,6 +593,512 @@ type PipedRepository struct {
 	Remote string `json:"remote"`
 	// The branch will be handled.
 	Branch string `json:"branch"`
+	// How often to check whether an application in this repository should be synced.
+	// Empty means the global syncInterval is used.
+	SyncInterval Duration `json:"syncInterval,omitempty"`
+	// Glob pattern of the tags used to trigger the deployments, e.g. "v*".
+	// When this is specified, the applications in this repository are deployed at the newest matching tag
+	// instead of the head commit of the branch. The tags must point to the commits of the branch.
+	// Empty means the head commit of the branch is used.
+	TagPattern string `json:"tagPattern,omitempty"`
+	// Whether to check out only the directories of the applications in this repository
+	// instead of the whole tree to reduce the disk usage of a large repository.
+	// Default is false.
+	SparseCheckout bool `json:"sparseCheckout,omitempty"`
+	// Regular expression of the commit messages which must not trigger the deployments,
+	// e.g. `\[skip-deploy\]` for the commits pushed by CI bots.
+	// When the message of the head commit matches, the commit-based triggering is skipped
+	// while the triggering by command or configuration drift still works.
+	// Empty means all commits can trigger the deployments.
+	SkipCommitMessagePattern string `json:"skipCommitMessagePattern,omitempty"`
+	// Whether to check out the submodules of this repository recursively
+	// to trigger the applications by the changes inside them,
+	// e.g. when the manifests live in a submodule updated by the commits of this repository.
+	// Default is false.
+	Submodules bool `json:"submodules,omitempty"`
+	// The hashes of the commits which must never be deployed, e.g. a bad commit
+	// which may become the head again by history rewrites after being reverted.
+	// The abbreviated hashes having at least 7 characters are allowed.
+	// While the head commit is one of them, no deployment is triggered in this repository.
+	DeniedCommits []string `json:"deniedCommits,omitempty"`
+	// The other IDs the applications may use to refer to this repository,
+	// e.g. the old ID of the repository which was renamed.
+	Aliases []string `json:"aliases,omitempty"`
+	// The number of the latest commits to clone instead of the whole history
+	// to speed up cloning a large repository.
+	// When the last triggered commit of an application is older than them,
+	// the application is triggered by any change since its changes cannot be listed.
+	// Zero means the whole history is cloned.
+	CloneDepth int `json:"cloneDepth,omitempty"`
+	// The email addresses of the commit authors allowed to trigger the deployments automatically,
+	// e.g. the service accounts of CI. The glob patterns such as "*@example.com" are allowed.
+	// While the head commit was authored by the others, the triggering by commit changes
+	// and configuration drifts is suppressed while the explicit commands still trigger.
+	// The commit missing its author email is regarded as not allowed.
+	// Empty means the commits of all authors are allowed.
+	AllowedCommitAuthors []string `json:"allowedCommitAuthors,omitempty"`
+	// Whether to deploy only the commits whose signature was verified
+	// by the keys configured in the git section.
+	// While the signature of the head commit is missing or not verified,
+	// no deployment is triggered in this repository and the GIT_COMMIT_SIGNATURE_UNVERIFIED notification is sent.
+	// Default is false.
+	RequireSignedCommits bool `json:"requireSignedCommits,omitempty"`
+	// The path to the private SSH key file used only to access this repository, e.g. its deploy key.
+	// This takes precedence over the SSH key configured in the git section.
+	// The file is read again when pulling the repository failed to pick up the rotated key.
+	// Empty means the SSH key configured in the git section is used.
+	SSHKeyFile string `json:"sshKeyFile,omitempty"`
+	// Glob patterns of the branches of this repository which must never trigger the deployments, e.g. "temp/*".
+	// These are used in addition to the deniedBranches of the piped.
+	DeniedBranches []string `json:"deniedBranches,omitempty"`
+	// The maximum number of applications registered against this repository.
+	// Zero means the maxApplicationsPerRepo of the piped is used.
+	MaxApplications int `json:"maxApplications,omitempty"`
+	// How long to wait with no new commit in this repository before triggering the deployments,
+	// to avoid deploying mid-way through a burst of related commits.
+	// Once the head commit was left unchanged for this period, its latest state is deployed.
+	// The commands still trigger the deployments immediately.
+	// Empty means the deployments are triggered as soon as the new commits are found.
+	QuietPeriod Duration `json:"quietPeriod,omitempty"`
+	// The command run by /bin/sh to refresh the credentials used to access this repository,
+	// e.g. a script issuing a new GitHub App installation token into the git credential store.
+	// This is run when pulling the repository was rejected by the remote due to its credentials,
+	// and then the pull is attempted again once.
+	// Empty means the pull is given up as is.
+	RefreshCredentialsCommand string `json:"refreshCredentialsCommand,omitempty"`
+}
+
+// minDeniedCommitLength is the minimum length of the abbreviated commit hashes in deniedCommits.
+const minDeniedCommitLength = 7
+
+func (r *PipedRepository) Validate() error {
+	if r.SyncInterval < 0 {
+		return fmt.Errorf("syncInterval of repository %s must be greater than or equal to 0", r.RepoID)
+	}
+	if r.SkipCommitMessagePattern != "" {
+		if _, err := regexp.Compile(r.SkipCommitMessagePattern); err != nil {
+			return fmt.Errorf("skipCommitMessagePattern of repository %s is invalid: %w", r.RepoID, err)
+		}
+	}
+	for _, c := range r.DeniedCommits {
+		if len(c) < minDeniedCommitLength {
+			return fmt.Errorf("deniedCommits of repository %s must have at least %d characters: %q", r.RepoID, minDeniedCommitLength, c)
+		}
+	}
+	for _, a := range r.Aliases {
+		if a == "" {
+			return fmt.Errorf("aliases of repository %s must not contain an empty one", r.RepoID)
+		}
+	}
+	if r.CloneDepth < 0 {
+		return fmt.Errorf("cloneDepth of repository %s must be greater than or equal to 0", r.RepoID)
+	}
+	if r.CloneDepth > 0 && r.SparseCheckout {
+		return fmt.Errorf("cloneDepth of repository %s cannot be used with sparseCheckout", r.RepoID)
+	}
+	for _, a := range r.AllowedCommitAuthors {
+		if a == "" {
+			return fmt.Errorf("allowedCommitAuthors of repository %s must not contain an empty one", r.RepoID)
+		}
+		if _, err := path.Match(a, ""); err != nil {
+			return fmt.Errorf("allowedCommitAuthors of repository %s contains an invalid pattern %q: %w", r.RepoID, a, err)
+		}
+	}
+	if err := validateBranchPatterns(r.DeniedBranches); err != nil {
+		return fmt.Errorf("deniedBranches of repository %s %w", r.RepoID, err)
+	}
+	if r.MaxApplications < 0 {
+		return fmt.Errorf("maxApplications of repository %s must be greater than or equal to 0", r.RepoID)
+	}
+	if r.QuietPeriod < 0 {
+		return fmt.Errorf("quietPeriod of repository %s must be greater than or equal to 0", r.RepoID)
+	}
+	return nil
+}
+
+func validateBranchPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if p == "" {
+			return errors.New("must not contain an empty one")
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("contains an invalid pattern %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// IsDeniedBranch reports whether the given branch of this repository is configured not to be deployed.
+func (r *PipedRepository) IsDeniedBranch(branch string) bool {
+	return matchBranchPatterns(r.DeniedBranches, branch)
+}
+
+// IsDeniedCommit reports whether the given commit is configured not to be deployed.
+func (r *PipedRepository) IsDeniedCommit(hash string) bool {
+	for _, c := range r.DeniedCommits {
+		if strings.HasPrefix(hash, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedCommitAuthor reports whether the commits authored by the given email address
+// are allowed to trigger the deployments automatically.
+func (r *PipedRepository) IsAllowedCommitAuthor(email string) bool {
+	if len(r.AllowedCommitAuthors) == 0 {
+		return true
+	}
+	if email == "" {
+		return false
+	}
+	email = strings.ToLower(email)
+	for _, a := range r.AllowedCommitAuthors {
+		if ok, _ := path.Match(strings.ToLower(a), email); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// PipedLastTriggeredCommitReport represents the asynchronous reports of the handled commits to the control-plane.
+// The reports are sent in batches at every interval instead of at every check
+// to avoid overloading the control-plane when many applications are handled at once.
+type PipedLastTriggeredCommitReport struct {
+	// The interval between the batches of the reports.
+	// Only the latest commit handled within the interval is reported for each application.
+	// Default is 1m.
+	Interval Duration `json:"interval" default:"1m"`
+	// Maximum number of applications reported in a batch.
+	// The rest of them are reported in the subsequent batches.
+	// Default is 50.
+	MaxReportsPerInterval int `json:"maxReportsPerInterval" default:"50"`
+}
+
+func (r *PipedLastTriggeredCommitReport) Validate() error {
+	if r.Interval <= 0 {
+		return errors.New("lastTriggeredCommitReport.interval must be greater than 0")
+	}
+	if r.MaxReportsPerInterval <= 0 {
+		return errors.New("lastTriggeredCommitReport.maxReportsPerInterval must be greater than 0")
+	}
+	return nil
+}
+
+// PipedDeploymentBudget limits the number of deployments can be triggered
+// across all applications within a rolling window.
+// This is used to reduce the blast radius of a mass-trigger event
+// such as a shared base change touching hundreds of applications.
+type PipedDeploymentBudget struct {
+	// Maximum number of deployments can be triggered within the window.
+	// The exceeded candidates are deferred and will be triggered in the subsequent checks.
+	Limit int `json:"limit"`
+	// The length of the rolling window.
+	// Default is 1h.
+	Window Duration `json:"window" default:"1h"`
+}
+
+func (b *PipedDeploymentBudget) Validate() error {
+	if b.Limit <= 0 {
+		return errors.New("deploymentBudget.limit must be greater than 0")
+	}
+	if b.Window <= 0 {
+		return errors.New("deploymentBudget.window must be greater than 0")
+	}
+	return nil
+}
+
+// PipedFleetRollout represents the rollout of the new commits of each repository
+// to a deterministic part of the applications triggered by them first.
+// The other applications are held without being triggered by new commits or configuration drift
+// until the rollout was promoted via the "/trigger/rollout/promote" path of the admin server.
+type PipedFleetRollout struct {
+	// Percentage of the applications triggered first.
+	// The applications are selected by the hash of their ID,
+	// so the same ones are selected for every new commit.
+	Percentage int `json:"percentage"`
+}
+
+func (r *PipedFleetRollout) Validate() error {
+	if r.Percentage <= 0 || r.Percentage > 100 {
+		return errors.New("fleetRollout.percentage must be between 1 and 100")
+	}
+	return nil
+}
+
+// PipedTracing represents the exporter of the traces of piped.
+type PipedTracing struct {
+	// The address of the OpenTelemetry collector receiving the traces via OTLP over gRPC,
+	// e.g. otel-collector:4317.
+	Endpoint string `json:"endpoint"`
+	// Whether to connect to the collector without TLS.
+	// Default is false.
+	Insecure bool `json:"insecure"`
+	// The fraction of the traces started by piped to be sampled, from 0 to 1.
+	// The traces started by the others follow their sampling decisions.
+	// Zero means all traces are sampled.
+	SamplingRatio float64 `json:"samplingRatio"`
+}
+
+func (t *PipedTracing) Validate() error {
+	if t.Endpoint == "" {
+		return errors.New("tracing.endpoint must be set")
+	}
+	if t.SamplingRatio < 0 || t.SamplingRatio > 1 {
+		return errors.New("tracing.samplingRatio must be between 0 and 1")
+	}
+	return nil
+}
+
+// PipedTriggerWebhook represents the webhook receiving the push events of the Git repositories.
+// The events are served at the "/trigger/webhook" path of the admin server.
+type PipedTriggerWebhook struct {
+	// The secret used to verify the received events.
+	// Both the signature of GitHub and the token of GitLab are supported.
+	// Empty means the events are not verified.
+	Secret string `json:"secret"`
+	// The path to the file containing the secret.
+	SecretFile string `json:"secretFile"`
+}
+
+func (w *PipedTriggerWebhook) Validate() error {
+	if w.Secret != "" && w.SecretFile != "" {
+		return errors.New("only either triggerWebhook.secret or triggerWebhook.secretFile can be set")
+	}
+	return nil
+}
+
+// LoadSecret returns the secret used to verify the received events.
+func (w *PipedTriggerWebhook) LoadSecret() (string, error) {
+	if w.SecretFile != "" {
+		val, err := os.ReadFile(w.SecretFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(val), "\n"), nil
+	}
+	return w.Secret, nil
+}
+
+// PipedDeploymentCreationRetry represents the retry policy used while creating a new deployment.
+// Only the transient errors such as Unavailable or DeadlineExceeded are retried.
+type PipedDeploymentCreationRetry struct {
+	// Maximum number of attempts, including the first one.
+	// Default is 3.
+	MaxAttempts int `json:"maxAttempts" default:"3"`
+	// The base interval of the exponential backoff.
+	// Default is 2s.
+	BaseInterval Duration `json:"baseInterval" default:"2s"`
+	// The maximum interval between two attempts.
+	// Default is 30s.
+	MaxInterval Duration `json:"maxInterval" default:"30s"`
+}
+
+func (r *PipedDeploymentCreationRetry) Validate() error {
+	if r.MaxAttempts <= 0 {
+		return errors.New("deploymentCreationRetry.maxAttempts must be greater than 0")
+	}
+	if r.BaseInterval < 0 {
+		return errors.New("deploymentCreationRetry.baseInterval must be greater than or equal to 0")
+	}
+	if r.MaxInterval < r.BaseInterval {
+		return errors.New("deploymentCreationRetry.maxInterval must be greater than or equal to baseInterval")
+	}
+	return nil
+}
+
+// PipedDeterminationRetry represents the retry policy used within a check
+// while determining whether an application should be triggered.
+// Only the transient errors are retried, the configuration errors fail immediately.
+type PipedDeterminationRetry struct {
+	// Maximum number of attempts, including the first one.
+	// Default is 3.
+	MaxAttempts int `json:"maxAttempts" default:"3"`
+	// The base interval of the exponential backoff.
+	// Default is 500ms.
+	BaseInterval Duration `json:"baseInterval" default:"500ms"`
+	// The maximum interval between two attempts.
+	// This should be kept short since the other candidates wait while retrying.
+	// Default is 2s.
+	MaxInterval Duration `json:"maxInterval" default:"2s"`
+}
+
+func (r *PipedDeterminationRetry) Validate() error {
+	if r.MaxAttempts <= 0 {
+		return errors.New("determinationRetry.maxAttempts must be greater than 0")
+	}
+	if r.BaseInterval < 0 {
+		return errors.New("determinationRetry.baseInterval must be greater than or equal to 0")
+	}
+	if r.MaxInterval < r.BaseInterval {
+		return errors.New("determinationRetry.maxInterval must be greater than or equal to baseInterval")
+	}
+	return nil
+}
+
+// PipedEnvironmentTriggerRule restricts when the deployments of the applications
+// belonging to an environment can be triggered.
+type PipedEnvironmentTriggerRule struct {
+	// The ID of the environment this rule is applied to.
+	EnvID string `json:"envId"`
+	// List of time windows when the deployments of the applications in this environment
+	// can be triggered by new commits or configuration drift.
+	TriggerWindows []PipedTriggerWindow `json:"triggerWindows"`
+	// Whether the deployments triggered by commands are allowed outside of the trigger windows.
+	AllowCommands bool `json:"allowCommands"`
+}
+
+func (r *PipedEnvironmentTriggerRule) Validate() error {
+	if r.EnvID == "" {
+		return errors.New("envId of environment trigger rule must be set")
+	}
+	if len(r.TriggerWindows) == 0 {
+		return fmt.Errorf("environment trigger rule of %s must have at least one trigger window", r.EnvID)
+	}
+	for _, w := range r.TriggerWindows {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid environment trigger rule of %s: %w", r.EnvID, err)
+		}
+	}
+	return nil
+}
+
+// IsOpen reports whether a deployment can be triggered at the given time.
+// byCommand should be true when the deployment is requested by a command.
+func (r *PipedEnvironmentTriggerRule) IsOpen(t time.Time, byCommand bool) bool {
+	if byCommand && r.AllowCommands {
+		return true
+	}
+	for _, w := range r.TriggerWindows {
+		if w.Includes(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// PipedBranchApplication configures the applications registered for the Git branches
+// by copying a template application.
+type PipedBranchApplication struct {
+	// The ID of the application used as the template.
+	// Its repository, directory, kind and cloud provider are copied to the registered applications
+	// while each of them is deployed with the application configuration in its own branch.
+	TemplateApplicationID string `json:"templateApplicationId"`
+	// The regular expression of the names of the branches to register the applications for.
+	BranchPattern string `json:"branchPattern"`
+}
+
+func (b *PipedBranchApplication) Validate() error {
+	if b.TemplateApplicationID == "" {
+		return errors.New("templateApplicationId of branch application must be set")
+	}
+	if b.BranchPattern == "" {
+		return fmt.Errorf("branchPattern of branch application %s must be set", b.TemplateApplicationID)
+	}
+	if _, err := regexp.Compile(b.BranchPattern); err != nil {
+		return fmt.Errorf("invalid branchPattern of branch application %s: %w", b.TemplateApplicationID, err)
+	}
+	return nil
+}
+
+// PipedTriggerWindow represents a daily time range when the deployments can be triggered.
+// The window crosses midnight when its end is before its start, e.g. 22:00-06:00,
+// and in that case the days are applied to the day the window starts.
+type PipedTriggerWindow struct {
+	// List of days of the week when this window is open, e.g. Mon, Tuesday.
+	// Empty means every day.
+	Days []string `json:"days"`
+	// The time this window opens in HH:MM format.
+	Start string `json:"start"`
+	// The time this window closes in HH:MM format.
+	End string `json:"end"`
+	// The IANA name of the timezone of start and end, e.g. Asia/Tokyo.
+	// Default is UTC.
+	Timezone string `json:"timezone" default:"UTC"`
+}
+
+func (w *PipedTriggerWindow) Validate() error {
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start of trigger window: %w", err)
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return fmt.Errorf("invalid end of trigger window: %w", err)
+	}
+	if start == end {
+		return errors.New("start and end of trigger window must be different")
+	}
+	for _, d := range w.Days {
+		if _, ok := parseWeekday(d); !ok {
+			return fmt.Errorf("invalid day of trigger window: %s", d)
+		}
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone of trigger window: %w", err)
+	}
+	return nil
+}
+
+// Includes reports whether the given time is inside this window.
+func (w *PipedTriggerWindow) Includes(t time.Time) bool {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return false
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return false
+	}
+
+	t = t.In(loc)
+	now := t.Hour()*60 + t.Minute()
+	switch {
+	case start < end:
+		return start <= now && now < end && w.includesDay(t.Weekday())
+	case now >= start:
+		return w.includesDay(t.Weekday())
+	case now < end:
+		// This is the part after midnight of the window started yesterday.
+		return w.includesDay((t.Weekday() + 6) % 7)
+	default:
+		return false
+	}
+}
+
+func (w *PipedTriggerWindow) includesDay(d time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, day := range w.Days {
+		if wd, ok := parseWeekday(day); ok && wd == d {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock parses the given HH:MM string and returns the number of minutes since midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%q must be in HH:MM format", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := d.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return d, true
+		}
+	}
+	return 0, false
 }
 
 type HelmChartRepositoryType string
@@ -582,6 +1430,17 @@ type Notifications struct {
 	Routes []NotificationRoute `json:"routes"`
 	// List of notification receivers.
 	Receivers []NotificationReceiver `json:"receivers"`
+	// Configuration for notifying the deployments triggered by the same commit
+	// at once as a DEPLOYMENTS_TRIGGERED event instead of one DEPLOYMENT_TRIGGERED event per deployment.
+	// Empty means they are notified individually.
+	DeploymentTriggeredBatch *NotificationDeploymentTriggeredBatch `json:"deploymentTriggeredBatch,omitempty"`
+}
+
+type NotificationDeploymentTriggeredBatch struct {
+	// The key of the application label used to group the deployments triggered by the same commit.
+	// The deployments having no such label are grouped together.
+	// Empty means they are grouped only by the commit.
+	GroupByLabel string `json:"groupByLabel"`
 }
 
 type NotificationRoute struct {
@@ -37,13 +37,19 @@ func TestPipedConfig(t *testing.T) {
 			expectedKind:       KindPiped,
 			expectedAPIVersion: "pipecd.dev/v1beta1",
 			expectedSpec: &PipedSpec{
-				ProjectID:             "test-project",
-				PipedID:               "test-piped",
-				PipedKeyFile:          "etc/piped/key",
-				APIAddress:            "your-pipecd.domain",
-				WebAddress:            "https://your-pipecd.domain",
-				SyncInterval:          Duration(time.Minute),
-				AppConfigSyncInterval: Duration(time.Minute),
+				ProjectID:                            "test-project",
+				PipedID:                              "test-piped",
+				PipedKeyFile:                         "etc/piped/key",
+				APIAddress:                           "your-pipecd.domain",
+				WebAddress:                           "https://your-pipecd.domain",
+				SyncInterval:                         Duration(time.Minute),
+				AppConfigSyncInterval:                Duration(time.Minute),
+				SyncJitter:                           floatPointer(0.1),
+				TriggerConcurrency:                   1,
+				LastTriggeredCommitCacheSize:         500,
+				MaxApplicationsPerRepo:               1000,
+				InvalidConfigNotificationInterval:    Duration(time.Hour),
+				RepoPullFailureNotificationThreshold: 5,
 				Git: PipedGit{
 					Username:   "username",
 					Email:      "username@email.com",
@@ -56,9 +62,10 @@ func TestPipedConfig(t *testing.T) {
 						Branch: "master",
 					},
 					{
-						RepoID: "repo2",
-						Remote: "git@github.com:org/repo2.git",
-						Branch: "master",
+						RepoID:       "repo2",
+						Remote:       "git@github.com:org/repo2.git",
+						Branch:       "master",
+						SyncInterval: Duration(5 * time.Minute),
 					},
 				},
 				ChartRepositories: []HelmChartRepository{
@@ -220,6 +227,24 @@ func TestPipedConfig(t *testing.T) {
 						},
 					},
 				},
+				DeploymentBudget: &PipedDeploymentBudget{
+					Limit:  20,
+					Window: Duration(time.Hour),
+				},
+				LastTriggeredCommitReport: &PipedLastTriggeredCommitReport{
+					Interval:              Duration(30 * time.Second),
+					MaxReportsPerInterval: 50,
+				},
+				DeploymentCreationRetry: PipedDeploymentCreationRetry{
+					MaxAttempts:  3,
+					BaseInterval: Duration(2 * time.Second),
+					MaxInterval:  Duration(30 * time.Second),
+				},
+				DeterminationRetry: PipedDeterminationRetry{
+					MaxAttempts:  3,
+					BaseInterval: Duration(500 * time.Millisecond),
+					MaxInterval:  Duration(2 * time.Second),
+				},
 			},
 			expectedError: nil,
 		},
@@ -364,3 +389,498 @@ func TestNotificationReceiverWebhook_LoadSignatureValue(t *testing.T) {
 		})
 	}
 }
+
+func TestPipedTriggerWebhook(t *testing.T) {
+	testcase := []struct {
+		name        string
+		webhook     *PipedTriggerWebhook
+		want        string
+		wantInvalid bool
+	}{
+		{
+			name:    "no secret",
+			webhook: &PipedTriggerWebhook{},
+			want:    "",
+		},
+		{
+			name: "set secret",
+			webhook: &PipedTriggerWebhook{
+				Secret: "foo",
+			},
+			want: "foo",
+		},
+		{
+			name: "set secretFile",
+			webhook: &PipedTriggerWebhook{
+				SecretFile: "testdata/piped/notification-receiver-webhook",
+			},
+			want: "foo",
+		},
+		{
+			name: "set both of them",
+			webhook: &PipedTriggerWebhook{
+				Secret:     "foo",
+				SecretFile: "testdata/piped/notification-receiver-webhook",
+			},
+			wantInvalid: true,
+		},
+	}
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.webhook.Validate()
+			assert.Equal(t, tc.wantInvalid, err != nil)
+			if err != nil {
+				return
+			}
+			got, err := tc.webhook.LoadSecret()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPipedTriggerWindow(t *testing.T) {
+	// 2021-08-02 is Monday.
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2021, 8, day, hour, min, 0, 0, time.UTC)
+	}
+
+	testcases := []struct {
+		name     string
+		window   PipedTriggerWindow
+		time     time.Time
+		expected bool
+	}{
+		{
+			name:     "inside business hours",
+			window:   PipedTriggerWindow{Days: []string{"Mon", "tuesday"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+			time:     at(2, 9, 0),
+			expected: true,
+		},
+		{
+			name:     "at the end of business hours",
+			window:   PipedTriggerWindow{Days: []string{"Mon", "tuesday"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+			time:     at(2, 18, 0),
+			expected: false,
+		},
+		{
+			name:     "not in the specified days",
+			window:   PipedTriggerWindow{Days: []string{"Mon", "tuesday"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+			time:     at(4, 10, 0),
+			expected: false,
+		},
+		{
+			name:     "in another timezone",
+			window:   PipedTriggerWindow{Start: "09:00", End: "18:00", Timezone: "Asia/Tokyo"},
+			time:     at(2, 1, 0),
+			expected: true,
+		},
+		{
+			name:     "after midnight of the window started on the specified day",
+			window:   PipedTriggerWindow{Days: []string{"Mon"}, Start: "22:00", End: "06:00", Timezone: "UTC"},
+			time:     at(3, 5, 59),
+			expected: true,
+		},
+		{
+			name:     "after midnight of the window started on another day",
+			window:   PipedTriggerWindow{Days: []string{"Mon"}, Start: "22:00", End: "06:00", Timezone: "UTC"},
+			time:     at(2, 5, 0),
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.window.Validate())
+			assert.Equal(t, tc.expected, tc.window.Includes(tc.time))
+		})
+	}
+}
+
+func TestPipedTriggerWindowValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		window  PipedTriggerWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			window: PipedTriggerWindow{Days: []string{"Sun"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+		},
+		{
+			name:    "invalid start",
+			window:  PipedTriggerWindow{Start: "9am", End: "18:00", Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "same start and end",
+			window:  PipedTriggerWindow{Start: "09:00", End: "09:00", Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid day",
+			window:  PipedTriggerWindow{Days: []string{"Funday"}, Start: "09:00", End: "18:00", Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			window:  PipedTriggerWindow{Start: "09:00", End: "18:00", Timezone: "Mars/Base"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.window.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedEnvironmentTriggerRuleValidate(t *testing.T) {
+	window := PipedTriggerWindow{Start: "09:00", End: "18:00", Timezone: "UTC"}
+	testcases := []struct {
+		name    string
+		rule    PipedEnvironmentTriggerRule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: PipedEnvironmentTriggerRule{EnvID: "prod", TriggerWindows: []PipedTriggerWindow{window}},
+		},
+		{
+			name:    "missing envId",
+			rule:    PipedEnvironmentTriggerRule{TriggerWindows: []PipedTriggerWindow{window}},
+			wantErr: true,
+		},
+		{
+			name:    "no trigger window",
+			rule:    PipedEnvironmentTriggerRule{EnvID: "prod"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid trigger window",
+			rule:    PipedEnvironmentTriggerRule{EnvID: "prod", TriggerWindows: []PipedTriggerWindow{{Start: "9am", End: "18:00", Timezone: "UTC"}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedBranchApplicationValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		app     PipedBranchApplication
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			app:  PipedBranchApplication{TemplateApplicationID: "app", BranchPattern: "^feature/.+$"},
+		},
+		{
+			name:    "missing templateApplicationId",
+			app:     PipedBranchApplication{BranchPattern: "^feature/.+$"},
+			wantErr: true,
+		},
+		{
+			name:    "missing branchPattern",
+			app:     PipedBranchApplication{TemplateApplicationID: "app"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid branchPattern",
+			app:     PipedBranchApplication{TemplateApplicationID: "app", BranchPattern: "feature/(.+"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.app.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedFleetRolloutValidate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		percentage int
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			percentage: 10,
+		},
+		{
+			name:       "all applications",
+			percentage: 100,
+		},
+		{
+			name:       "zero",
+			percentage: 0,
+			wantErr:    true,
+		},
+		{
+			name:       "over 100",
+			percentage: 101,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := PipedFleetRollout{Percentage: tc.percentage}
+			err := r.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedLastTriggeredCommitReportValidate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		interval   Duration
+		maxReports int
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			interval:   Duration(time.Minute),
+			maxReports: 50,
+		},
+		{
+			name:       "zero interval",
+			maxReports: 50,
+			wantErr:    true,
+		},
+		{
+			name:     "zero max reports",
+			interval: Duration(time.Minute),
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := PipedLastTriggeredCommitReport{Interval: tc.interval, MaxReportsPerInterval: tc.maxReports}
+			err := r.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedTracingValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		tracing PipedTracing
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			tracing: PipedTracing{Endpoint: "otel-collector:4317", SamplingRatio: 0.1},
+		},
+		{
+			name:    "missing endpoint",
+			tracing: PipedTracing{},
+			wantErr: true,
+		},
+		{
+			name:    "too large sampling ratio",
+			tracing: PipedTracing{Endpoint: "otel-collector:4317", SamplingRatio: 1.5},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.tracing.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedRepositoryValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		repo    PipedRepository
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			repo: PipedRepository{RepoID: "repo", SkipCommitMessagePattern: `\[skip-deploy\]`, DeniedCommits: []string{"abc1234"}, CloneDepth: 10, AllowedCommitAuthors: []string{"*@example.com"}},
+		},
+		{
+			name:    "negative sync interval",
+			repo:    PipedRepository{RepoID: "repo", SyncInterval: Duration(-time.Minute)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid skip commit message pattern",
+			repo:    PipedRepository{RepoID: "repo", SkipCommitMessagePattern: `[skip-deploy`},
+			wantErr: true,
+		},
+		{
+			name:    "too short denied commit",
+			repo:    PipedRepository{RepoID: "repo", DeniedCommits: []string{"abc123"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty alias",
+			repo:    PipedRepository{RepoID: "repo", Aliases: []string{""}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid denied branch pattern",
+			repo:    PipedRepository{RepoID: "repo", DeniedBranches: []string{"temp/["}},
+			wantErr: true,
+		},
+		{
+			name:    "negative clone depth",
+			repo:    PipedRepository{RepoID: "repo", CloneDepth: -1},
+			wantErr: true,
+		},
+		{
+			name:    "empty allowed commit author",
+			repo:    PipedRepository{RepoID: "repo", AllowedCommitAuthors: []string{""}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid allowed commit author pattern",
+			repo:    PipedRepository{RepoID: "repo", AllowedCommitAuthors: []string{"[bot@example.com"}},
+			wantErr: true,
+		},
+		{
+			name:    "clone depth with sparse checkout",
+			repo:    PipedRepository{RepoID: "repo", CloneDepth: 10, SparseCheckout: true},
+			wantErr: true,
+		},
+		{
+			name:    "negative max applications",
+			repo:    PipedRepository{RepoID: "repo", MaxApplications: -1},
+			wantErr: true,
+		},
+		{
+			name:    "negative quiet period",
+			repo:    PipedRepository{RepoID: "repo", QuietPeriod: Duration(-time.Minute)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.repo.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedSpecResolveRepository(t *testing.T) {
+	s := PipedSpec{
+		Repositories: []PipedRepository{
+			{RepoID: "repo-1", Aliases: []string{"old-repo-1"}},
+			{RepoID: "Repo-2"},
+		},
+	}
+
+	testcases := []struct {
+		id       string
+		expected string
+	}{
+		{id: "repo-1", expected: "repo-1"},
+		{id: "old-repo-1", expected: "repo-1"},
+		{id: "Old-Repo-1", expected: "repo-1"},
+		{id: "repo-2", expected: "Repo-2"},
+		{id: " Repo-2 ", expected: "Repo-2"},
+		{id: "repo-3"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.id, func(t *testing.T) {
+			repo, ok := s.ResolveRepository(tc.id)
+			assert.Equal(t, tc.expected != "", ok)
+			assert.Equal(t, tc.expected, repo.RepoID)
+		})
+	}
+}
+
+func TestPipedSpecValidateRepositoryAliases(t *testing.T) {
+	s := PipedSpec{
+		Repositories: []PipedRepository{
+			{RepoID: "repo-1", Aliases: []string{"old-repo"}},
+			{RepoID: "repo-2"},
+		},
+	}
+	assert.NoError(t, s.validateRepositoryAliases())
+
+	s.Repositories[1].Aliases = []string{"old-repo"}
+	assert.EqualError(t, s.validateRepositoryAliases(), "alias old-repo of repository repo-2 is already used by repository repo-1")
+
+	s.Repositories[1].Aliases = []string{"repo-1"}
+	assert.EqualError(t, s.validateRepositoryAliases(), "alias repo-1 of repository repo-2 is already used by repository repo-1")
+}
+
+func TestPipedSpecValidateRepositorySSHKeys(t *testing.T) {
+	s := PipedSpec{
+		Repositories: []PipedRepository{
+			{RepoID: "repo-1", Remote: "git@github.com:org/repo-1.git", SSHKeyFile: "/etc/piped-secret/repo-1-key"},
+			{RepoID: "repo-1-release", Remote: "git@github.com:org/repo-1.git", Branch: "release", SSHKeyFile: "/etc/piped-secret/repo-1-key"},
+			{RepoID: "repo-2", Remote: "git@github.com:org/repo-2.git"},
+		},
+	}
+	assert.NoError(t, s.validateRepositorySSHKeys())
+
+	s.Repositories[1].SSHKeyFile = "/etc/piped-secret/another-key"
+	assert.EqualError(t, s.validateRepositorySSHKeys(), "repositories repo-1 and repo-1-release must use the same sshKeyFile since they share the remote git@github.com:org/repo-1.git")
+}
+
+func TestPipedRepositoryIsDeniedCommit(t *testing.T) {
+	r := PipedRepository{DeniedCommits: []string{"abc1234", "0123456789abcdef0123456789abcdef01234567"}}
+
+	assert.True(t, r.IsDeniedCommit("abc1234def5678abc1234def5678abc1234def56"))
+	assert.True(t, r.IsDeniedCommit("0123456789abcdef0123456789abcdef01234567"))
+	assert.False(t, r.IsDeniedCommit("def5678abc1234def5678abc1234def5678abc12"))
+	assert.False(t, (&PipedRepository{}).IsDeniedCommit("abc1234def5678abc1234def5678abc1234def56"))
+}
+
+func TestPipedSpecIsDeniedBranch(t *testing.T) {
+	s := PipedSpec{
+		DeniedBranches: []string{"temp/*"},
+		Repositories: []PipedRepository{
+			{RepoID: "repo-1", DeniedBranches: []string{"scratch-*"}},
+			{RepoID: "repo-2"},
+		},
+	}
+
+	assert.True(t, s.IsDeniedBranch("repo-1", "temp/foo"))
+	assert.True(t, s.IsDeniedBranch("repo-2", "temp/foo"))
+	assert.True(t, s.IsDeniedBranch("repo-1", "scratch-foo"))
+	assert.False(t, s.IsDeniedBranch("repo-2", "scratch-foo"))
+	// The patterns are matched case-sensitively.
+	assert.False(t, s.IsDeniedBranch("repo-1", "Temp/foo"))
+	assert.False(t, s.IsDeniedBranch("repo-1", "main"))
+	assert.False(t, s.IsDeniedBranch("unknown", "main"))
+}
+
+func TestPipedSpecMaxApplications(t *testing.T) {
+	s := PipedSpec{
+		MaxApplicationsPerRepo: 1000,
+		Repositories: []PipedRepository{
+			{RepoID: "repo-1", MaxApplications: 3000},
+			{RepoID: "repo-2"},
+		},
+	}
+
+	assert.Equal(t, 3000, s.MaxApplications("repo-1"))
+	assert.Equal(t, 1000, s.MaxApplications("repo-2"))
+	assert.Equal(t, 1000, s.MaxApplications("unknown"))
+}
+
+func TestPipedRepositoryIsAllowedCommitAuthor(t *testing.T) {
+	r := PipedRepository{AllowedCommitAuthors: []string{"ci-bot@example.com", "*@trusted.example.com"}}
+
+	assert.True(t, r.IsAllowedCommitAuthor("ci-bot@example.com"))
+	assert.True(t, r.IsAllowedCommitAuthor("CI-Bot@Example.com"))
+	assert.True(t, r.IsAllowedCommitAuthor("foo@trusted.example.com"))
+	assert.False(t, r.IsAllowedCommitAuthor("foo@example.com"))
+	assert.False(t, r.IsAllowedCommitAuthor(""))
+	assert.True(t, (&PipedRepository{}).IsAllowedCommitAuthor("foo@example.com"))
+	assert.True(t, (&PipedRepository{}).IsAllowedCommitAuthor(""))
+}
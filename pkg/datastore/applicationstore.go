@@ -172,6 +172,8 @@ func (a *applicationCollection) Encode(e interface{}) (map[Shard][]byte, error)
 		Deploying:                        me.Deploying,
 		MostRecentlySuccessfulDeployment: me.MostRecentlySuccessfulDeployment,
 		MostRecentlyTriggeredDeployment:  me.MostRecentlyTriggeredDeployment,
+		LastHandledCommit:                me.LastHandledCommit,
+		LastHandledCommitAt:              me.LastHandledCommitAt,
 	}
 	adata, err := json.Marshal(&agentShardStruct)
 	if err != nil {
@@ -195,6 +197,7 @@ type ApplicationStore interface {
 	UpdateMostRecentDeployment(ctx context.Context, id string, status model.DeploymentStatus, deployment *model.ApplicationDeploymentReference) error
 	UpdateConfigFilename(ctx context.Context, id, configFilename string) error
 	UpdateDeployingStatus(ctx context.Context, id string, deploying bool) error
+	UpdateLastHandledCommit(ctx context.Context, id, commit string, handledAt int64) error
 	UpdateBasicInfo(ctx context.Context, id, name, description string, labels map[string]string) error
 	UpdateConfiguration(ctx context.Context, id, pipedID, cloudProvider, configFilename string) error
 }
@@ -351,6 +354,19 @@ func (s *applicationStore) UpdateDeployingStatus(ctx context.Context, id string,
 	})
 }
 
+// UpdateLastHandledCommit updates the commit handled most recently by piped.
+// Nothing is changed when the saved one was handled later than the given one.
+func (s *applicationStore) UpdateLastHandledCommit(ctx context.Context, id, commit string, handledAt int64) error {
+	return s.update(ctx, id, func(app *model.Application) error {
+		if handledAt < app.LastHandledCommitAt {
+			return nil
+		}
+		app.LastHandledCommit = commit
+		app.LastHandledCommitAt = handledAt
+		return nil
+	})
+}
+
 func (s *applicationStore) UpdateBasicInfo(ctx context.Context, id, name, description string, labels map[string]string) error {
 	return s.update(ctx, id, func(app *model.Application) error {
 		app.Name = name
@@ -123,6 +123,34 @@ func (mr *MockProjectStoreMockRecorder) MigrateFromProjectRBACConfig(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateFromProjectRBACConfig", reflect.TypeOf((*MockProjectStore)(nil).MigrateFromProjectRBACConfig), arg0, arg1)
 }
 
+// PauseTriggering mocks base method.
+func (m *MockProjectStore) PauseTriggering(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTriggering", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTriggering indicates an expected call of PauseTriggering.
+func (mr *MockProjectStoreMockRecorder) PauseTriggering(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTriggering", reflect.TypeOf((*MockProjectStore)(nil).PauseTriggering), arg0, arg1)
+}
+
+// ResumeTriggering mocks base method.
+func (m *MockProjectStore) ResumeTriggering(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTriggering", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTriggering indicates an expected call of ResumeTriggering.
+func (mr *MockProjectStoreMockRecorder) ResumeTriggering(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTriggering", reflect.TypeOf((*MockProjectStore)(nil).ResumeTriggering), arg0, arg1)
+}
+
 // UpdateProjectRBACConfig mocks base method.
 func (m *MockProjectStore) UpdateProjectRBACConfig(arg0 context.Context, arg1 string, arg2 *model.ProjectRBACConfig) error {
 	m.ctrl.T.Helper()
@@ -524,6 +552,20 @@ func (mr *MockApplicationStoreMockRecorder) UpdateDeployingStatus(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeployingStatus", reflect.TypeOf((*MockApplicationStore)(nil).UpdateDeployingStatus), arg0, arg1, arg2)
 }
 
+// UpdateLastHandledCommit mocks base method.
+func (m *MockApplicationStore) UpdateLastHandledCommit(arg0 context.Context, arg1, arg2 string, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastHandledCommit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastHandledCommit indicates an expected call of UpdateLastHandledCommit.
+func (mr *MockApplicationStoreMockRecorder) UpdateLastHandledCommit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastHandledCommit", reflect.TypeOf((*MockApplicationStore)(nil).UpdateLastHandledCommit), arg0, arg1, arg2, arg3)
+}
+
 // UpdateMostRecentDeployment mocks base method.
 func (m *MockApplicationStore) UpdateMostRecentDeployment(arg0 context.Context, arg1 string, arg2 model.DeploymentStatus, arg3 *model.ApplicationDeploymentReference) error {
 	m.ctrl.T.Helper()
@@ -76,6 +76,8 @@ type ProjectStore interface {
 	UpdateProjectStaticAdmin(ctx context.Context, id, username, password string) error
 	EnableStaticAdmin(ctx context.Context, id string) error
 	DisableStaticAdmin(ctx context.Context, id string) error
+	PauseTriggering(ctx context.Context, id string) error
+	ResumeTriggering(ctx context.Context, id string) error
 	UpdateProjectSSOConfig(ctx context.Context, id string, sso *model.ProjectSSOConfig) error
 	UpdateProjectRBACConfig(ctx context.Context, id string, rbac *model.ProjectRBACConfig) error
 	UpdateProjectRBACRoles(ctx context.Context, id string, roles []*model.ProjectRBACRole) error
@@ -180,6 +182,22 @@ func (s *projectStore) DisableStaticAdmin(ctx context.Context, id string) error
 	})
 }
 
+// PauseTriggering pauses triggering new deployments in all pipeds of the project.
+func (s *projectStore) PauseTriggering(ctx context.Context, id string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		p.TriggerPaused = true
+		return nil
+	})
+}
+
+// ResumeTriggering resumes triggering new deployments in all pipeds of the project.
+func (s *projectStore) ResumeTriggering(ctx context.Context, id string) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		p.TriggerPaused = false
+		return nil
+	})
+}
+
 // UpdateProjectSSOConfig updates project single sign on settings.
 func (s *projectStore) UpdateProjectSSOConfig(ctx context.Context, id string, sso *model.ProjectSSOConfig) error {
 	return s.update(ctx, id, func(p *model.Project) error {
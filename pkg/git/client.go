@@ -20,6 +20,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +32,10 @@ import (
 const (
 	defaultUsername = "piped"
 	defaultEmail    = "pipecd.dev@gmail.com"
+
+	// shallowCacheSuffix is added to the cache path of the shallow clones
+	// to not truncate the history of the full clones sharing the same repository.
+	shallowCacheSuffix = "@shallow"
 )
 
 // Client is a git client for cloning/fetching git repo.
@@ -37,6 +43,14 @@ const (
 type Client interface {
 	// Clone clones a specific git repository to the given destination.
 	Clone(ctx context.Context, repoID, remote, branch, destination string) (Repo, error)
+	// SparseClone clones a specific git repository to the given destination
+	// while checking out only the files inside the given directories.
+	SparseClone(ctx context.Context, repoID, remote, branch, destination string, dirs []string) (Repo, error)
+	// ShallowClone clones a specific git repository to the given destination
+	// while fetching only the given number of the latest commits.
+	ShallowClone(ctx context.Context, repoID, remote, branch, destination string, depth int) (Repo, error)
+	// ListRemoteBranches returns the names of all branches in the given remote repository.
+	ListRemoteBranches(ctx context.Context, remote string) ([]string, error)
 	// Clean removes all cache data.
 	Clean() error
 }
@@ -51,7 +65,11 @@ type client struct {
 
 	gitEnvs       []string
 	gitEnvsByRepo map[string][]string
-	logger        *zap.Logger
+	// The source files of the SSH keys used only for the specific remotes.
+	sshKeyFiles map[string]string
+	sshKeyDir   string
+	sshKeys     map[string]*sshKey
+	logger      *zap.Logger
 }
 
 type Option func(*client)
@@ -68,6 +86,34 @@ func WithGitEnvForRepo(remote string, env string) Option {
 	}
 }
 
+// WithSSHKeyForRepo configures the SSH key used only to access the given remote, e.g. a deploy key of it,
+// instead of the ones configured in the ssh config.
+// The key file is read again when the commands accessing the remote failed to pick up the rotated key.
+func WithSSHKeyForRepo(remote, keyFile string) Option {
+	return func(c *client) {
+		c.sshKeyFiles[remote] = keyFile
+	}
+}
+
+// WithSignatureVerificationKeys configures the keys used to verify the signatures of the commits.
+// The GPG keys are read from the keyring of the given GnuPG home directory
+// while the SSH keys are read from the given file in the format of gpg.ssh.allowedSignersFile.
+// Empty means the default one of each kind is used.
+func WithSignatureVerificationKeys(gpgHome, sshAllowedSignersFile string) Option {
+	return func(c *client) {
+		if gpgHome != "" {
+			c.gitEnvs = append(c.gitEnvs, "GNUPGHOME="+gpgHome)
+		}
+		if sshAllowedSignersFile != "" {
+			c.gitEnvs = append(c.gitEnvs,
+				"GIT_CONFIG_COUNT=1",
+				"GIT_CONFIG_KEY_0=gpg.ssh.allowedSignersFile",
+				"GIT_CONFIG_VALUE_0="+sshAllowedSignersFile,
+			)
+		}
+	}
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return func(c *client) {
 		c.logger = logger
@@ -110,6 +156,8 @@ func NewClient(opts ...Option) (Client, error) {
 		cacheDir:      cacheDir,
 		repoLocks:     make(map[string]*sync.Mutex),
 		gitEnvsByRepo: make(map[string][]string, 0),
+		sshKeyFiles:   make(map[string]string),
+		sshKeys:       make(map[string]*sshKey),
 		logger:        zap.NewNop(),
 	}
 
@@ -117,13 +165,77 @@ func NewClient(opts ...Option) (Client, error) {
 		opt(c)
 	}
 
+	if len(c.sshKeyFiles) > 0 {
+		c.sshKeyDir, err = os.MkdirTemp("", "gitssh")
+		if err != nil {
+			return nil, fmt.Errorf("unable to create a temporary directory for ssh keys: %v", err)
+		}
+		for remote, file := range c.sshKeyFiles {
+			k, err := newSSHKey(file, c.sshKeyDir)
+			if err != nil {
+				c.Clean()
+				return nil, fmt.Errorf("unable to load ssh key for %s: %v", remote, err)
+			}
+			c.sshKeys[remote] = k
+		}
+	}
+
 	return c, nil
 }
 
 // Clone clones a specific git repository to the given destination.
 func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination string) (Repo, error) {
+	return c.clone(ctx, repoID, remote, branch, destination, false, 0)
+}
+
+// SparseClone clones a specific git repository to the given destination
+// while checking out only the files inside the given directories.
+func (c *client) SparseClone(ctx context.Context, repoID, remote, branch, destination string, dirs []string) (Repo, error) {
+	r, err := c.clone(ctx, repoID, remote, branch, destination, true, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.SetSparseCheckout(ctx, dirs); err != nil {
+		return nil, fmt.Errorf("failed to set sparse checkout: %v", err)
+	}
+	if err := r.Checkout(ctx, "HEAD"); err != nil {
+		return nil, fmt.Errorf("failed to checkout: %v", err)
+	}
+
+	// The size of the checked out files is compared with the whole tree
+	// to let the users know how much is saved by the sparse checkout.
+	checkedOut, total, err := r.checkedOutSize(ctx)
+	if err != nil {
+		c.logger.Warn("failed to measure the size of sparse checkout", zap.String("repo-id", repoID), zap.Error(err))
+		return r, nil
+	}
+	c.logger.Info(fmt.Sprintf("sparse checkout of %s saved %d bytes: checked out %d of %d bytes in %d directories",
+		repoID, total-checkedOut, checkedOut, total, len(dirs)),
+		zap.String("repo-id", repoID),
+		zap.String("branch", branch),
+	)
+	return r, nil
+}
+
+// ShallowClone clones a specific git repository to the given destination
+// while fetching only the given number of the latest commits.
+// The commits pulled into the cloned repository later are kept in addition to them.
+func (c *client) ShallowClone(ctx context.Context, repoID, remote, branch, destination string, depth int) (Repo, error) {
+	if depth <= 0 {
+		return nil, fmt.Errorf("depth must be greater than 0: %d", depth)
+	}
+	return c.clone(ctx, repoID, remote, branch, destination, false, depth)
+}
+
+// clone clones the given repository via the local cache of it.
+// Only the given number of the latest commits are fetched when depth is greater than 0.
+func (c *client) clone(ctx context.Context, repoID, remote, branch, destination string, noCheckout bool, depth int) (*repo, error) {
+	cacheKey := repoID
+	if depth > 0 {
+		cacheKey += shallowCacheSuffix
+	}
 	var (
-		repoCachePath = filepath.Join(c.cacheDir, repoID)
+		repoCachePath = filepath.Join(c.cacheDir, cacheKey)
 		logger        = c.logger.With(
 			zap.String("repo-id", repoID),
 			zap.String("remote", remote),
@@ -131,8 +243,8 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 		)
 	)
 
-	c.lockRepo(repoID)
-	defer c.unlockRepo(repoID)
+	c.lockRepo(cacheKey)
+	defer c.unlockRepo(cacheKey)
 
 	_, err := os.Stat(repoCachePath)
 	if err != nil && !os.IsNotExist(err) {
@@ -145,9 +257,12 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 		if err := os.MkdirAll(filepath.Dir(repoCachePath), os.ModePerm); err != nil && !os.IsExist(err) {
 			return nil, err
 		}
-		out, err := retryCommand(3, time.Second, logger, func() ([]byte, error) {
-			return runGitCommand(ctx, c.gitPath, "", c.envsForRepo(remote), "clone", "--mirror", remote, repoCachePath)
-		})
+		args := []string{"clone", "--mirror"}
+		if depth > 0 {
+			args = append(args, "--depth", strconv.Itoa(depth))
+		}
+		args = append(args, remote, repoCachePath)
+		out, err := c.runRemoteGitCommand(ctx, "", remote, logger, args...)
 		if err != nil {
 			logger.Error("failed to clone from remote",
 				zap.String("out", string(out)),
@@ -158,9 +273,11 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 	} else {
 		// Cache hit. Do a git fetch to keep updated.
 		c.logger.Info(fmt.Sprintf("fetching %s to update the cache", repoID))
-		out, err := retryCommand(3, time.Second, c.logger, func() ([]byte, error) {
-			return runGitCommand(ctx, c.gitPath, repoCachePath, c.envsForRepo(remote), "fetch")
-		})
+		args := []string{"fetch"}
+		if depth > 0 {
+			args = append(args, "--depth", strconv.Itoa(depth))
+		}
+		out, err := c.runRemoteGitCommand(ctx, repoCachePath, remote, c.logger, args...)
 		if err != nil {
 			logger.Error("failed to fetch from remote",
 				zap.String("out", string(out)),
@@ -183,6 +300,9 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 	}
 
 	args := []string{"clone"}
+	if noCheckout {
+		args = append(args, "--no-checkout")
+	}
 	if branch != "" {
 		args = append(args, "-b", branch)
 	}
@@ -198,6 +318,7 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 	}
 
 	r := NewRepo(destination, c.gitPath, remote, branch, c.envsForRepo(remote))
+	r.sshKey = c.sshKeys[remote]
 	if c.username != "" || c.email != "" {
 		if err := r.setUser(ctx, c.username, c.email); err != nil {
 			return nil, fmt.Errorf("failed to set user: %v", err)
@@ -216,15 +337,18 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 
 // Clean removes all cache data.
 func (c *client) Clean() error {
+	if c.sshKeyDir != "" {
+		if err := os.RemoveAll(c.sshKeyDir); err != nil {
+			return err
+		}
+	}
 	return os.RemoveAll(c.cacheDir)
 }
 
 // getLatestRemoteHashForBranch returns the hash of the latest commit of a remote branch.
 func (c *client) getLatestRemoteHashForBranch(ctx context.Context, remote, branch string) (string, error) {
 	ref := "refs/heads/" + branch
-	out, err := retryCommand(3, time.Second, c.logger, func() ([]byte, error) {
-		return runGitCommand(ctx, c.gitPath, "", c.envsForRepo(remote), "ls-remote", ref)
-	})
+	out, err := c.runRemoteGitCommand(ctx, "", remote, c.logger, "ls-remote", ref)
 	if err != nil {
 		c.logger.Error("failed to get latest remote hash for branch",
 			zap.String("remote", remote),
@@ -238,6 +362,31 @@ func (c *client) getLatestRemoteHashForBranch(ctx context.Context, remote, branc
 	return parts[0], nil
 }
 
+// ListRemoteBranches returns the sorted names of all branches in the given remote repository.
+func (c *client) ListRemoteBranches(ctx context.Context, remote string) ([]string, error) {
+	out, err := c.runRemoteGitCommand(ctx, "", remote, c.logger, "ls-remote", "--heads", remote)
+	if err != nil {
+		c.logger.Error("failed to list remote branches",
+			zap.String("remote", remote),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	const prefix = "refs/heads/"
+	branches := make([]string, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) != 2 || !strings.HasPrefix(parts[1], prefix) {
+			continue
+		}
+		branches = append(branches, strings.TrimPrefix(parts[1], prefix))
+	}
+	sort.Strings(branches)
+	return branches, nil
+}
+
 func (c *client) lockRepo(repoID string) {
 	c.mu.Lock()
 	if _, ok := c.repoLocks[repoID]; !ok {
@@ -256,8 +405,43 @@ func (c *client) unlockRepo(repoID string) {
 }
 
 func (c *client) envsForRepo(remote string) []string {
-	envs := c.gitEnvsByRepo[remote]
-	return append(envs, c.gitEnvs...)
+	envs := make([]string, 0, len(c.gitEnvsByRepo[remote])+len(c.gitEnvs)+1)
+	envs = append(envs, c.gitEnvsByRepo[remote]...)
+	envs = append(envs, c.gitEnvs...)
+	// The SSH key for the remote is placed last to take precedence over the others.
+	if k, ok := c.sshKeys[remote]; ok {
+		envs = append(envs, k.env())
+	}
+	return envs
+}
+
+// runRemoteGitCommand runs the given git command accessing the given remote with retries.
+// The SSH key for the remote is read again before retrying since the failure may be caused by its rotation.
+func (c *client) runRemoteGitCommand(ctx context.Context, dir, remote string, logger *zap.Logger, args ...string) ([]byte, error) {
+	var attempts int
+	return retryCommand(3, time.Second, logger, func() ([]byte, error) {
+		if attempts > 0 {
+			c.reloadSSHKey(remote)
+		}
+		attempts++
+		return runGitCommand(ctx, c.gitPath, dir, c.envsForRepo(remote), args...)
+	})
+}
+
+// reloadSSHKey reads the SSH key for the given remote again if configured.
+func (c *client) reloadSSHKey(remote string) {
+	k, ok := c.sshKeys[remote]
+	if !ok {
+		return
+	}
+	changed, err := k.reload()
+	if err != nil {
+		c.logger.Warn("failed to reload ssh key", zap.String("remote", remote), zap.Error(err))
+		return
+	}
+	if changed {
+		c.logger.Info("reloaded the rotated ssh key", zap.String("remote", remote))
+	}
 }
 
 func runGitCommand(ctx context.Context, execPath, dir string, envs []string, args ...string) ([]byte, error) {
@@ -92,6 +92,148 @@ func TestClone(t *testing.T) {
 	assert.Equal(t, "Added note.txt", commits12[0].Message)
 }
 
+func TestSparseClone(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	c, err := NewClient()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	defer c.Clean()
+
+	err = faker.makeRepo("test-sparse-clone-org", "repo-1")
+	require.NoError(t, err)
+	commander := gitCommander{
+		gitPath: c.(*client).gitPath,
+		dir:     faker.dir,
+		org:     "test-sparse-clone-org",
+		repo:    "repo-1",
+	}
+	for _, dir := range []string{"app-1", "app-2", "app-3"} {
+		err = os.MkdirAll(filepath.Join(faker.repoDir("test-sparse-clone-org", "repo-1"), dir), os.ModePerm)
+		require.NoError(t, err)
+		err = commander.addCommit(filepath.Join(dir, "app.pipecd.yaml"), dir)
+		require.NoError(t, err)
+	}
+
+	ctx := context.Background()
+	repoPath, err := os.MkdirTemp("", "repopath")
+	require.NoError(t, err)
+	repo, err := c.SparseClone(ctx, "repo-1", faker.repoDir("test-sparse-clone-org", "repo-1"), "", repoPath, []string{"app-1"})
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+	defer func() {
+		assert.NoError(t, repo.Clean())
+	}()
+
+	exists := func(path string) bool {
+		_, err := os.Stat(filepath.Join(repoPath, path))
+		return err == nil
+	}
+	assert.True(t, exists("README.md"))
+	assert.True(t, exists("app-1/app.pipecd.yaml"))
+	assert.False(t, exists("app-2/app.pipecd.yaml"))
+	assert.False(t, exists("app-3/app.pipecd.yaml"))
+
+	// Widen the sparse checkout.
+	err = repo.SetSparseCheckout(ctx, []string{"app-1", "app-2/"})
+	require.NoError(t, err)
+	assert.True(t, exists("app-2/app.pipecd.yaml"))
+	assert.False(t, exists("app-3/app.pipecd.yaml"))
+
+	// Check out the whole tree when the root directory was given.
+	err = repo.SetSparseCheckout(ctx, []string{"app-1", "."})
+	require.NoError(t, err)
+	assert.True(t, exists("app-3/app.pipecd.yaml"))
+}
+
+func TestShallowClone(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	c, err := NewClient()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	defer c.Clean()
+
+	err = faker.makeRepo("test-shallow-clone-org", "repo-1")
+	require.NoError(t, err)
+	commander := gitCommander{
+		gitPath: c.(*client).gitPath,
+		dir:     faker.dir,
+		org:     "test-shallow-clone-org",
+		repo:    "repo-1",
+	}
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		err = commander.addCommit(name, name)
+		require.NoError(t, err)
+	}
+
+	ctx := context.Background()
+	// The depth is ignored for the local path so the file URL is used instead.
+	remote := "file://" + faker.repoDir("test-shallow-clone-org", "repo-1")
+
+	_, err = c.ShallowClone(ctx, "repo-1", remote, "master", "", 0)
+	require.Error(t, err)
+
+	repoPath, err := os.MkdirTemp("", "repopath")
+	require.NoError(t, err)
+	repo, err := c.ShallowClone(ctx, "repo-1", remote, "master", repoPath, 2)
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+	defer func() {
+		assert.NoError(t, repo.Clean())
+	}()
+	commits, err := repo.ListCommits(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commits))
+	assert.Equal(t, "Added c.txt", commits[0].Message)
+
+	// The full clone of the same repository is not truncated.
+	fullPath, err := os.MkdirTemp("", "fullpath")
+	require.NoError(t, err)
+	full, err := c.Clone(ctx, "repo-1", remote, "master", fullPath)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, full.Clean())
+	}()
+	commits, err = full.ListCommits(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(commits))
+}
+
+func TestListRemoteBranches(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	c, err := NewClient()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	defer c.Clean()
+
+	err = faker.makeRepo("test-list-branches-org", "repo-1")
+	require.NoError(t, err)
+	commander := gitCommander{
+		gitPath: c.(*client).gitPath,
+		dir:     faker.dir,
+		org:     "test-list-branches-org",
+		repo:    "repo-1",
+	}
+	err = commander.runGitCommands([][]string{
+		{"branch", "feature/b"},
+		{"branch", "feature/a"},
+		{"tag", "v1.0.0"},
+	})
+	require.NoError(t, err)
+
+	branches, err := c.ListRemoteBranches(context.Background(), faker.repoDir("test-list-branches-org", "repo-1"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature/a", "feature/b", "master"}, branches)
+}
+
 type faker struct {
 	dir     string
 	gitPath string
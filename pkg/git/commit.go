@@ -16,6 +16,7 @@ package git
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -23,25 +24,118 @@ import (
 const (
 	separator       = "__GIT_LOG_SEPARATOR__"
 	delimiter       = "__GIT_LOG_DELIMITER__"
-	fieldNum        = 7
+	fieldNum        = 9
 	commitLogFormat = separator +
 		"%an" + delimiter +
+		"%ae" + delimiter +
 		"%cn" + delimiter +
 		"%at" + delimiter +
 		"%H" + delimiter +
 		"%h" + delimiter +
+		"%P" + delimiter +
 		"%s" + delimiter +
 		"%b"
 )
 
 type Commit struct {
 	Author          string
+	AuthorEmail     string
 	Committer       string
 	CreatedAt       int
 	Hash            string
 	AbbreviatedHash string
 	Message         string
 	Body            string
+	// The hashes of the parent commits.
+	Parents []string
+}
+
+// IsMerge reports whether this commit is a merge commit having multiple parents.
+func (c Commit) IsMerge() bool {
+	return len(c.Parents) > 1
+}
+
+// GetTrailerValue returns the value of the given trailer, e.g. "Signed-off-by: foo",
+// from the last paragraph of the commit body. The key is case-insensitive.
+// If the trailer was specified multiple times, the last one is returned.
+func (c Commit) GetTrailerValue(key string) (string, bool) {
+	paragraphs := strings.Split(strings.TrimSpace(c.Body), "\n\n")
+	lines := strings.Split(paragraphs[len(paragraphs)-1], "\n")
+
+	var (
+		value string
+		found bool
+	)
+	for _, line := range lines {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if !strings.EqualFold(strings.TrimSpace(parts[0]), key) {
+			continue
+		}
+		value, found = strings.TrimSpace(parts[1]), true
+	}
+	return value, found
+}
+
+var (
+	// The subject of the merge commit created by GitHub, e.g. "Merge pull request #123 from org/branch".
+	githubMergeSubjectRegex = regexp.MustCompile(`^Merge pull request #(\d+) `)
+	// The subject of the squashed commit created by GitHub, e.g. "Add new feature (#123)".
+	githubSquashSubjectRegex = regexp.MustCompile(`\(#(\d+)\)$`)
+	// The line of the merge commit body created by GitLab, e.g. "See merge request org/repo!123".
+	gitlabMergeBodyRegex = regexp.MustCompile(`(?m)^See merge request \S+!(\d+)$`)
+	// The subject of the revert commit created by git or GitHub, e.g. `Revert "Add new feature" (#124)`.
+	revertSubjectRegex = regexp.MustCompile(`^Revert "(.+)"( \(#\d+\))?$`)
+	// The line of the revert commit body created by git, e.g. "This reverts commit 0123abc.".
+	revertBodyRegex = regexp.MustCompile(`(?m)^This reverts commit ([0-9a-f]{7,40})\.?$`)
+)
+
+// Revert describes the commit reverted by a revert commit.
+type Revert struct {
+	// The subject of the reverted commit.
+	Subject string
+	// The hash of the reverted commit. Empty when the commit message does not tell it.
+	Hash string
+}
+
+// GetRevert returns the commit reverted by this commit, parsed from the commit message
+// generated by git revert or the revert button of GitHub.
+// The merge commit of GitHub is regarded as a revert when its body starts with the subject of the revert.
+// This is a heuristic which does not compare the changes of the commits.
+func (c Commit) GetRevert() (Revert, bool) {
+	subject := strings.TrimSpace(c.Message)
+	if githubMergeSubjectRegex.MatchString(subject) {
+		subject = strings.TrimSpace(strings.SplitN(strings.TrimSpace(c.Body), "\n", 2)[0])
+	}
+	m := revertSubjectRegex.FindStringSubmatch(subject)
+	if len(m) < 2 {
+		return Revert{}, false
+	}
+	r := Revert{Subject: m[1]}
+	if h := revertBodyRegex.FindStringSubmatch(c.Body); len(h) == 2 {
+		r.Hash = h[1]
+	}
+	return r, true
+}
+
+// GetPullRequestNumber returns the number of the pull request (or merge request)
+// merged by this commit, parsed from the commit message generated by GitHub or GitLab.
+func (c Commit) GetPullRequestNumber() (int, bool) {
+	for _, m := range [][]string{
+		githubMergeSubjectRegex.FindStringSubmatch(c.Message),
+		githubSquashSubjectRegex.FindStringSubmatch(strings.TrimSpace(c.Message)),
+		gitlabMergeBodyRegex.FindStringSubmatch(c.Body),
+	} {
+		if len(m) != 2 {
+			continue
+		}
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
 }
 
 // We was using json encoding to parse commit log,
@@ -68,17 +162,19 @@ func parseCommit(log string) (Commit, error) {
 	if len(fields) != fieldNum {
 		return Commit{}, fmt.Errorf("invalid log: log line should contain %d fields but got %d", fieldNum, len(fields))
 	}
-	createdAt, err := strconv.Atoi(fields[2])
+	createdAt, err := strconv.Atoi(fields[3])
 	if err != nil {
 		return Commit{}, err
 	}
 	return Commit{
 		Author:          fields[0],
-		Committer:       fields[1],
+		AuthorEmail:     fields[1],
+		Committer:       fields[2],
 		CreatedAt:       createdAt,
-		Hash:            fields[3],
-		AbbreviatedHash: fields[4],
-		Message:         fields[5],
-		Body:            strings.TrimSpace(fields[6]),
+		Hash:            fields[4],
+		AbbreviatedHash: fields[5],
+		Parents:         strings.Fields(fields[6]),
+		Message:         fields[7],
+		Body:            strings.TrimSpace(fields[8]),
 	}, nil
 }
@@ -30,18 +30,22 @@ func TestParseCommit(t *testing.T) {
 	expected := []Commit{
 		{
 			Author:          "nghialv",
+			AuthorEmail:     "nghialv@example.com",
 			Committer:       "kapetanios-robot",
 			CreatedAt:       1565752022,
 			Hash:            "74e20ede0242fdc7fd75b5be56e8d7fa72060707",
 			AbbreviatedHash: "74e20ed",
+			Parents:         []string{"c9a7596e7e92ea5e3f03eeb951f632acb02b88a3"},
 			Message:         "wip",
 		},
 		{
 			Author:          "Le Van Nghia",
+			AuthorEmail:     "nghialv@example.com",
 			Committer:       "kapetanios-robot",
 			CreatedAt:       1565749682,
 			Hash:            "c9a7596e7e92ea5e3f03eeb951f632acb02b88a3",
 			AbbreviatedHash: "c9a7596",
+			Parents:         []string{"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", "24e20ede0242fdc7fd75b5be56e8d7fa72060707"},
 			Message:         `Add implementation of inplug service (#648)`,
 			Body: `**What this PR does / why we need it**:
 
@@ -61,10 +65,12 @@ This PR was merged by Kapetanios.`,
 		},
 		{
 			Author:          "nghialv",
+			AuthorEmail:     "nghialv@example.com",
 			Committer:       "kapetanios-robot",
 			CreatedAt:       2565752022,
 			Hash:            "24e20ede0242fdc7fd75b5be56e8d7fa72060707",
 			AbbreviatedHash: "24e20ed",
+			Parents:         []string{},
 			Message:         `Added commands to "kapectl" for creating, updating project secret (#475)`,
 		},
 	}
@@ -78,3 +84,137 @@ This PR was merged by Kapetanios.`,
 	})
 	assert.Equal(t, expected, commits)
 }
+
+func TestCommitGetTrailerValue(t *testing.T) {
+	testcases := []struct {
+		name     string
+		body     string
+		key      string
+		expected string
+		found    bool
+	}{
+		{
+			name: "empty body",
+			key:  "Pipecd-Sync-Strategy",
+		},
+		{
+			name:     "trailer in the last paragraph",
+			body:     "Some details.\n\nSigned-off-by: foo\nPipecd-Sync-Strategy: QUICK_SYNC",
+			key:      "Pipecd-Sync-Strategy",
+			expected: "QUICK_SYNC",
+			found:    true,
+		},
+		{
+			name:     "key is case-insensitive",
+			body:     "pipecd-sync-strategy:  PIPELINE ",
+			key:      "Pipecd-Sync-Strategy",
+			expected: "PIPELINE",
+			found:    true,
+		},
+		{
+			name: "not in the last paragraph",
+			body: "Pipecd-Sync-Strategy: QUICK_SYNC\n\nSome details.",
+			key:  "Pipecd-Sync-Strategy",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Commit{Body: tc.body}
+			value, found := c.GetTrailerValue(tc.key)
+			assert.Equal(t, tc.expected, value)
+			assert.Equal(t, tc.found, found)
+		})
+	}
+}
+
+func TestCommitGetPullRequestNumber(t *testing.T) {
+	testcases := []struct {
+		name     string
+		message  string
+		body     string
+		expected int
+		found    bool
+	}{
+		{
+			name:    "no pull request",
+			message: "Fix typo",
+			body:    "Fixes #12",
+		},
+		{
+			name:     "github merge commit",
+			message:  "Merge pull request #123 from org/feature",
+			expected: 123,
+			found:    true,
+		},
+		{
+			name:     "github squashed commit",
+			message:  "Add implementation of inplug service (#648)",
+			expected: 648,
+			found:    true,
+		},
+		{
+			name:     "gitlab merge commit",
+			message:  "Merge branch 'feature' into 'main'",
+			body:     "Add new feature\n\nSee merge request org/repo!45",
+			expected: 45,
+			found:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Commit{Message: tc.message, Body: tc.body}
+			n, found := c.GetPullRequestNumber()
+			assert.Equal(t, tc.expected, n)
+			assert.Equal(t, tc.found, found)
+		})
+	}
+}
+
+func TestCommitGetRevert(t *testing.T) {
+	testcases := []struct {
+		name     string
+		message  string
+		body     string
+		expected Revert
+		found    bool
+	}{
+		{
+			name:    "not revert",
+			message: "Revert the timeout to 10s",
+		},
+		{
+			name:     "git revert",
+			message:  `Revert "Add new feature"`,
+			body:     "This reverts commit 0123456789abcdef0123456789abcdef01234567.",
+			expected: Revert{Subject: "Add new feature", Hash: "0123456789abcdef0123456789abcdef01234567"},
+			found:    true,
+		},
+		{
+			name:     "github squashed revert",
+			message:  `Revert "Add new feature" (#124)`,
+			body:     "This reverts commit 0123abc.",
+			expected: Revert{Subject: "Add new feature", Hash: "0123abc"},
+			found:    true,
+		},
+		{
+			name:     "github merged revert",
+			message:  "Merge pull request #124 from org/revert-123-feature",
+			body:     `Revert "Add new feature"`,
+			expected: Revert{Subject: "Add new feature"},
+			found:    true,
+		},
+		{
+			name:    "github merged pull request",
+			message: "Merge pull request #124 from org/feature",
+			body:    "Add new feature",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Commit{Message: tc.message, Body: tc.body}
+			r, found := c.GetRevert()
+			assert.Equal(t, tc.expected, r)
+			assert.Equal(t, tc.found, found)
+		})
+	}
+}
@@ -50,6 +50,21 @@ func (mr *MockRepoMockRecorder) ChangedFiles(arg0, arg1, arg2 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangedFiles", reflect.TypeOf((*MockRepo)(nil).ChangedFiles), arg0, arg1, arg2)
 }
 
+// ChangedSubmoduleFiles mocks base method.
+func (m *MockRepo) ChangedSubmoduleFiles(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangedSubmoduleFiles", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangedSubmoduleFiles indicates an expected call of ChangedSubmoduleFiles.
+func (mr *MockRepoMockRecorder) ChangedSubmoduleFiles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangedSubmoduleFiles", reflect.TypeOf((*MockRepo)(nil).ChangedSubmoduleFiles), arg0, arg1, arg2)
+}
+
 // Checkout mocks base method.
 func (m *MockRepo) Checkout(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -135,6 +150,36 @@ func (mr *MockRepoMockRecorder) GetClonedBranch() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClonedBranch", reflect.TypeOf((*MockRepo)(nil).GetClonedBranch))
 }
 
+// GetCommitForRev mocks base method.
+func (m *MockRepo) GetCommitForRev(arg0 context.Context, arg1 string) (git.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitForRev", arg0, arg1)
+	ret0, _ := ret[0].(git.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitForRev indicates an expected call of GetCommitForRev.
+func (mr *MockRepoMockRecorder) GetCommitForRev(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitForRev", reflect.TypeOf((*MockRepo)(nil).GetCommitForRev), arg0, arg1)
+}
+
+// GetCommitSignature mocks base method.
+func (m *MockRepo) GetCommitSignature(arg0 context.Context, arg1 string) (git.CommitSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitSignature", arg0, arg1)
+	ret0, _ := ret[0].(git.CommitSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitSignature indicates an expected call of GetCommitSignature.
+func (mr *MockRepoMockRecorder) GetCommitSignature(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitSignature", reflect.TypeOf((*MockRepo)(nil).GetCommitSignature), arg0, arg1)
+}
+
 // GetCommitHashForRev mocks base method.
 func (m *MockRepo) GetCommitHashForRev(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -179,6 +224,21 @@ func (mr *MockRepoMockRecorder) GetPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPath", reflect.TypeOf((*MockRepo)(nil).GetPath))
 }
 
+// ListCommitRange mocks base method.
+func (m *MockRepo) ListCommitRange(arg0 context.Context, arg1, arg2 string) ([]git.Commit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommitRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]git.Commit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommitRange indicates an expected call of ListCommitRange.
+func (mr *MockRepoMockRecorder) ListCommitRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommitRange", reflect.TypeOf((*MockRepo)(nil).ListCommitRange), arg0, arg1, arg2)
+}
+
 // ListCommits mocks base method.
 func (m *MockRepo) ListCommits(arg0 context.Context, arg1 string) ([]git.Commit, error) {
 	m.ctrl.T.Helper()
@@ -194,6 +254,21 @@ func (mr *MockRepoMockRecorder) ListCommits(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockRepo)(nil).ListCommits), arg0, arg1)
 }
 
+// ListTags mocks base method.
+func (m *MockRepo) ListTags(arg0 context.Context, arg1 string) ([]git.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0, arg1)
+	ret0, _ := ret[0].([]git.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockRepoMockRecorder) ListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockRepo)(nil).ListTags), arg0, arg1)
+}
+
 // MergeRemoteBranch mocks base method.
 func (m *MockRepo) MergeRemoteBranch(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -235,3 +310,46 @@ func (mr *MockRepoMockRecorder) Push(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockRepo)(nil).Push), arg0, arg1)
 }
+
+// ReadFileAt mocks base method.
+func (m *MockRepo) ReadFileAt(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFileAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFileAt indicates an expected call of ReadFileAt.
+func (mr *MockRepoMockRecorder) ReadFileAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFileAt", reflect.TypeOf((*MockRepo)(nil).ReadFileAt), arg0, arg1, arg2)
+}
+
+// SetSparseCheckout mocks base method.
+func (m *MockRepo) SetSparseCheckout(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSparseCheckout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSparseCheckout indicates an expected call of SetSparseCheckout.
+func (mr *MockRepoMockRecorder) SetSparseCheckout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSparseCheckout", reflect.TypeOf((*MockRepo)(nil).SetSparseCheckout), arg0, arg1)
+}
+
+// UpdateSubmodules mocks base method.
+func (m *MockRepo) UpdateSubmodules(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubmodules", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubmodules indicates an expected call of UpdateSubmodules.
+func (mr *MockRepoMockRecorder) UpdateSubmodules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubmodules", reflect.TypeOf((*MockRepo)(nil).UpdateSubmodules), arg0)
+}
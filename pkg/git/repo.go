@@ -15,19 +15,38 @@
 package git
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
 var (
 	ErrNoChange = errors.New("no change")
+	ErrNotFound = errors.New("not found")
+	// ErrAuthentication is returned when the remote rejected the credentials, e.g. an expired token.
+	ErrAuthentication = errors.New("authentication failed")
 )
 
+// authFailureMessages are the messages printed by git and ssh when the remote rejected the credentials.
+var authFailureMessages = []string{
+	"Authentication failed",
+	"could not read Username",
+	"could not read Password",
+	"Permission denied (publickey",
+	"Invalid username or password",
+	"The requested URL returned error: 401",
+	"The requested URL returned error: 403",
+}
+
+// submoduleMode is the file mode Git records for the submodules.
+const submoduleMode = "160000"
+
 // Repo provides functions to get and handle git data.
 type Repo interface {
 	GetPath() string
@@ -35,10 +54,18 @@ type Repo interface {
 	Copy(dest string) (Repo, error)
 
 	ListCommits(ctx context.Context, visionRange string) ([]Commit, error)
+	ListCommitRange(ctx context.Context, from, to string) ([]Commit, error)
 	GetLatestCommit(ctx context.Context) (Commit, error)
 	GetCommitHashForRev(ctx context.Context, rev string) (string, error)
+	GetCommitForRev(ctx context.Context, rev string) (Commit, error)
+	GetCommitSignature(ctx context.Context, rev string) (CommitSignature, error)
 	ChangedFiles(ctx context.Context, from, to string) ([]string, error)
+	ChangedSubmoduleFiles(ctx context.Context, from, to string) ([]string, error)
+	ReadFileAt(ctx context.Context, rev, path string) ([]byte, error)
+	ListTags(ctx context.Context, pattern string) ([]Tag, error)
 	Checkout(ctx context.Context, commitish string) error
+	SetSparseCheckout(ctx context.Context, dirs []string) error
+	UpdateSubmodules(ctx context.Context) error
 	CheckoutPullRequest(ctx context.Context, number int, branch string) error
 	Clean() error
 
@@ -54,6 +81,9 @@ type repo struct {
 	remote       string
 	clonedBranch string
 	gitEnvs      []string
+	// The SSH key used only to access the remote.
+	// Nil means the ones configured in the ssh config are used.
+	sshKey *sshKey
 }
 
 // NewRepo creates a new Repo instance.
@@ -92,6 +122,8 @@ func (r *repo) Copy(dest string) (Repo, error) {
 		gitPath:      r.gitPath,
 		remote:       r.remote,
 		clonedBranch: r.clonedBranch,
+		gitEnvs:      r.gitEnvs,
+		sshKey:       r.sshKey,
 	}, nil
 }
 
@@ -114,6 +146,24 @@ func (r *repo) ListCommits(ctx context.Context, revisionRange string) ([]Commit,
 	return parseCommits(string(out))
 }
 
+// ListCommitRange returns the commits after a given commit until another one, oldest first.
+// Only the first parents are followed so the commits inside the merged branches are not included.
+func (r *repo) ListCommitRange(ctx context.Context, from, to string) ([]Commit, error) {
+	out, err := r.runGitCommand(ctx,
+		"log",
+		"--no-decorate",
+		"--first-parent",
+		"--reverse",
+		fmt.Sprintf("--pretty=format:%s", commitLogFormat),
+		fmt.Sprintf("%s..%s", from, to),
+	)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+
+	return parseCommits(string(out))
+}
+
 // GetLatestCommit returns the most recent commit of current branch.
 func (r *repo) GetLatestCommit(ctx context.Context) (Commit, error) {
 	commits, err := r.ListCommits(ctx, "-1")
@@ -138,6 +188,50 @@ func (r *repo) GetCommitHashForRev(ctx context.Context, rev string) (string, err
 	return strings.TrimSpace(string(out)), nil
 }
 
+// GetCommitForRev returns the commit for a given rev.
+// An error is returned when no such commit exists in the repository.
+func (r *repo) GetCommitForRev(ctx context.Context, rev string) (Commit, error) {
+	out, err := r.runGitCommand(ctx,
+		"log",
+		"-1",
+		"--no-decorate",
+		fmt.Sprintf("--pretty=format:%s", commitLogFormat),
+		rev+"^{commit}",
+		"--",
+	)
+	if err != nil {
+		return Commit{}, formatCommandError(err, out)
+	}
+
+	commits, err := parseCommits(string(out))
+	if err != nil {
+		return Commit{}, err
+	}
+
+	if len(commits) != 1 {
+		return Commit{}, fmt.Errorf("commits must contain one item, got: %d", len(commits))
+	}
+
+	return commits[0], nil
+}
+
+// GetCommitSignature verifies the signature of the commit for a given rev
+// against the keys configured to the client, and returns the result.
+func (r *repo) GetCommitSignature(ctx context.Context, rev string) (CommitSignature, error) {
+	out, err := r.runGitCommand(ctx,
+		"log",
+		"-1",
+		"--no-decorate",
+		fmt.Sprintf("--pretty=format:%s", signatureLogFormat),
+		rev+"^{commit}",
+		"--",
+	)
+	if err != nil {
+		return CommitSignature{}, formatCommandError(err, out)
+	}
+	return parseCommitSignature(string(out))
+}
+
 // ChangedFiles returns a list of files those were touched between two commits.
 func (r *repo) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
 	out, err := r.runGitCommand(ctx, "diff", "--name-only", from, to)
@@ -159,6 +253,116 @@ func (r *repo) ChangedFiles(ctx context.Context, from, to string) ([]string, err
 	return files, nil
 }
 
+// ReadFileAt returns the content of a given file at a given revision without checking it out.
+// The path is relative to the root of this repository.
+// ErrNotFound is returned when the file does not exist at that revision.
+func (r *repo) ReadFileAt(ctx context.Context, rev, path string) ([]byte, error) {
+	out, err := r.runGitCommand(ctx, "ls-tree", "--name-only", rev, "--", path)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+	if strings.TrimSpace(string(out)) == "" {
+		return nil, ErrNotFound
+	}
+
+	out, err = r.runGitCommand(ctx, "show", fmt.Sprintf("%s:%s", rev, path))
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+	return out, nil
+}
+
+// ChangedSubmoduleFiles returns a list of files those were touched inside the submodules
+// whose recorded commit was changed between two commits, including the nested submodules.
+// The returned paths are relative to the root of this repository.
+// The submodules must have been updated to both commits before.
+func (r *repo) ChangedSubmoduleFiles(ctx context.Context, from, to string) ([]string, error) {
+	out, err := r.runGitCommand(ctx, "diff", "--raw", "--no-abbrev", from, to)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+
+	files := make([]string, 0)
+	for _, line := range strings.Split(string(out), "\n") {
+		// Each line is formatted as ":<old mode> <new mode> <old object> <new object> <status>\t<path>".
+		parts := strings.SplitN(line, "\t", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(parts[0], ":"))
+		if len(fields) != 5 || (fields[0] != submoduleMode && fields[1] != submoduleMode) {
+			continue
+		}
+
+		var (
+			path    = parts[1]
+			sub     = &repo{dir: filepath.Join(r.dir, path), gitPath: r.gitPath, gitEnvs: r.gitEnvs}
+			changed []string
+		)
+		switch {
+		case fields[0] != submoduleMode:
+			// The submodule was added so all of its files were touched.
+			changed, err = sub.listFiles(ctx, fields[3])
+		case fields[1] != submoduleMode:
+			// The submodule was removed so all of its files were touched.
+			changed, err = sub.listFiles(ctx, fields[2])
+		default:
+			changed, err = sub.ChangedFiles(ctx, fields[2], fields[3])
+			if err == nil {
+				var nested []string
+				nested, err = sub.ChangedSubmoduleFiles(ctx, fields[2], fields[3])
+				changed = append(changed, nested...)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list changed files in submodule %s: %w", path, err)
+		}
+		for _, f := range changed {
+			files = append(files, path+"/"+f)
+		}
+	}
+	return files, nil
+}
+
+// listFiles returns a list of all files at the given commit.
+func (r *repo) listFiles(ctx context.Context, commitish string) ([]string, error) {
+	out, err := r.runGitCommand(ctx, "ls-tree", "-r", "--name-only", commitish)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+
+	files := make([]string, 0)
+	for _, f := range strings.Split(string(out), "\n") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// ListTags fetches the tags from the remote and returns the ones matching the given glob pattern,
+// e.g. "v*", ordered from the newest to the oldest by their creation time.
+func (r *repo) ListTags(ctx context.Context, pattern string) ([]Tag, error) {
+	out, err := r.runGitCommand(ctx, "fetch", "--tags", "--force", r.remote)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+
+	out, err = r.runGitCommand(ctx,
+		"for-each-ref",
+		// The last key is used as the primary one.
+		"--sort=-v:refname",
+		"--sort=-creatordate",
+		fmt.Sprintf("--format=%s", tagRefFormat),
+		"refs/tags/"+pattern,
+	)
+	if err != nil {
+		return nil, formatCommandError(err, out)
+	}
+
+	return parseTags(string(out))
+}
+
 // Checkout checkouts to a given commitish.
 func (r *repo) Checkout(ctx context.Context, commitish string) error {
 	out, err := r.runGitCommand(ctx, "checkout", commitish)
@@ -168,6 +372,69 @@ func (r *repo) Checkout(ctx context.Context, commitish string) error {
 	return nil
 }
 
+// SetSparseCheckout limits the working tree to the given directories and the files at the root.
+// The whole tree is checked out when any of them is the root directory.
+func (r *repo) SetSparseCheckout(ctx context.Context, dirs []string) error {
+	args := []string{"sparse-checkout", "set", "--cone"}
+	for _, d := range dirs {
+		d = strings.Trim(filepath.Clean(d), "/")
+		if d == "." || d == "" {
+			args = []string{"sparse-checkout", "disable"}
+			break
+		}
+		args = append(args, d)
+	}
+
+	out, err := r.runGitCommand(ctx, args...)
+	if err != nil {
+		return formatCommandError(err, out)
+	}
+	return nil
+}
+
+// UpdateSubmodules checks out the commits recorded in the current commit
+// for all submodules recursively, initializing the ones not yet cloned.
+func (r *repo) UpdateSubmodules(ctx context.Context) error {
+	out, err := r.runGitCommand(ctx, "submodule", "update", "--init", "--recursive")
+	if err != nil {
+		return formatCommandError(err, out)
+	}
+	return nil
+}
+
+// checkedOutSize returns the total size of the files in the working tree
+// and the total size of all files in the head commit.
+func (r *repo) checkedOutSize(ctx context.Context) (checkedOut, total int64, err error) {
+	out, err := r.runGitCommand(ctx, "ls-tree", "-r", "-l", "HEAD")
+	if err != nil {
+		return 0, 0, formatCommandError(err, out)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		// Each line is formatted as "<mode> <type> <object> <size>\t<path>".
+		fields := strings.Fields(strings.SplitN(line, "\t", 2)[0])
+		if len(fields) != 4 {
+			continue
+		}
+		if size, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+			total += size
+		}
+	}
+
+	err = filepath.Walk(r.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if info.Mode().IsRegular() {
+			checkedOut += info.Size()
+		}
+		return nil
+	})
+	return checkedOut, total, err
+}
+
 // CheckoutPullRequest checkouts to the latest commit of a given pull request.
 func (r *repo) CheckoutPullRequest(ctx context.Context, number int, branch string) error {
 	target := fmt.Sprintf("pull/%d/head:%s", number, branch)
@@ -181,7 +448,17 @@ func (r *repo) CheckoutPullRequest(ctx context.Context, number int, branch strin
 // Pull fetches from and integrate with a local branch.
 func (r *repo) Pull(ctx context.Context, branch string) error {
 	out, err := r.runGitCommand(ctx, "pull", r.remote, branch)
+	// The SSH key may have been rotated since it was loaded,
+	// so pull again once with the new one if it was changed.
+	if err != nil && r.sshKey != nil {
+		if changed, rerr := r.sshKey.reload(); rerr == nil && changed {
+			out, err = r.runGitCommand(ctx, "pull", r.remote, branch)
+		}
+	}
 	if err != nil {
+		if isAuthFailure(out) {
+			return fmt.Errorf("%w: %v", ErrAuthentication, formatCommandError(err, out))
+		}
 		return formatCommandError(err, out)
 	}
 	return nil
@@ -300,3 +577,14 @@ func (r *repo) runGitCommand(ctx context.Context, args ...string) ([]byte, error
 func formatCommandError(err error, out []byte) error {
 	return fmt.Errorf("err: %w, out: %s", err, string(out))
 }
+
+// isAuthFailure reports whether the given output of a command accessing the remote
+// shows that its credentials were rejected.
+func isAuthFailure(out []byte) bool {
+	for _, m := range authFailureMessages {
+		if bytes.Contains(out, []byte(m)) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,7 +16,9 @@ package git
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"testing"
@@ -52,6 +54,36 @@ func TestGetCommitHashForRev(t *testing.T) {
 	assert.Equal(t, commits[0].Hash, latestCommitHash)
 }
 
+func TestGetCommitForRev(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-get-commit-for-rev"
+		ctx      = context.Background()
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+	}
+
+	commits, err := r.ListCommits(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commits))
+
+	commit, err := r.GetCommitForRev(ctx, commits[0].Hash)
+	require.NoError(t, err)
+	assert.Equal(t, commits[0], commit)
+
+	_, err = r.GetCommitForRev(ctx, "0123456789012345678901234567890123456789")
+	assert.Error(t, err)
+}
+
 func TestChangedFiles(t *testing.T) {
 	faker, err := newFaker()
 	require.NoError(t, err)
@@ -103,6 +135,277 @@ func TestChangedFiles(t *testing.T) {
 	assert.Equal(t, expectedChangedFiles, changedFiles)
 }
 
+func TestListCommitRange(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-list-commit-range"
+		ctx      = context.Background()
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+	}
+
+	fromCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	messages := []string{"First commit", "Second commit"}
+	for i, m := range messages {
+		err = os.WriteFile(filepath.Join(r.dir, "README.md"), []byte(fmt.Sprintf("content %d", i)), os.ModePerm)
+		require.NoError(t, err)
+		err = r.addCommit(ctx, m)
+		require.NoError(t, err)
+	}
+
+	headCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	commits, err := r.ListCommitRange(ctx, fromCommitHash, headCommitHash)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commits))
+	assert.Equal(t, "First commit", commits[0].Message)
+	assert.Equal(t, "Second commit", commits[1].Message)
+	assert.Equal(t, headCommitHash, commits[1].Hash)
+
+	commits, err = r.ListCommitRange(ctx, headCommitHash, headCommitHash)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
+func TestReadFileAt(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-read-file-at"
+		ctx      = context.Background()
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+	}
+
+	err = os.MkdirAll(filepath.Join(r.dir, "app"), os.ModePerm)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(r.dir, "app", "values.yaml"), []byte("tag: v1"), os.ModePerm)
+	require.NoError(t, err)
+	err = r.addCommit(ctx, "Add values")
+	require.NoError(t, err)
+	firstCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(r.dir, "app", "values.yaml"), []byte("tag: v2"), os.ModePerm)
+	require.NoError(t, err)
+	err = r.addCommit(ctx, "Update values")
+	require.NoError(t, err)
+
+	content, err := r.ReadFileAt(ctx, firstCommitHash, "app/values.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "tag: v1", string(content))
+
+	content, err = r.ReadFileAt(ctx, "HEAD", "app/values.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "tag: v2", string(content))
+
+	_, err = r.ReadFileAt(ctx, "HEAD", "app/missing.yaml")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestChangedSubmoduleFiles(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-changed-submodule-files"
+		subName  = "repo-changed-submodule-files-sub"
+		ctx      = context.Background()
+	)
+
+	require.NoError(t, faker.makeRepo(org, repoName))
+	require.NoError(t, faker.makeRepo(org, subName))
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+	}
+
+	initialCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	// The local submodule is allowed explicitly since recent Git versions disallow it by default.
+	out, err := r.runGitCommand(ctx, "-c", "protocol.file.allow=always", "submodule", "add", faker.repoDir(org, subName), "manifests")
+	require.NoError(t, err, string(out))
+	require.NoError(t, r.addCommit(ctx, "Added submodule"))
+	addedCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	// All files of the added submodule were touched.
+	changedFiles, err := r.ChangedSubmoduleFiles(ctx, initialCommitHash, addedCommitHash)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifests/README.md"}, changedFiles)
+
+	// Update the commit recorded for the submodule.
+	sub := &repo{
+		dir:     filepath.Join(r.dir, "manifests"),
+		gitPath: faker.gitPath,
+	}
+	require.NoError(t, sub.setUser(ctx, "test-user", "test-user@example.com"))
+	require.NoError(t, os.MkdirAll(filepath.Join(sub.dir, "app"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(sub.dir, "app", "deployment.yaml"), []byte("content"), os.ModePerm))
+	require.NoError(t, sub.addCommit(ctx, "Added manifest"))
+	require.NoError(t, r.addCommit(ctx, "Updated submodule"))
+	updatedCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+
+	changedFiles, err = r.ChangedSubmoduleFiles(ctx, addedCommitHash, updatedCommitHash)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifests/app/deployment.yaml"}, changedFiles)
+
+	// The submodule pointer itself is reported by ChangedFiles.
+	changedFiles, err = r.ChangedFiles(ctx, addedCommitHash, updatedCommitHash)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifests"}, changedFiles)
+
+	// No submodule was changed.
+	changedFiles, err = r.ChangedSubmoduleFiles(ctx, updatedCommitHash, updatedCommitHash)
+	require.NoError(t, err)
+	assert.Empty(t, changedFiles)
+}
+
+func TestListTags(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-list-tags"
+		ctx      = context.Background()
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+		remote:  faker.repoDir(org, repoName),
+	}
+
+	firstCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+	_, err = r.runGitCommand(ctx, "tag", "v1.0.0")
+	require.NoError(t, err)
+	_, err = r.runGitCommand(ctx, "tag", "staging")
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(r.dir, "new-file.txt"), []byte("content"), os.ModePerm)
+	require.NoError(t, err)
+	err = r.addCommit(ctx, "Added new file")
+	require.NoError(t, err)
+	secondCommitHash, err := r.GetCommitHashForRev(ctx, "HEAD")
+	require.NoError(t, err)
+	_, err = r.runGitCommand(ctx, "tag", "-a", "v1.1.0", "-m", "Release v1.1.0")
+	require.NoError(t, err)
+
+	tags, err := r.ListTags(ctx, "v*")
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{
+		{Name: "v1.1.0", Hash: secondCommitHash},
+		{Name: "v1.0.0", Hash: firstCommitHash},
+	}, tags)
+
+	tags, err = r.ListTags(ctx, "prod*")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestGetCommitSignature(t *testing.T) {
+	sshKeygenPath, err := exec.LookPath("ssh-keygen")
+	if err != nil {
+		t.Skip("ssh-keygen is not installed")
+	}
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-get-commit-signature"
+		ctx      = context.Background()
+		keyFile  = filepath.Join(faker.dir, "signing-key")
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	out, err := exec.Command(sshKeygenPath, "-q", "-t", "ed25519", "-N", "", "-C", "", "-f", keyFile).CombinedOutput()
+	require.NoError(t, err, string(out))
+	publicKey, err := os.ReadFile(keyFile + ".pub")
+	require.NoError(t, err)
+
+	allowedSignersFile := filepath.Join(faker.dir, "allowed-signers")
+	err = os.WriteFile(allowedSignersFile, []byte("*@pipecd.dev "+string(publicKey)), os.ModePerm)
+	require.NoError(t, err)
+	unknownSignersFile := filepath.Join(faker.dir, "unknown-signers")
+	err = os.WriteFile(unknownSignersFile, []byte(""), os.ModePerm)
+	require.NoError(t, err)
+
+	c := &client{}
+	WithSignatureVerificationKeys("", allowedSignersFile)(c)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+		gitEnvs: c.gitEnvs,
+	}
+
+	sig, err := r.GetCommitSignature(ctx, "HEAD")
+	require.NoError(t, err)
+	assert.False(t, sig.IsSigned())
+	assert.False(t, sig.IsVerified())
+
+	for _, args := range [][]string{
+		{"config", "user.email", "test@pipecd.dev"},
+		{"config", "gpg.format", "ssh"},
+		{"config", "user.signingkey", keyFile},
+		{"commit", "--allow-empty", "-S", "-m", "Signed commit"},
+	} {
+		out, err := r.runGitCommand(ctx, args...)
+		require.NoError(t, err, string(out))
+	}
+
+	sig, err = r.GetCommitSignature(ctx, "HEAD")
+	require.NoError(t, err)
+	assert.True(t, sig.IsSigned())
+	assert.True(t, sig.IsVerified())
+	assert.Equal(t, SignatureStatusGood, sig.Status)
+	assert.Equal(t, "*@pipecd.dev", sig.Signer)
+	assert.NotEmpty(t, sig.Key)
+
+	// The signature made by the key not listed in the allowed signers is not verified.
+	c = &client{}
+	WithSignatureVerificationKeys("", unknownSignersFile)(c)
+	r.gitEnvs = c.gitEnvs
+	sig, err = r.GetCommitSignature(ctx, "HEAD")
+	require.NoError(t, err)
+	assert.True(t, sig.IsSigned())
+	assert.False(t, sig.IsVerified())
+
+	_, err = r.GetCommitSignature(ctx, "unknown")
+	assert.Error(t, err)
+}
+
 func TestAddCommit(t *testing.T) {
 	faker, err := newFaker()
 	require.NoError(t, err)
@@ -183,3 +486,37 @@ func TestCommitChanges(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, string(changes["a/b/c/new.txt"]), string(bytes))
 }
+
+func TestIsAuthFailure(t *testing.T) {
+	testcases := []struct {
+		name     string
+		out      string
+		expected bool
+	}{
+		{
+			name:     "expired token over https",
+			out:      "remote: Invalid username or password.\nfatal: Authentication failed for 'https://github.com/org/repo.git/'",
+			expected: true,
+		},
+		{
+			name:     "no credentials over https",
+			out:      "fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+			expected: true,
+		},
+		{
+			name:     "rejected ssh key",
+			out:      "git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.",
+			expected: true,
+		},
+		{
+			name:     "missing branch",
+			out:      "fatal: couldn't find remote ref unknown",
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isAuthFailure([]byte(tc.out)))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The status of the signature is placed at the first field.
+// See "%G?" of git-log(1) for the possible values.
+const signatureLogFormat = "%G?" + delimiter +
+	"%GS" + delimiter +
+	"%GK"
+
+// SignatureStatus represents the result of verifying the signature of a commit.
+type SignatureStatus string
+
+const (
+	// The signature is good and made by one of the configured keys.
+	SignatureStatusGood SignatureStatus = "G"
+	// The signature is good but made by the key whose validity is unknown,
+	// e.g. the SSH key not listed in the allowed signers file.
+	SignatureStatusUnknownValidity SignatureStatus = "U"
+	SignatureStatusBad             SignatureStatus = "B"
+	SignatureStatusExpired         SignatureStatus = "X"
+	SignatureStatusExpiredKey      SignatureStatus = "Y"
+	SignatureStatusRevokedKey      SignatureStatus = "R"
+	// The signature cannot be checked, e.g. the key is missing.
+	SignatureStatusCannotCheck SignatureStatus = "E"
+	SignatureStatusNoSignature SignatureStatus = "N"
+)
+
+// CommitSignature is the signature of a commit.
+type CommitSignature struct {
+	Status SignatureStatus
+	// The name of the signer.
+	Signer string
+	// The key used to sign.
+	Key string
+}
+
+// IsSigned reports whether the commit has a signature regardless of its validity.
+func (s CommitSignature) IsSigned() bool {
+	return s.Status != "" && s.Status != SignatureStatusNoSignature
+}
+
+// IsVerified reports whether the commit was signed by one of the configured keys.
+func (s CommitSignature) IsVerified() bool {
+	return s.Status == SignatureStatusGood
+}
+
+func parseCommitSignature(out string) (CommitSignature, error) {
+	fields := strings.Split(strings.TrimSpace(out), delimiter)
+	if len(fields) != 3 {
+		return CommitSignature{}, fmt.Errorf("invalid signature: signature line should contain 3 fields but got %d", len(fields))
+	}
+	return CommitSignature{
+		Status: SignatureStatus(fields[0]),
+		Signer: fields[1],
+		Key:    fields[2],
+	}, nil
+}
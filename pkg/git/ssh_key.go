@@ -0,0 +1,78 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// sshKey is the SSH key used only to access a specific remote, e.g. a deploy key of the repository.
+// The key is copied from its source file into the file owned by piped
+// to be read by ssh with the required permission regardless of how the source file was mounted.
+// The copy is refreshed by reload to pick up the rotated key.
+type sshKey struct {
+	mu     sync.Mutex
+	source string
+	file   string
+	data   []byte
+}
+
+// newSSHKey copies the SSH key from the given source file into a new file in the given directory.
+func newSSHKey(source, dir string) (*sshKey, error) {
+	f, err := os.CreateTemp(dir, "piped-ssh-key-*")
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	k := &sshKey{
+		source: source,
+		file:   f.Name(),
+	}
+	if _, err := k.reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// reload reads the source file again and updates the copy when the key was changed.
+// It reports whether the key was changed.
+func (k *sshKey) reload() (bool, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	data, err := os.ReadFile(k.source)
+	if err != nil {
+		return false, fmt.Errorf("failed to read ssh key file %s: %w", k.source, err)
+	}
+	if bytes.Equal(data, k.data) {
+		return false, nil
+	}
+	if err := os.WriteFile(k.file, data, 0600); err != nil {
+		return false, fmt.Errorf("failed to write ssh key file %s: %w", k.file, err)
+	}
+	k.data = data
+	return true, nil
+}
+
+// env returns the environment variable letting git use only this key
+// instead of the ones configured in the ssh config.
+func (k *sshKey) env() string {
+	return fmt.Sprintf("GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=no -F /dev/null", k.file)
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSHKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dir    = t.TempDir()
+		source = filepath.Join(dir, "deploy-key")
+	)
+	require.NoError(t, os.WriteFile(source, []byte("key-1"), 0644))
+
+	k, err := newSSHKey(source, dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, source, k.file)
+	assert.Equal(t, "GIT_SSH_COMMAND=ssh -i "+k.file+" -o IdentitiesOnly=yes -o StrictHostKeyChecking=no -F /dev/null", k.env())
+
+	// The copy is readable only by the owner to be accepted by ssh.
+	info, err := os.Stat(k.file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	data, err := os.ReadFile(k.file)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", string(data))
+
+	changed, err := k.reload()
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// The rotated key is picked up.
+	require.NoError(t, os.WriteFile(source, []byte("key-2"), 0644))
+	changed, err = k.reload()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	data, err = os.ReadFile(k.file)
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", string(data))
+
+	// The previous key is kept while the source file is missing.
+	require.NoError(t, os.Remove(source))
+	_, err = k.reload()
+	assert.Error(t, err)
+	data, err = os.ReadFile(k.file)
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", string(data))
+}
+
+func TestClientSSHKeyForRepo(t *testing.T) {
+	t.Parallel()
+
+	source := filepath.Join(t.TempDir(), "deploy-key")
+	require.NoError(t, os.WriteFile(source, []byte("key"), 0600))
+
+	gc, err := NewClient(
+		WithGitEnv("GIT_TERMINAL_PROMPT=0"),
+		WithSSHKeyForRepo("git@github.com:org/repo-1.git", source),
+	)
+	require.NoError(t, err)
+	c := gc.(*client)
+	defer func() {
+		require.NoError(t, c.Clean())
+		_, err := os.Stat(c.sshKeyDir)
+		assert.True(t, os.IsNotExist(err))
+	}()
+
+	k := c.sshKeys["git@github.com:org/repo-1.git"]
+	require.NotNil(t, k)
+	assert.Equal(t, []string{"GIT_TERMINAL_PROMPT=0", k.env()}, c.envsForRepo("git@github.com:org/repo-1.git"))
+	assert.Equal(t, []string{"GIT_TERMINAL_PROMPT=0"}, c.envsForRepo("git@github.com:org/repo-2.git"))
+
+	_, err = NewClient(WithSSHKeyForRepo("git@github.com:org/repo-1.git", filepath.Join(t.TempDir(), "missing")))
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The hash of the tagged commit is placed at the last field for annotated tags
+// while it is empty for lightweight ones.
+const tagRefFormat = "%(refname:strip=2)" + delimiter +
+	"%(objectname)" + delimiter +
+	"%(*objectname)"
+
+type Tag struct {
+	Name string
+	// The hash of the commit pointed by this tag.
+	Hash string
+}
+
+func parseTags(out string) ([]Tag, error) {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	tags := make([]Tag, 0, len(lines))
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, delimiter)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid tag: tag line should contain 3 fields but got %d", len(fields))
+		}
+		tag := Tag{
+			Name: fields[0],
+			Hash: fields[1],
+		}
+		if fields[2] != "" {
+			tag.Hash = fields[2]
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
@@ -20,18 +20,54 @@ import (
 	"strings"
 )
 
+// outOfSyncShortReasonFormat is the format of the short reason of the sync state
+// reporting the numbers of the resources differing between Git and the live state.
+const outOfSyncShortReasonFormat = "There are %d manifests not synced (%d adds, %d deletes, %d changes)"
+
 const (
 	DefaultApplicationConfigFilename    = "app.pipecd.yaml"
 	oldDefaultApplicationConfigFilename = ".pipe.yaml"
 	applicationConfigFileExtention      = ".pipecd.yaml"
 )
 
+const (
+	// BranchApplicationTemplateLabelKey is the label key holding the ID of the template application
+	// of the application registered to deploy a Git branch.
+	BranchApplicationTemplateLabelKey = "pipecd.dev/branch-template"
+	// BranchApplicationBranchLabelKey is the label key holding the Git branch
+	// deployed by the application registered for it.
+	BranchApplicationBranchLabelKey = "pipecd.dev/branch"
+)
+
 // GetApplicationConfigFilePath returns the path to application configuration file.
+// The explicitly specified path takes precedence over the one derived from the application directory.
 func (p ApplicationGitPath) GetApplicationConfigFilePath() string {
+	if p.ConfigFilePath != "" {
+		return filepath.Clean(p.ConfigFilePath)
+	}
 	return filepath.Join(p.Path, p.GetApplicationConfigFilename())
 }
 
+// ValidateConfigFilePath checks whether the explicitly specified path to the application configuration file
+// is a relative path staying within the repository.
+func (p *ApplicationGitPath) ValidateConfigFilePath() error {
+	if p.ConfigFilePath == "" {
+		return nil
+	}
+	if filepath.IsAbs(p.ConfigFilePath) {
+		return fmt.Errorf("config file path %s must be relative to the repository root", p.ConfigFilePath)
+	}
+	path := filepath.Clean(p.ConfigFilePath)
+	if path == "." || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("config file path %s must be within the repository", p.ConfigFilePath)
+	}
+	return nil
+}
+
 func (p ApplicationGitPath) GetApplicationConfigFilename() string {
+	if p.ConfigFilePath != "" {
+		return filepath.Base(p.ConfigFilePath)
+	}
 	// The config file name used to allow to be empty until the default name got changed.
 	// So empty means the old default name.
 	filename := oldDefaultApplicationConfigFilename
@@ -56,6 +92,36 @@ func (s ApplicationSyncState) HasChanged(next ApplicationSyncState) bool {
 	return false
 }
 
+// OutOfSyncResources represents the numbers of the resources differing between Git and the live state.
+type OutOfSyncResources struct {
+	// The live resources not defined in Git.
+	Extra int
+	// The resources defined in Git but not found in the live state.
+	Missing int
+	// The resources whose live state differs from the one defined in Git.
+	Changed int
+}
+
+// MakeOutOfSyncShortReason returns the short reason of the sync state
+// reporting the given numbers of the differing resources.
+func MakeOutOfSyncShortReason(r OutOfSyncResources) string {
+	return fmt.Sprintf(outOfSyncShortReasonFormat, r.Extra+r.Missing+r.Changed, r.Extra, r.Missing, r.Changed)
+}
+
+// GetOutOfSyncResources returns the numbers of the differing resources reported by the short reason.
+// False is returned when the short reason does not report them,
+// e.g. the application kinds not supporting it.
+func (s *ApplicationSyncState) GetOutOfSyncResources() (OutOfSyncResources, bool) {
+	var (
+		r     OutOfSyncResources
+		total int
+	)
+	if _, err := fmt.Sscanf(s.GetShortReason(), outOfSyncShortReasonFormat, &total, &r.Extra, &r.Missing, &r.Changed); err != nil {
+		return OutOfSyncResources{}, false
+	}
+	return r, true
+}
+
 func MakeApplicationURL(baseURL, applicationID string) string {
 	return fmt.Sprintf("%s/applications/%s", strings.TrimSuffix(baseURL, "/"), applicationID)
 }
@@ -92,3 +158,10 @@ func (a *Application) SetUpdatedAt(t int64) {
 func IsApplicationConfigFile(filename string) bool {
 	return filename == DefaultApplicationConfigFilename || strings.HasSuffix(filename, applicationConfigFileExtention) || filename == oldDefaultApplicationConfigFilename
 }
+
+// IsBranchApplication reports whether the application was registered to deploy a Git branch
+// by copying a template application.
+func (a *Application) IsBranchApplication() bool {
+	_, ok := a.Labels[BranchApplicationTemplateLabelKey]
+	return ok
+}
@@ -258,6 +258,10 @@ func (m *Application) validate(all bool) error {
 
 	// no validation rules for Deploying
 
+	// no validation rules for LastHandledCommit
+
+	// no validation rules for LastHandledCommitAt
+
 	if m.GetDeletedAt() < 0 {
 		err := ApplicationValidationError{
 			field:  "DeletedAt",
@@ -48,6 +48,90 @@ func TestMakeApplicationURL(t *testing.T) {
 	}
 }
 
+func TestApplicationGitPath_GetApplicationConfigFilePath(t *testing.T) {
+	testcases := []struct {
+		name             string
+		gitPath          *ApplicationGitPath
+		expectedPath     string
+		expectedFilename string
+	}{
+		{
+			name:             "derived from the old default filename",
+			gitPath:          &ApplicationGitPath{Path: "apps/app-1"},
+			expectedPath:     "apps/app-1/.pipe.yaml",
+			expectedFilename: ".pipe.yaml",
+		},
+		{
+			name:             "derived from the config filename",
+			gitPath:          &ApplicationGitPath{Path: "apps/app-1", ConfigFilename: "app.pipecd.yaml"},
+			expectedPath:     "apps/app-1/app.pipecd.yaml",
+			expectedFilename: "app.pipecd.yaml",
+		},
+		{
+			name: "explicitly specified",
+			gitPath: &ApplicationGitPath{
+				Path:           "apps/app-1",
+				ConfigFilename: "app.pipecd.yaml",
+				ConfigFilePath: ".pipecd/./app-1.pipecd.yaml",
+			},
+			expectedPath:     ".pipecd/app-1.pipecd.yaml",
+			expectedFilename: "app-1.pipecd.yaml",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedPath, tc.gitPath.GetApplicationConfigFilePath())
+			assert.Equal(t, tc.expectedFilename, tc.gitPath.GetApplicationConfigFilename())
+		})
+	}
+}
+
+func TestApplicationGitPath_ValidateConfigFilePath(t *testing.T) {
+	testcases := []struct {
+		name           string
+		configFilePath string
+		wantErr        bool
+	}{
+		{
+			name:           "not specified",
+			configFilePath: "",
+			wantErr:        false,
+		},
+		{
+			name:           "within the repository",
+			configFilePath: ".pipecd/app-1.pipecd.yaml",
+			wantErr:        false,
+		},
+		{
+			name:           "going back within the repository",
+			configFilePath: "apps/../.pipecd/app-1.pipecd.yaml",
+			wantErr:        false,
+		},
+		{
+			name:           "absolute path",
+			configFilePath: "/etc/app-1.pipecd.yaml",
+			wantErr:        true,
+		},
+		{
+			name:           "outside the repository",
+			configFilePath: ".pipecd/../../app-1.pipecd.yaml",
+			wantErr:        true,
+		},
+		{
+			name:           "repository root",
+			configFilePath: "./",
+			wantErr:        true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &ApplicationGitPath{ConfigFilePath: tc.configFilePath}
+			err := p.ValidateConfigFilePath()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestApplication_ContainLabels(t *testing.T) {
 	testcases := []struct {
 		name   string
@@ -97,3 +181,20 @@ func TestApplication_ContainLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOutOfSyncResources(t *testing.T) {
+	r := OutOfSyncResources{Extra: 1, Missing: 2, Changed: 3}
+	s := &ApplicationSyncState{ShortReason: MakeOutOfSyncShortReason(r)}
+	assert.Equal(t, "There are 6 manifests not synced (1 adds, 2 deletes, 3 changes)", s.ShortReason)
+	got, ok := s.GetOutOfSyncResources()
+	assert.True(t, ok)
+	assert.Equal(t, r, got)
+
+	s = &ApplicationSyncState{ShortReason: "The service manifest doesn't be synced"}
+	_, ok = s.GetOutOfSyncResources()
+	assert.False(t, ok)
+
+	var nilState *ApplicationSyncState
+	_, ok = nilState.GetOutOfSyncResources()
+	assert.False(t, ok)
+}
@@ -18,6 +18,7 @@ import "context"
 
 const (
 	MetadataKeyTriggeredDeploymentID = "TriggeredDeploymentID"
+	MetadataKeyTriggerError          = "TriggerError"
 )
 
 type ReportableCommand struct {
@@ -37,6 +38,10 @@ func (c *Command) IsChainSyncApplicationCmd() bool {
 	return c.GetChainSyncApplication() != nil
 }
 
+func (c *Command) IsRefreshLastTriggeredCommitCmd() bool {
+	return c.GetRefreshLastTriggeredCommit() != nil
+}
+
 func (c *Command) SetUpdatedAt(t int64) {
 	c.UpdatedAt = t
 }
@@ -317,6 +317,35 @@ func (m *Command) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRefreshLastTriggeredCommit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RefreshLastTriggeredCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RefreshLastTriggeredCommit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshLastTriggeredCommit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "RefreshLastTriggeredCommit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := CommandValidationError{
 			field:  "CreatedAt",
@@ -451,6 +480,12 @@ func (m *Command_SyncApplication) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for TargetCommitHash
+
+	// no validation rules for ExternalSource
+
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return Command_SyncApplicationMultiError(errors)
 	}
@@ -1308,3 +1343,119 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Command_SkipStageValidationError{}
+
+// Validate checks the field values on Command_RefreshLastTriggeredCommit with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *Command_RefreshLastTriggeredCommit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command_RefreshLastTriggeredCommit
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// Command_RefreshLastTriggeredCommitMultiError, or nil if none found.
+func (m *Command_RefreshLastTriggeredCommit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_RefreshLastTriggeredCommit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := Command_RefreshLastTriggeredCommitValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Command_RefreshLastTriggeredCommitMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_RefreshLastTriggeredCommitMultiError is an error wrapping multiple
+// validation errors returned by
+// Command_RefreshLastTriggeredCommit.ValidateAll() if the designated
+// constraints aren't met.
+type Command_RefreshLastTriggeredCommitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_RefreshLastTriggeredCommitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_RefreshLastTriggeredCommitMultiError) AllErrors() []error { return m }
+
+// Command_RefreshLastTriggeredCommitValidationError is the validation error
+// returned by Command_RefreshLastTriggeredCommit.Validate if the designated
+// constraints aren't met.
+type Command_RefreshLastTriggeredCommitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_RefreshLastTriggeredCommitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_RefreshLastTriggeredCommitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_RefreshLastTriggeredCommitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_RefreshLastTriggeredCommitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_RefreshLastTriggeredCommitValidationError) ErrorName() string {
+	return "Command_RefreshLastTriggeredCommitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_RefreshLastTriggeredCommitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand_RefreshLastTriggeredCommit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_RefreshLastTriggeredCommitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_RefreshLastTriggeredCommitValidationError{}
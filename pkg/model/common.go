@@ -23,6 +23,21 @@ func ApplicationKindStrings() []string {
 	return out
 }
 
+// SyncStrategyStrings returns a list of available sync strategies in string.
+func SyncStrategyStrings() []string {
+	out := make([]string, 0, len(SyncStrategy_value))
+	for s := range SyncStrategy_value {
+		out = append(out, s)
+	}
+	return out
+}
+
+// IsValid reports whether the strategy is one of the defined ones.
+func (s SyncStrategy) IsValid() bool {
+	_, ok := SyncStrategy_name[int32(s)]
+	return ok
+}
+
 // ContainLabels checks if it has all the given labels.
 func (a *ApplicationInfo) ContainLabels(labels map[string]string) bool {
 	if len(a.Labels) < len(labels) {
@@ -114,6 +114,8 @@ func (m *ApplicationGitPath) validate(all bool) error {
 
 	// no validation rules for Url
 
+	// no validation rules for ConfigFilePath
+
 	if len(errors) > 0 {
 		return ApplicationGitPathMultiError(errors)
 	}
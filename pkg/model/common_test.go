@@ -140,3 +140,10 @@ func TestApplicationInfo_ContainLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncStrategyIsValid(t *testing.T) {
+	assert.True(t, SyncStrategy_AUTO.IsValid())
+	assert.True(t, SyncStrategy_QUICK_SYNC.IsValid())
+	assert.True(t, SyncStrategy_PIPELINE.IsValid())
+	assert.False(t, SyncStrategy(100).IsValid())
+}
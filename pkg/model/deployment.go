@@ -22,6 +22,14 @@ import (
 
 const (
 	MetadataKeyDeploymentNotification = "DeploymentNotification"
+	MetadataKeyTriggerCondition       = "TriggerCondition"
+	MetadataKeyTriggerReason          = "TriggerReason"
+	MetadataKeyTriggerSource          = "TriggerSource"
+	MetadataKeyCommitAuthor           = "CommitAuthor"
+	MetadataKeyCommitAuthorEmail      = "CommitAuthorEmail"
+	MetadataKeyPullRequestNumber      = "PullRequestNumber"
+	MetadataKeyTriggerLatency         = "TriggerLatency"
+	MetadataKeyRevertedCommit         = "RevertedCommit"
 )
 
 var notCompletedDeploymentStatuses = []DeploymentStatus{
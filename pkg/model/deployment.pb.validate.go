@@ -220,6 +220,8 @@ func (m *Deployment) validate(all bool) error {
 
 	// no validation rules for Summary
 
+	// no validation rules for Description
+
 	// no validation rules for Version
 
 	for idx, item := range m.GetVersions() {
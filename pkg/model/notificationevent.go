@@ -97,3 +97,20 @@ func (e *NotificationEventApplicationOutOfSync) GetAppName() string {
 func (e *NotificationEventApplicationOutOfSync) GetLabels() map[string]string {
 	return e.Application.Labels
 }
+
+func (e *NotificationEventDeploymentTriggerSkippedInvalidConfig) GetAppName() string {
+	return e.Application.Name
+}
+
+func (e *NotificationEventDeploymentTriggerSkippedInvalidConfig) GetLabels() map[string]string {
+	return e.Application.Labels
+}
+
+// GetLabels returns the label used to group the deployments
+// so that the routes can be matched against it.
+func (e *NotificationEventDeploymentsTriggered) GetLabels() map[string]string {
+	if e.GroupLabel == "" {
+		return nil
+	}
+	return map[string]string{e.GroupLabel: e.GroupValue}
+}
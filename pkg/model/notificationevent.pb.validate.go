@@ -98,6 +98,8 @@ func (m *NotificationEventDeploymentTriggered) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ChangesSummary
+
 	if len(errors) > 0 {
 		return NotificationEventDeploymentTriggeredMultiError(errors)
 	}
@@ -1384,6 +1386,353 @@ var _ interface {
 	ErrorName() string
 } = NotificationEventDeploymentTriggerFailedValidationError{}
 
+// Validate checks the field values on
+// NotificationEventDeploymentTriggerSkippedInvalidConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *NotificationEventDeploymentTriggerSkippedInvalidConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// NotificationEventDeploymentTriggerSkippedInvalidConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError, or nil if
+// none found.
+func (m *NotificationEventDeploymentTriggerSkippedInvalidConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentTriggerSkippedInvalidConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetApplication() == nil {
+		err := NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+			field:  "Application",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetConfigPath()) < 1 {
+		err := NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+			field:  "ConfigPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CommitHash
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError is an error
+// wrapping multiple validation errors returned by
+// NotificationEventDeploymentTriggerSkippedInvalidConfig.ValidateAll() if the
+// designated constraints aren't met.
+type NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentTriggerSkippedInvalidConfigMultiError) AllErrors() []error {
+	return m
+}
+
+// NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError is
+// the validation error returned by
+// NotificationEventDeploymentTriggerSkippedInvalidConfig.Validate if the
+// designated constraints aren't met.
+type NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) ErrorName() string {
+	return "NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentTriggerSkippedInvalidConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentTriggerSkippedInvalidConfigValidationError{}
+
+// Validate checks the field values on NotificationEventDeploymentsTriggered
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *NotificationEventDeploymentsTriggered) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventDeploymentsTriggered
+// with the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentsTriggeredMultiError, or nil if none found.
+func (m *NotificationEventDeploymentsTriggered) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentsTriggered) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeployments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationEventDeploymentsTriggeredValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationEventDeploymentsTriggeredValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationEventDeploymentsTriggeredValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCommitHash()) < 1 {
+		err := NotificationEventDeploymentsTriggeredValidationError{
+			field:  "CommitHash",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GroupLabel
+
+	// no validation rules for GroupValue
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentsTriggeredMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentsTriggeredMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventDeploymentsTriggered.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventDeploymentsTriggeredMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentsTriggeredMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentsTriggeredMultiError) AllErrors() []error {
+	return m
+}
+
+// NotificationEventDeploymentsTriggeredValidationError is the validation error
+// returned by NotificationEventDeploymentsTriggered.Validate if the designated
+// constraints aren't met.
+type NotificationEventDeploymentsTriggeredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentsTriggeredValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentsTriggeredValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentsTriggeredValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e NotificationEventDeploymentsTriggeredValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentsTriggeredValidationError) ErrorName() string {
+	return "NotificationEventDeploymentsTriggeredValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentsTriggeredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentsTriggered.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentsTriggeredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentsTriggeredValidationError{}
+
 // Validate checks the field values on NotificationEventApplicationSynced with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -2029,3 +2378,476 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = NotificationEventPipedStoppedValidationError{}
+
+// Validate checks the field values on NotificationEventGitRepoPullFailed with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *NotificationEventGitRepoPullFailed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventGitRepoPullFailed
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// NotificationEventGitRepoPullFailedMultiError, or nil if none found.
+func (m *NotificationEventGitRepoPullFailed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventGitRepoPullFailed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := NotificationEventGitRepoPullFailedValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := NotificationEventGitRepoPullFailedValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := NotificationEventGitRepoPullFailedValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Branch
+
+	// no validation rules for Failures
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := NotificationEventGitRepoPullFailedValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NotificationEventGitRepoPullFailedMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventGitRepoPullFailedMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventGitRepoPullFailed.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventGitRepoPullFailedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventGitRepoPullFailedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventGitRepoPullFailedMultiError) AllErrors() []error { return m }
+
+// NotificationEventGitRepoPullFailedValidationError is the validation error
+// returned by NotificationEventGitRepoPullFailed.Validate if the designated
+// constraints aren't met.
+type NotificationEventGitRepoPullFailedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventGitRepoPullFailedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventGitRepoPullFailedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventGitRepoPullFailedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventGitRepoPullFailedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventGitRepoPullFailedValidationError) ErrorName() string {
+	return "NotificationEventGitRepoPullFailedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventGitRepoPullFailedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventGitRepoPullFailed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventGitRepoPullFailedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventGitRepoPullFailedValidationError{}
+
+// Validate checks the field values on
+// NotificationEventGitCommitSignatureUnverified with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *NotificationEventGitCommitSignatureUnverified) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// NotificationEventGitCommitSignatureUnverified with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// NotificationEventGitCommitSignatureUnverifiedMultiError, or nil if none
+// found.
+func (m *NotificationEventGitCommitSignatureUnverified) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventGitCommitSignatureUnverified) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := NotificationEventGitCommitSignatureUnverifiedValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := NotificationEventGitCommitSignatureUnverifiedValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := NotificationEventGitCommitSignatureUnverifiedValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Branch
+
+	if utf8.RuneCountInString(m.GetCommitHash()) < 1 {
+		err := NotificationEventGitCommitSignatureUnverifiedValidationError{
+			field:  "CommitHash",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CommitAuthor
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := NotificationEventGitCommitSignatureUnverifiedValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NotificationEventGitCommitSignatureUnverifiedMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventGitCommitSignatureUnverifiedMultiError is an error wrapping
+// multiple validation errors returned by
+// NotificationEventGitCommitSignatureUnverified.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventGitCommitSignatureUnverifiedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventGitCommitSignatureUnverifiedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventGitCommitSignatureUnverifiedMultiError) AllErrors() []error { return m }
+
+// NotificationEventGitCommitSignatureUnverifiedValidationError is the
+// validation error returned by
+// NotificationEventGitCommitSignatureUnverified.Validate if the designated
+// constraints aren't met.
+type NotificationEventGitCommitSignatureUnverifiedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) ErrorName() string {
+	return "NotificationEventGitCommitSignatureUnverifiedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventGitCommitSignatureUnverifiedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventGitCommitSignatureUnverified.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventGitCommitSignatureUnverifiedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventGitCommitSignatureUnverifiedValidationError{}
+
+// Validate checks the field values on
+// NotificationEventGitRepoApplicationLimitExceeded with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NotificationEventGitRepoApplicationLimitExceeded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// NotificationEventGitRepoApplicationLimitExceeded with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in
+// NotificationEventGitRepoApplicationLimitExceededMultiError, or nil if none
+// found.
+func (m *NotificationEventGitRepoApplicationLimitExceeded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventGitRepoApplicationLimitExceeded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := NotificationEventGitRepoApplicationLimitExceededValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := NotificationEventGitRepoApplicationLimitExceededValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := NotificationEventGitRepoApplicationLimitExceededValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Applications
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return NotificationEventGitRepoApplicationLimitExceededMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventGitRepoApplicationLimitExceededMultiError is an error
+// wrapping multiple validation errors returned by
+// NotificationEventGitRepoApplicationLimitExceeded.ValidateAll() if the
+// designated constraints aren't met.
+type NotificationEventGitRepoApplicationLimitExceededMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventGitRepoApplicationLimitExceededMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventGitRepoApplicationLimitExceededMultiError) AllErrors() []error { return m }
+
+// NotificationEventGitRepoApplicationLimitExceededValidationError is the
+// validation error returned by
+// NotificationEventGitRepoApplicationLimitExceeded.Validate if the designated
+// constraints aren't met.
+type NotificationEventGitRepoApplicationLimitExceededValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) ErrorName() string {
+	return "NotificationEventGitRepoApplicationLimitExceededValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventGitRepoApplicationLimitExceededValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventGitRepoApplicationLimitExceeded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventGitRepoApplicationLimitExceededValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventGitRepoApplicationLimitExceededValidationError{}
@@ -263,6 +263,8 @@ func (m *Project) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TriggerPaused
+
 	if len(errors) > 0 {
 		return ProjectMultiError(errors)
 	}
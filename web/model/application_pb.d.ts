@@ -56,6 +56,12 @@ export class Application extends jspb.Message {
   getDeploying(): boolean;
   setDeploying(value: boolean): Application;
 
+  getLastHandledCommit(): string;
+  setLastHandledCommit(value: string): Application;
+
+  getLastHandledCommitAt(): number;
+  setLastHandledCommitAt(value: number): Application;
+
   getDeletedAt(): number;
   setDeletedAt(value: number): Application;
 
@@ -95,6 +101,8 @@ export namespace Application {
     mostRecentlyTriggeredDeployment?: ApplicationDeploymentReference.AsObject,
     syncState?: ApplicationSyncState.AsObject,
     deploying: boolean,
+    lastHandledCommit: string,
+    lastHandledCommitAt: number,
     deletedAt: number,
     deleted: boolean,
     disabled: boolean,
@@ -192,4 +200,5 @@ export enum ApplicationSyncStatus {
   SYNCED = 1,
   DEPLOYING = 2,
   OUT_OF_SYNC = 3,
+  INVALID_CONFIG = 4,
 }
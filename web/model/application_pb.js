@@ -140,6 +140,8 @@ proto.model.Application.toObject = function(includeInstance, msg) {
     mostRecentlyTriggeredDeployment: (f = msg.getMostRecentlyTriggeredDeployment()) && proto.model.ApplicationDeploymentReference.toObject(includeInstance, f),
     syncState: (f = msg.getSyncState()) && proto.model.ApplicationSyncState.toObject(includeInstance, f),
     deploying: jspb.Message.getBooleanFieldWithDefault(msg, 14, false),
+    lastHandledCommit: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    lastHandledCommitAt: jspb.Message.getFieldWithDefault(msg, 16, 0),
     deletedAt: jspb.Message.getFieldWithDefault(msg, 98, 0),
     deleted: jspb.Message.getBooleanFieldWithDefault(msg, 99, false),
     disabled: jspb.Message.getBooleanFieldWithDefault(msg, 100, false),
@@ -243,6 +245,14 @@ proto.model.Application.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setDeploying(value);
       break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastHandledCommit(value);
+      break;
+    case 16:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastHandledCommitAt(value);
+      break;
     case 98:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setDeletedAt(value);
@@ -391,6 +401,20 @@ proto.model.Application.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getLastHandledCommit();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getLastHandledCommitAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      16,
+      f
+    );
+  }
   f = message.getDeletedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -761,6 +785,42 @@ proto.model.Application.prototype.setDeploying = function(value) {
 };
 
 
+/**
+ * optional string last_handled_commit = 15;
+ * @return {string}
+ */
+proto.model.Application.prototype.getLastHandledCommit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Application} returns this
+ */
+proto.model.Application.prototype.setLastHandledCommit = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional int64 last_handled_commit_at = 16;
+ * @return {number}
+ */
+proto.model.Application.prototype.getLastHandledCommitAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.Application} returns this
+ */
+proto.model.Application.prototype.setLastHandledCommitAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
 /**
  * optional int64 deleted_at = 98;
  * @return {number}
@@ -1499,7 +1559,8 @@ proto.model.ApplicationSyncStatus = {
   UNKNOWN: 0,
   SYNCED: 1,
   DEPLOYING: 2,
-  OUT_OF_SYNC: 3
+  OUT_OF_SYNC: 3,
+  INVALID_CONFIG: 4
 };
 
 goog.object.extend(exports, proto.model);
@@ -73,6 +73,11 @@ export class Command extends jspb.Message {
   hasSkipStage(): boolean;
   clearSkipStage(): Command;
 
+  getRefreshLastTriggeredCommit(): Command.RefreshLastTriggeredCommit | undefined;
+  setRefreshLastTriggeredCommit(value?: Command.RefreshLastTriggeredCommit): Command;
+  hasRefreshLastTriggeredCommit(): boolean;
+  clearRefreshLastTriggeredCommit(): Command;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): Command;
 
@@ -107,6 +112,7 @@ export namespace Command {
     buildPlanPreview?: Command.BuildPlanPreview.AsObject,
     chainSyncApplication?: Command.ChainSyncApplication.AsObject,
     skipStage?: Command.SkipStage.AsObject,
+    refreshLastTriggeredCommit?: Command.RefreshLastTriggeredCommit.AsObject,
     createdAt: number,
     updatedAt: number,
   }
@@ -118,6 +124,15 @@ export namespace Command {
     getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
     setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplication;
 
+    getTargetCommitHash(): string;
+    setTargetCommitHash(value: string): SyncApplication;
+
+    getExternalSource(): string;
+    setExternalSource(value: string): SyncApplication;
+
+    getDescription(): string;
+    setDescription(value: string): SyncApplication;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SyncApplication.AsObject;
     static toObject(includeInstance: boolean, msg: SyncApplication): SyncApplication.AsObject;
@@ -130,6 +145,9 @@ export namespace Command {
     export type AsObject = {
       applicationId: string,
       syncStrategy: pkg_model_common_pb.SyncStrategy,
+      targetCommitHash: string,
+      externalSource: string,
+      description: string,
     }
   }
 
@@ -300,6 +318,25 @@ export namespace Command {
   }
 
 
+  export class RefreshLastTriggeredCommit extends jspb.Message {
+    getApplicationId(): string;
+    setApplicationId(value: string): RefreshLastTriggeredCommit;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RefreshLastTriggeredCommit.AsObject;
+    static toObject(includeInstance: boolean, msg: RefreshLastTriggeredCommit): RefreshLastTriggeredCommit.AsObject;
+    static serializeBinaryToWriter(message: RefreshLastTriggeredCommit, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RefreshLastTriggeredCommit;
+    static deserializeBinaryFromReader(message: RefreshLastTriggeredCommit, reader: jspb.BinaryReader): RefreshLastTriggeredCommit;
+  }
+
+  export namespace RefreshLastTriggeredCommit {
+    export type AsObject = {
+      applicationId: string,
+    }
+  }
+
+
   export enum Type { 
     SYNC_APPLICATION = 0,
     UPDATE_APPLICATION_CONFIG = 1,
@@ -308,6 +345,7 @@ export namespace Command {
     BUILD_PLAN_PREVIEW = 4,
     CHAIN_SYNC_APPLICATION = 5,
     SKIP_STAGE = 6,
+    REFRESH_LAST_TRIGGERED_COMMIT = 7,
   }
 }
 
@@ -30,6 +30,7 @@ goog.exportSymbol('proto.model.Command.ApproveStage', null, global);
 goog.exportSymbol('proto.model.Command.BuildPlanPreview', null, global);
 goog.exportSymbol('proto.model.Command.CancelDeployment', null, global);
 goog.exportSymbol('proto.model.Command.ChainSyncApplication', null, global);
+goog.exportSymbol('proto.model.Command.RefreshLastTriggeredCommit', null, global);
 goog.exportSymbol('proto.model.Command.SkipStage', null, global);
 goog.exportSymbol('proto.model.Command.SyncApplication', null, global);
 goog.exportSymbol('proto.model.Command.Type', null, global);
@@ -203,6 +204,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.Command.SkipStage.displayName = 'proto.model.Command.SkipStage';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.Command.RefreshLastTriggeredCommit = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.Command.RefreshLastTriggeredCommit, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.Command.RefreshLastTriggeredCommit.displayName = 'proto.model.Command.RefreshLastTriggeredCommit';
+}
 
 
 
@@ -253,6 +275,7 @@ proto.model.Command.toObject = function(includeInstance, msg) {
     buildPlanPreview: (f = msg.getBuildPlanPreview()) && proto.model.Command.BuildPlanPreview.toObject(includeInstance, f),
     chainSyncApplication: (f = msg.getChainSyncApplication()) && proto.model.Command.ChainSyncApplication.toObject(includeInstance, f),
     skipStage: (f = msg.getSkipStage()) && proto.model.Command.SkipStage.toObject(includeInstance, f),
+    refreshLastTriggeredCommit: (f = msg.getRefreshLastTriggeredCommit()) && proto.model.Command.RefreshLastTriggeredCommit.toObject(includeInstance, f),
     createdAt: jspb.Message.getFieldWithDefault(msg, 100, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 101, 0)
   };
@@ -372,6 +395,11 @@ proto.model.Command.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.model.Command.SkipStage.deserializeBinaryFromReader);
       msg.setSkipStage(value);
       break;
+    case 38:
+      var value = new proto.model.Command.RefreshLastTriggeredCommit;
+      reader.readMessage(value,proto.model.Command.RefreshLastTriggeredCommit.deserializeBinaryFromReader);
+      msg.setRefreshLastTriggeredCommit(value);
+      break;
     case 100:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
@@ -539,6 +567,14 @@ proto.model.Command.serializeBinaryToWriter = function(message, writer) {
       proto.model.Command.SkipStage.serializeBinaryToWriter
     );
   }
+  f = message.getRefreshLastTriggeredCommit();
+  if (f != null) {
+    writer.writeMessage(
+      38,
+      f,
+      proto.model.Command.RefreshLastTriggeredCommit.serializeBinaryToWriter
+    );
+  }
   f = message.getCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -566,7 +602,8 @@ proto.model.Command.Type = {
   APPROVE_STAGE: 3,
   BUILD_PLAN_PREVIEW: 4,
   CHAIN_SYNC_APPLICATION: 5,
-  SKIP_STAGE: 6
+  SKIP_STAGE: 6,
+  REFRESH_LAST_TRIGGERED_COMMIT: 7
 };
 
 
@@ -602,7 +639,10 @@ proto.model.Command.SyncApplication.prototype.toObject = function(opt_includeIns
 proto.model.Command.SyncApplication.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    targetCommitHash: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    externalSource: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -647,6 +687,18 @@ proto.model.Command.SyncApplication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetCommitHash(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExternalSource(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -690,6 +742,27 @@ proto.model.Command.SyncApplication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getTargetCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getExternalSource();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -729,6 +802,60 @@ proto.model.Command.SyncApplication.prototype.setSyncStrategy = function(value)
 };
 
 
+/**
+ * optional string target_commit_hash = 3;
+ * @return {string}
+ */
+proto.model.Command.SyncApplication.prototype.getTargetCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setTargetCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string external_source = 4;
+ * @return {string}
+ */
+proto.model.Command.SyncApplication.prototype.getExternalSource = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setExternalSource = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string description = 5;
+ * @return {string}
+ */
+proto.model.Command.SyncApplication.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
 
 
 
@@ -1899,6 +2026,137 @@ proto.model.Command.SkipStage.prototype.setStageId = function(value) {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.Command.RefreshLastTriggeredCommit.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.Command.RefreshLastTriggeredCommit.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.Command.RefreshLastTriggeredCommit} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.Command.RefreshLastTriggeredCommit.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.Command.RefreshLastTriggeredCommit}
+ */
+proto.model.Command.RefreshLastTriggeredCommit.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.Command.RefreshLastTriggeredCommit;
+  return proto.model.Command.RefreshLastTriggeredCommit.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.Command.RefreshLastTriggeredCommit} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.Command.RefreshLastTriggeredCommit}
+ */
+proto.model.Command.RefreshLastTriggeredCommit.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.Command.RefreshLastTriggeredCommit.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.Command.RefreshLastTriggeredCommit.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.Command.RefreshLastTriggeredCommit} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.Command.RefreshLastTriggeredCommit.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.model.Command.RefreshLastTriggeredCommit.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.RefreshLastTriggeredCommit} returns this
+ */
+proto.model.Command.RefreshLastTriggeredCommit.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
 /**
  * optional string id = 1;
  * @return {string}
@@ -2360,6 +2618,43 @@ proto.model.Command.prototype.hasSkipStage = function() {
 };
 
 
+/**
+ * optional RefreshLastTriggeredCommit refresh_last_triggered_commit = 38;
+ * @return {?proto.model.Command.RefreshLastTriggeredCommit}
+ */
+proto.model.Command.prototype.getRefreshLastTriggeredCommit = function() {
+  return /** @type{?proto.model.Command.RefreshLastTriggeredCommit} */ (
+    jspb.Message.getWrapperField(this, proto.model.Command.RefreshLastTriggeredCommit, 38));
+};
+
+
+/**
+ * @param {?proto.model.Command.RefreshLastTriggeredCommit|undefined} value
+ * @return {!proto.model.Command} returns this
+*/
+proto.model.Command.prototype.setRefreshLastTriggeredCommit = function(value) {
+  return jspb.Message.setWrapperField(this, 38, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.Command} returns this
+ */
+proto.model.Command.prototype.clearRefreshLastTriggeredCommit = function() {
+  return this.setRefreshLastTriggeredCommit(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.Command.prototype.hasRefreshLastTriggeredCommit = function() {
+  return jspb.Message.getField(this, 38) != null;
+};
+
+
 /**
  * optional int64 created_at = 100;
  * @return {number}
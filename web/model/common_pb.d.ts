@@ -21,6 +21,9 @@ export class ApplicationGitPath extends jspb.Message {
   getUrl(): string;
   setUrl(value: string): ApplicationGitPath;
 
+  getConfigFilePath(): string;
+  setConfigFilePath(value: string): ApplicationGitPath;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ApplicationGitPath.AsObject;
   static toObject(includeInstance: boolean, msg: ApplicationGitPath): ApplicationGitPath.AsObject;
@@ -36,6 +39,7 @@ export namespace ApplicationGitPath {
     configPath: string,
     configFilename: string,
     url: string,
+    configFilePath: string,
   }
 }
 
@@ -151,7 +151,8 @@ proto.model.ApplicationGitPath.toObject = function(includeInstance, msg) {
     path: jspb.Message.getFieldWithDefault(msg, 2, ""),
     configPath: jspb.Message.getFieldWithDefault(msg, 3, ""),
     configFilename: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    url: jspb.Message.getFieldWithDefault(msg, 5, "")
+    url: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    configFilePath: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -209,6 +210,10 @@ proto.model.ApplicationGitPath.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.setUrl(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConfigFilePath(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -273,6 +278,12 @@ proto.model.ApplicationGitPath.serializeBinaryToWriter = function(message, write
       5,
       f
     );
+  }  f = message.getConfigFilePath();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
   }
 };
 
@@ -386,6 +397,24 @@ proto.model.ApplicationGitPath.prototype.setUrl = function(value) {
 };
 
 
+/**
+ * optional string config_file_path = 6;
+ * @return {string}
+ */
+proto.model.ApplicationGitPath.prototype.getConfigFilePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ApplicationGitPath} returns this
+ */
+proto.model.ApplicationGitPath.prototype.setConfigFilePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
 
 
 
@@ -45,6 +45,9 @@ export class Deployment extends jspb.Message {
   getSummary(): string;
   setSummary(value: string): Deployment;
 
+  getDescription(): string;
+  setDescription(value: string): Deployment;
+
   getVersion(): string;
   setVersion(value: string): Deployment;
 
@@ -110,6 +113,7 @@ export namespace Deployment {
     labelsMap: Array<[string, string]>,
     trigger?: DeploymentTrigger.AsObject,
     summary: string,
+    description: string,
     version: string,
     versionsList: Array<pkg_model_common_pb.ArtifactVersion.AsObject>,
     runningCommitHash: string,
@@ -298,4 +302,6 @@ export enum TriggerKind {
   ON_COMMAND = 1,
   ON_OUT_OF_SYNC = 2,
   ON_CHAIN = 3,
+  ON_EXTERNAL = 4,
+  ON_SCHEDULE = 5,
 }
@@ -167,6 +167,7 @@ proto.model.Deployment.toObject = function(includeInstance, msg) {
     labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
     trigger: (f = msg.getTrigger()) && proto.model.DeploymentTrigger.toObject(includeInstance, f),
     summary: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 25, ""),
     version: jspb.Message.getFieldWithDefault(msg, 23, ""),
     versionsList: jspb.Message.toObjectList(msg.getVersionsList(),
     pkg_model_common_pb.ArtifactVersion.toObject, includeInstance),
@@ -270,6 +271,10 @@ proto.model.Deployment.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setSummary(value);
       break;
+    case 25:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
     case 23:
       var value = /** @type {string} */ (reader.readString());
       msg.setVersion(value);
@@ -438,6 +443,13 @@ proto.model.Deployment.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      25,
+      f
+    );
+  }
   f = message.getVersion();
   if (f.length > 0) {
     writer.writeString(
@@ -789,6 +801,24 @@ proto.model.Deployment.prototype.setSummary = function(value) {
 };
 
 
+/**
+ * optional string description = 25;
+ * @return {string}
+ */
+proto.model.Deployment.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 25, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Deployment} returns this
+ */
+proto.model.Deployment.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 25, value);
+};
+
+
 /**
  * optional string version = 23;
  * @return {string}
@@ -2229,7 +2259,9 @@ proto.model.TriggerKind = {
   ON_COMMIT: 0,
   ON_COMMAND: 1,
   ON_OUT_OF_SYNC: 2,
-  ON_CHAIN: 3
+  ON_CHAIN: 3,
+  ON_EXTERNAL: 4,
+  ON_SCHEDULE: 5
 };
 
 goog.object.extend(exports, proto.model);
@@ -16,6 +16,9 @@ export class NotificationEventDeploymentTriggered extends jspb.Message {
   clearMentionedAccountsList(): NotificationEventDeploymentTriggered;
   addMentionedAccounts(value: string, index?: number): NotificationEventDeploymentTriggered;
 
+  getChangesSummary(): string;
+  setChangesSummary(value: string): NotificationEventDeploymentTriggered;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentTriggered.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentTriggered): NotificationEventDeploymentTriggered.AsObject;
@@ -28,6 +31,7 @@ export namespace NotificationEventDeploymentTriggered {
   export type AsObject = {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
+    changesSummary: string,
   }
 }
 
@@ -261,6 +265,76 @@ export namespace NotificationEventDeploymentTriggerFailed {
   }
 }
 
+export class NotificationEventDeploymentTriggerSkippedInvalidConfig extends jspb.Message {
+  getApplication(): pkg_model_application_pb.Application | undefined;
+  setApplication(value?: pkg_model_application_pb.Application): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+  hasApplication(): boolean;
+  clearApplication(): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+
+  getConfigPath(): string;
+  setConfigPath(value: string): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+
+  getCommitHash(): string;
+  setCommitHash(value: string): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+
+  getReason(): string;
+  setReason(value: string): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventDeploymentTriggerSkippedInvalidConfig.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventDeploymentTriggerSkippedInvalidConfig): NotificationEventDeploymentTriggerSkippedInvalidConfig.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventDeploymentTriggerSkippedInvalidConfig, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+  static deserializeBinaryFromReader(message: NotificationEventDeploymentTriggerSkippedInvalidConfig, reader: jspb.BinaryReader): NotificationEventDeploymentTriggerSkippedInvalidConfig;
+}
+
+export namespace NotificationEventDeploymentTriggerSkippedInvalidConfig {
+  export type AsObject = {
+    application?: pkg_model_application_pb.Application.AsObject,
+    configPath: string,
+    commitHash: string,
+    reason: string,
+  }
+}
+
+export class NotificationEventDeploymentsTriggered extends jspb.Message {
+  getDeploymentsList(): Array<pkg_model_deployment_pb.Deployment>;
+  setDeploymentsList(value: Array<pkg_model_deployment_pb.Deployment>): NotificationEventDeploymentsTriggered;
+  clearDeploymentsList(): NotificationEventDeploymentsTriggered;
+  addDeployments(value?: pkg_model_deployment_pb.Deployment, index?: number): pkg_model_deployment_pb.Deployment;
+
+  getCommitHash(): string;
+  setCommitHash(value: string): NotificationEventDeploymentsTriggered;
+
+  getGroupLabel(): string;
+  setGroupLabel(value: string): NotificationEventDeploymentsTriggered;
+
+  getGroupValue(): string;
+  setGroupValue(value: string): NotificationEventDeploymentsTriggered;
+
+  getMentionedAccountsList(): Array<string>;
+  setMentionedAccountsList(value: Array<string>): NotificationEventDeploymentsTriggered;
+  clearMentionedAccountsList(): NotificationEventDeploymentsTriggered;
+  addMentionedAccounts(value: string, index?: number): NotificationEventDeploymentsTriggered;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventDeploymentsTriggered.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventDeploymentsTriggered): NotificationEventDeploymentsTriggered.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventDeploymentsTriggered, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventDeploymentsTriggered;
+  static deserializeBinaryFromReader(message: NotificationEventDeploymentsTriggered, reader: jspb.BinaryReader): NotificationEventDeploymentsTriggered;
+}
+
+export namespace NotificationEventDeploymentsTriggered {
+  export type AsObject = {
+    deploymentsList: Array<pkg_model_deployment_pb.Deployment.AsObject>,
+    commitHash: string,
+    groupLabel: string,
+    groupValue: string,
+    mentionedAccountsList: Array<string>,
+  }
+}
+
 export class NotificationEventApplicationSynced extends jspb.Message {
   getApplication(): pkg_model_application_pb.Application | undefined;
   setApplication(value?: pkg_model_application_pb.Application): NotificationEventApplicationSynced;
@@ -373,6 +447,120 @@ export namespace NotificationEventPipedStopped {
   }
 }
 
+export class NotificationEventGitRepoPullFailed extends jspb.Message {
+  getPipedId(): string;
+  setPipedId(value: string): NotificationEventGitRepoPullFailed;
+
+  getProjectId(): string;
+  setProjectId(value: string): NotificationEventGitRepoPullFailed;
+
+  getRepoId(): string;
+  setRepoId(value: string): NotificationEventGitRepoPullFailed;
+
+  getBranch(): string;
+  setBranch(value: string): NotificationEventGitRepoPullFailed;
+
+  getFailures(): number;
+  setFailures(value: number): NotificationEventGitRepoPullFailed;
+
+  getReason(): string;
+  setReason(value: string): NotificationEventGitRepoPullFailed;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventGitRepoPullFailed.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventGitRepoPullFailed): NotificationEventGitRepoPullFailed.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventGitRepoPullFailed, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventGitRepoPullFailed;
+  static deserializeBinaryFromReader(message: NotificationEventGitRepoPullFailed, reader: jspb.BinaryReader): NotificationEventGitRepoPullFailed;
+}
+
+export namespace NotificationEventGitRepoPullFailed {
+  export type AsObject = {
+    pipedId: string,
+    projectId: string,
+    repoId: string,
+    branch: string,
+    failures: number,
+    reason: string,
+  }
+}
+
+export class NotificationEventGitCommitSignatureUnverified extends jspb.Message {
+  getPipedId(): string;
+  setPipedId(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getProjectId(): string;
+  setProjectId(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getRepoId(): string;
+  setRepoId(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getBranch(): string;
+  setBranch(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getCommitHash(): string;
+  setCommitHash(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getCommitAuthor(): string;
+  setCommitAuthor(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  getReason(): string;
+  setReason(value: string): NotificationEventGitCommitSignatureUnverified;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventGitCommitSignatureUnverified.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventGitCommitSignatureUnverified): NotificationEventGitCommitSignatureUnverified.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventGitCommitSignatureUnverified, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventGitCommitSignatureUnverified;
+  static deserializeBinaryFromReader(message: NotificationEventGitCommitSignatureUnverified, reader: jspb.BinaryReader): NotificationEventGitCommitSignatureUnverified;
+}
+
+export namespace NotificationEventGitCommitSignatureUnverified {
+  export type AsObject = {
+    pipedId: string,
+    projectId: string,
+    repoId: string,
+    branch: string,
+    commitHash: string,
+    commitAuthor: string,
+    reason: string,
+  }
+}
+
+export class NotificationEventGitRepoApplicationLimitExceeded extends jspb.Message {
+  getPipedId(): string;
+  setPipedId(value: string): NotificationEventGitRepoApplicationLimitExceeded;
+
+  getProjectId(): string;
+  setProjectId(value: string): NotificationEventGitRepoApplicationLimitExceeded;
+
+  getRepoId(): string;
+  setRepoId(value: string): NotificationEventGitRepoApplicationLimitExceeded;
+
+  getApplications(): number;
+  setApplications(value: number): NotificationEventGitRepoApplicationLimitExceeded;
+
+  getLimit(): number;
+  setLimit(value: number): NotificationEventGitRepoApplicationLimitExceeded;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventGitRepoApplicationLimitExceeded.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventGitRepoApplicationLimitExceeded): NotificationEventGitRepoApplicationLimitExceeded.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventGitRepoApplicationLimitExceeded, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventGitRepoApplicationLimitExceeded;
+  static deserializeBinaryFromReader(message: NotificationEventGitRepoApplicationLimitExceeded, reader: jspb.BinaryReader): NotificationEventGitRepoApplicationLimitExceeded;
+}
+
+export namespace NotificationEventGitRepoApplicationLimitExceeded {
+  export type AsObject = {
+    pipedId: string,
+    projectId: string,
+    repoId: string,
+    applications: number,
+    limit: number,
+  }
+}
+
 export enum NotificationEventType { 
   EVENT_DEPLOYMENT_TRIGGERED = 0,
   EVENT_DEPLOYMENT_PLANNED = 1,
@@ -383,11 +571,16 @@ export enum NotificationEventType {
   EVENT_DEPLOYMENT_CANCELLED = 6,
   EVENT_DEPLOYMENT_WAIT_APPROVAL = 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED = 8,
+  EVENT_DEPLOYMENT_TRIGGER_SKIPPED_INVALID_CONFIG = 9,
+  EVENT_DEPLOYMENTS_TRIGGERED = 10,
   EVENT_APPLICATION_SYNCED = 100,
   EVENT_APPLICATION_OUT_OF_SYNC = 101,
   EVENT_APPLICATION_HEALTHY = 200,
   EVENT_PIPED_STARTED = 300,
   EVENT_PIPED_STOPPED = 301,
+  EVENT_GIT_REPO_PULL_FAILED = 302,
+  EVENT_GIT_COMMIT_SIGNATURE_UNVERIFIED = 303,
+  EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED = 304,
 }
 export enum NotificationEventGroup { 
   EVENT_NONE = 0,
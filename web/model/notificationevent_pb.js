@@ -36,8 +36,13 @@ goog.exportSymbol('proto.model.NotificationEventDeploymentPlanned', null, global
 goog.exportSymbol('proto.model.NotificationEventDeploymentRollingBack', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentSucceeded', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentTriggerFailed', null, global);
+goog.exportSymbol('proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentTriggered', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentWaitApproval', null, global);
+goog.exportSymbol('proto.model.NotificationEventDeploymentsTriggered', null, global);
+goog.exportSymbol('proto.model.NotificationEventGitCommitSignatureUnverified', null, global);
+goog.exportSymbol('proto.model.NotificationEventGitRepoApplicationLimitExceeded', null, global);
+goog.exportSymbol('proto.model.NotificationEventGitRepoPullFailed', null, global);
 goog.exportSymbol('proto.model.NotificationEventGroup', null, global);
 goog.exportSymbol('proto.model.NotificationEventPipedStarted', null, global);
 goog.exportSymbol('proto.model.NotificationEventPipedStopped', null, global);
@@ -231,6 +236,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.NotificationEventDeploymentTriggerFailed.displayName = 'proto.model.NotificationEventDeploymentTriggerFailed';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.displayName = 'proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventDeploymentsTriggered = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NotificationEventDeploymentsTriggered.repeatedFields_, null);
+};
+goog.inherits(proto.model.NotificationEventDeploymentsTriggered, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventDeploymentsTriggered.displayName = 'proto.model.NotificationEventDeploymentsTriggered';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -315,6 +362,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.NotificationEventPipedStopped.displayName = 'proto.model.NotificationEventPipedStopped';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventGitRepoPullFailed = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.NotificationEventGitRepoPullFailed, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventGitRepoPullFailed.displayName = 'proto.model.NotificationEventGitRepoPullFailed';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.NotificationEventGitCommitSignatureUnverified, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventGitCommitSignatureUnverified.displayName = 'proto.model.NotificationEventGitCommitSignatureUnverified';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventGitRepoApplicationLimitExceeded = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.NotificationEventGitRepoApplicationLimitExceeded, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventGitRepoApplicationLimitExceeded.displayName = 'proto.model.NotificationEventGitRepoApplicationLimitExceeded';
+}
 
 /**
  * List of repeated fields within this message type.
@@ -355,7 +465,8 @@ proto.model.NotificationEventDeploymentTriggered.prototype.toObject = function(o
 proto.model.NotificationEventDeploymentTriggered.toObject = function(includeInstance, msg) {
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
-    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    changesSummary: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -401,6 +512,10 @@ proto.model.NotificationEventDeploymentTriggered.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedAccounts(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChangesSummary(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -445,6 +560,13 @@ proto.model.NotificationEventDeploymentTriggered.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getChangesSummary();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -522,6 +644,24 @@ proto.model.NotificationEventDeploymentTriggered.prototype.clearMentionedAccount
 };
 
 
+/**
+ * optional string changes_summary = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.getChangesSummary = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.setChangesSummary = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -2334,7 +2474,6 @@ proto.model.NotificationEventDeploymentTriggerFailed.prototype.clearMentionedAcc
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2348,8 +2487,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventApplicationSynced.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventApplicationSynced.toObject(opt_includeInstance, this);
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.toObject(opt_includeInstance, this);
 };
 
 
@@ -2358,14 +2497,16 @@ proto.model.NotificationEventApplicationSynced.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventApplicationSynced} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventApplicationSynced.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.toObject = function(includeInstance, msg) {
   var f, obj = {
     application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f),
-    state: (f = msg.getState()) && pkg_model_application_pb.ApplicationSyncState.toObject(includeInstance, f)
+    configPath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    commitHash: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -2379,23 +2520,23 @@ proto.model.NotificationEventApplicationSynced.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventApplicationSynced}
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig}
  */
-proto.model.NotificationEventApplicationSynced.deserializeBinary = function(bytes) {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventApplicationSynced;
-  return proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig;
+  return proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventApplicationSynced} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventApplicationSynced}
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig}
  */
-proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2407,10 +2548,17 @@ proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader = fun
       reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
       msg.setApplication(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConfigPath(value);
+      break;
     case 3:
-      var value = new pkg_model_application_pb.ApplicationSyncState;
-      reader.readMessage(value,pkg_model_application_pb.ApplicationSyncState.deserializeBinaryFromReader);
-      msg.setState(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitHash(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
       break;
     default:
       reader.skipField();
@@ -2425,9 +2573,9 @@ proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventApplicationSynced.prototype.serializeBinary = function() {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2435,11 +2583,11 @@ proto.model.NotificationEventApplicationSynced.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventApplicationSynced} message
+ * @param {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getApplication();
   if (f != null) {
@@ -2449,12 +2597,25 @@ proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter = functio
       pkg_model_application_pb.Application.serializeBinaryToWriter
     );
   }
-  f = message.getState();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getConfigPath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
       3,
-      f,
-      pkg_model_application_pb.ApplicationSyncState.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
@@ -2464,7 +2625,7 @@ proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter = functio
  * optional Application application = 1;
  * @return {?proto.model.Application}
  */
-proto.model.NotificationEventApplicationSynced.prototype.getApplication = function() {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.getApplication = function() {
   return /** @type{?proto.model.Application} */ (
     jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
 };
@@ -2472,18 +2633,18 @@ proto.model.NotificationEventApplicationSynced.prototype.getApplication = functi
 
 /**
  * @param {?proto.model.Application|undefined} value
- * @return {!proto.model.NotificationEventApplicationSynced} returns this
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} returns this
 */
-proto.model.NotificationEventApplicationSynced.prototype.setApplication = function(value) {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.setApplication = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventApplicationSynced} returns this
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} returns this
  */
-proto.model.NotificationEventApplicationSynced.prototype.clearApplication = function() {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.clearApplication = function() {
   return this.setApplication(undefined);
 };
 
@@ -2492,49 +2653,73 @@ proto.model.NotificationEventApplicationSynced.prototype.clearApplication = func
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.model.NotificationEventApplicationSynced.prototype.hasApplication = function() {
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.hasApplication = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional ApplicationSyncState state = 3;
- * @return {?proto.model.ApplicationSyncState}
+ * optional string config_path = 2;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationSynced.prototype.getState = function() {
-  return /** @type{?proto.model.ApplicationSyncState} */ (
-    jspb.Message.getWrapperField(this, pkg_model_application_pb.ApplicationSyncState, 3));
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.getConfigPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.model.ApplicationSyncState|undefined} value
- * @return {!proto.model.NotificationEventApplicationSynced} returns this
-*/
-proto.model.NotificationEventApplicationSynced.prototype.setState = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} returns this
+ */
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.setConfigPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventApplicationSynced} returns this
+ * optional string commit_hash = 3;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationSynced.prototype.clearState = function() {
-  return this.setState(undefined);
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} returns this
  */
-proto.model.NotificationEventApplicationSynced.prototype.hasState = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string reason = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig} returns this
+ */
+proto.model.NotificationEventDeploymentTriggerSkippedInvalidConfig.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.NotificationEventDeploymentsTriggered.repeatedFields_ = [1,5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2550,8 +2735,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventApplicationOutOfSync.toObject(opt_includeInstance, this);
+proto.model.NotificationEventDeploymentsTriggered.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentsTriggered.toObject(opt_includeInstance, this);
 };
 
 
@@ -2560,14 +2745,18 @@ proto.model.NotificationEventApplicationOutOfSync.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventApplicationOutOfSync} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventDeploymentsTriggered} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventApplicationOutOfSync.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventDeploymentsTriggered.toObject = function(includeInstance, msg) {
   var f, obj = {
-    application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f),
-    state: (f = msg.getState()) && pkg_model_application_pb.ApplicationSyncState.toObject(includeInstance, f)
+    deploymentsList: jspb.Message.toObjectList(msg.getDeploymentsList(),
+    pkg_model_deployment_pb.Deployment.toObject, includeInstance),
+    commitHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    groupLabel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    groupValue: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2581,23 +2770,23 @@ proto.model.NotificationEventApplicationOutOfSync.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventApplicationOutOfSync}
+ * @return {!proto.model.NotificationEventDeploymentsTriggered}
  */
-proto.model.NotificationEventApplicationOutOfSync.deserializeBinary = function(bytes) {
+proto.model.NotificationEventDeploymentsTriggered.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventApplicationOutOfSync;
-  return proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventDeploymentsTriggered;
+  return proto.model.NotificationEventDeploymentsTriggered.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventApplicationOutOfSync} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventDeploymentsTriggered} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventApplicationOutOfSync}
+ * @return {!proto.model.NotificationEventDeploymentsTriggered}
  */
-proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventDeploymentsTriggered.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2605,14 +2794,25 @@ proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_application_pb.Application;
-      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
-      msg.setApplication(value);
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.addDeployments(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitHash(value);
       break;
     case 3:
-      var value = new pkg_model_application_pb.ApplicationSyncState;
-      reader.readMessage(value,pkg_model_application_pb.ApplicationSyncState.deserializeBinaryFromReader);
-      msg.setState(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGroupLabel(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGroupValue(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedAccounts(value);
       break;
     default:
       reader.skipField();
@@ -2627,9 +2827,9 @@ proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.serializeBinary = function() {
+proto.model.NotificationEventDeploymentsTriggered.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventApplicationOutOfSync.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventDeploymentsTriggered.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2637,11 +2837,289 @@ proto.model.NotificationEventApplicationOutOfSync.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventApplicationOutOfSync} message
+ * @param {!proto.model.NotificationEventDeploymentsTriggered} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventApplicationOutOfSync.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventDeploymentsTriggered.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+  f = message.getCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGroupLabel();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getGroupValue();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMentionedAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated Deployment deployments = 1;
+ * @return {!Array<!proto.model.Deployment>}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.getDeploymentsList = function() {
+  return /** @type{!Array<!proto.model.Deployment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.Deployment>} value
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+*/
+proto.model.NotificationEventDeploymentsTriggered.prototype.setDeploymentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.Deployment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Deployment}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.addDeployments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Deployment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.clearDeploymentsList = function() {
+  return this.setDeploymentsList([]);
+};
+
+
+/**
+ * optional string commit_hash = 2;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string group_label = 3;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.getGroupLabel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.setGroupLabel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string group_value = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.getGroupValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.setGroupValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated string mentioned_accounts = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentsTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentsTriggered.prototype.clearMentionedAccountsList = function() {
+  return this.setMentionedAccountsList([]);
+};
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventApplicationSynced.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventApplicationSynced} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventApplicationSynced.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f),
+    state: (f = msg.getState()) && pkg_model_application_pb.ApplicationSyncState.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventApplicationSynced}
+ */
+proto.model.NotificationEventApplicationSynced.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventApplicationSynced;
+  return proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventApplicationSynced} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventApplicationSynced}
+ */
+proto.model.NotificationEventApplicationSynced.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_application_pb.Application;
+      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
+      msg.setApplication(value);
+      break;
+    case 3:
+      var value = new pkg_model_application_pb.ApplicationSyncState;
+      reader.readMessage(value,pkg_model_application_pb.ApplicationSyncState.deserializeBinaryFromReader);
+      msg.setState(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventApplicationSynced} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventApplicationSynced.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getApplication();
   if (f != null) {
@@ -2663,76 +3141,998 @@ proto.model.NotificationEventApplicationOutOfSync.serializeBinaryToWriter = func
 
 
 /**
- * optional Application application = 1;
- * @return {?proto.model.Application}
+ * optional Application application = 1;
+ * @return {?proto.model.Application}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.getApplication = function() {
+  return /** @type{?proto.model.Application} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
+};
+
+
+/**
+ * @param {?proto.model.Application|undefined} value
+ * @return {!proto.model.NotificationEventApplicationSynced} returns this
+*/
+proto.model.NotificationEventApplicationSynced.prototype.setApplication = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventApplicationSynced} returns this
+ */
+proto.model.NotificationEventApplicationSynced.prototype.clearApplication = function() {
+  return this.setApplication(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.hasApplication = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ApplicationSyncState state = 3;
+ * @return {?proto.model.ApplicationSyncState}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.getState = function() {
+  return /** @type{?proto.model.ApplicationSyncState} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_pb.ApplicationSyncState, 3));
+};
+
+
+/**
+ * @param {?proto.model.ApplicationSyncState|undefined} value
+ * @return {!proto.model.NotificationEventApplicationSynced} returns this
+*/
+proto.model.NotificationEventApplicationSynced.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventApplicationSynced} returns this
+ */
+proto.model.NotificationEventApplicationSynced.prototype.clearState = function() {
+  return this.setState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventApplicationSynced.prototype.hasState = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventApplicationOutOfSync.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventApplicationOutOfSync} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventApplicationOutOfSync.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    application: (f = msg.getApplication()) && pkg_model_application_pb.Application.toObject(includeInstance, f),
+    state: (f = msg.getState()) && pkg_model_application_pb.ApplicationSyncState.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventApplicationOutOfSync}
+ */
+proto.model.NotificationEventApplicationOutOfSync.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventApplicationOutOfSync;
+  return proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventApplicationOutOfSync} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventApplicationOutOfSync}
+ */
+proto.model.NotificationEventApplicationOutOfSync.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_application_pb.Application;
+      reader.readMessage(value,pkg_model_application_pb.Application.deserializeBinaryFromReader);
+      msg.setApplication(value);
+      break;
+    case 3:
+      var value = new pkg_model_application_pb.ApplicationSyncState;
+      reader.readMessage(value,pkg_model_application_pb.ApplicationSyncState.deserializeBinaryFromReader);
+      msg.setState(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventApplicationOutOfSync.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventApplicationOutOfSync} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventApplicationOutOfSync.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApplication();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_application_pb.Application.serializeBinaryToWriter
+    );
+  }
+  f = message.getState();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      pkg_model_application_pb.ApplicationSyncState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Application application = 1;
+ * @return {?proto.model.Application}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.getApplication = function() {
+  return /** @type{?proto.model.Application} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
+};
+
+
+/**
+ * @param {?proto.model.Application|undefined} value
+ * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+*/
+proto.model.NotificationEventApplicationOutOfSync.prototype.setApplication = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.clearApplication = function() {
+  return this.setApplication(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.hasApplication = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ApplicationSyncState state = 3;
+ * @return {?proto.model.ApplicationSyncState}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.getState = function() {
+  return /** @type{?proto.model.ApplicationSyncState} */ (
+    jspb.Message.getWrapperField(this, pkg_model_application_pb.ApplicationSyncState, 3));
+};
+
+
+/**
+ * @param {?proto.model.ApplicationSyncState|undefined} value
+ * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+*/
+proto.model.NotificationEventApplicationOutOfSync.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.clearState = function() {
+  return this.setState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventApplicationOutOfSync.prototype.hasState = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventPipedStarted.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventPipedStarted.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventPipedStarted} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventPipedStarted.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventPipedStarted}
+ */
+proto.model.NotificationEventPipedStarted.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventPipedStarted;
+  return proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventPipedStarted} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventPipedStarted}
+ */
+proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProjectId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventPipedStarted.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventPipedStarted.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventPipedStarted} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventPipedStarted.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getProjectId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStarted.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStarted} returns this
+ */
+proto.model.NotificationEventPipedStarted.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStarted.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStarted} returns this
+ */
+proto.model.NotificationEventPipedStarted.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string version = 3;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStarted.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStarted} returns this
+ */
+proto.model.NotificationEventPipedStarted.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string project_id = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStarted.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStarted} returns this
+ */
+proto.model.NotificationEventPipedStarted.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventPipedStopped.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventPipedStopped.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventPipedStopped} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventPipedStopped.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventPipedStopped}
+ */
+proto.model.NotificationEventPipedStopped.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventPipedStopped;
+  return proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventPipedStopped} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventPipedStopped}
+ */
+proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProjectId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventPipedStopped.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventPipedStopped.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventPipedStopped} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventPipedStopped.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getProjectId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStopped.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStopped} returns this
+ */
+proto.model.NotificationEventPipedStopped.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStopped.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStopped} returns this
+ */
+proto.model.NotificationEventPipedStopped.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string version = 3;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStopped.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStopped} returns this
+ */
+proto.model.NotificationEventPipedStopped.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string project_id = 4;
+ * @return {string}
+ */
+proto.model.NotificationEventPipedStopped.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventPipedStopped} returns this
+ */
+proto.model.NotificationEventPipedStopped.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventGitRepoPullFailed.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventGitRepoPullFailed} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventGitRepoPullFailed.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    repoId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    branch: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    failures: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventGitRepoPullFailed}
+ */
+proto.model.NotificationEventGitRepoPullFailed.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventGitRepoPullFailed;
+  return proto.model.NotificationEventGitRepoPullFailed.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventGitRepoPullFailed} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventGitRepoPullFailed}
+ */
+proto.model.NotificationEventGitRepoPullFailed.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProjectId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRepoId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBranch(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFailures(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventGitRepoPullFailed.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventGitRepoPullFailed} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventGitRepoPullFailed.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProjectId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRepoId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getBranch();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getFailures();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string piped_id = 1;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.getApplication = function() {
-  return /** @type{?proto.model.Application} */ (
-    jspb.Message.getWrapperField(this, pkg_model_application_pb.Application, 1));
+proto.model.NotificationEventGitRepoPullFailed.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.model.Application|undefined} value
- * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
-*/
-proto.model.NotificationEventApplicationOutOfSync.prototype.setApplication = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+ * optional string project_id = 2;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.clearApplication = function() {
-  return this.setApplication(undefined);
+proto.model.NotificationEventGitRepoPullFailed.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.hasApplication = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.model.NotificationEventGitRepoPullFailed.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional ApplicationSyncState state = 3;
- * @return {?proto.model.ApplicationSyncState}
+ * optional string repo_id = 3;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.getState = function() {
-  return /** @type{?proto.model.ApplicationSyncState} */ (
-    jspb.Message.getWrapperField(this, pkg_model_application_pb.ApplicationSyncState, 3));
+proto.model.NotificationEventGitRepoPullFailed.prototype.getRepoId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.model.ApplicationSyncState|undefined} value
- * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
-*/
-proto.model.NotificationEventApplicationOutOfSync.prototype.setState = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.setRepoId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.NotificationEventApplicationOutOfSync} returns this
+ * optional string branch = 4;
+ * @return {string}
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.clearState = function() {
-  return this.setState(undefined);
+proto.model.NotificationEventGitRepoPullFailed.prototype.getBranch = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
  */
-proto.model.NotificationEventApplicationOutOfSync.prototype.hasState = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.model.NotificationEventGitRepoPullFailed.prototype.setBranch = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int32 failures = 5;
+ * @return {number}
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.getFailures = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.setFailures = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string reason = 6;
+ * @return {string}
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitRepoPullFailed} returns this
+ */
+proto.model.NotificationEventGitRepoPullFailed.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -2752,8 +4152,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventPipedStarted.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventPipedStarted.toObject(opt_includeInstance, this);
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventGitCommitSignatureUnverified.toObject(opt_includeInstance, this);
 };
 
 
@@ -2762,16 +4162,19 @@ proto.model.NotificationEventPipedStarted.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventPipedStarted} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventGitCommitSignatureUnverified} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventPipedStarted.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventGitCommitSignatureUnverified.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    version: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    projectId: jspb.Message.getFieldWithDefault(msg, 4, "")
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    repoId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    branch: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    commitHash: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    commitAuthor: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -2785,23 +4188,23 @@ proto.model.NotificationEventPipedStarted.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventPipedStarted}
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified}
  */
-proto.model.NotificationEventPipedStarted.deserializeBinary = function(bytes) {
+proto.model.NotificationEventGitCommitSignatureUnverified.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventPipedStarted;
-  return proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventGitCommitSignatureUnverified;
+  return proto.model.NotificationEventGitCommitSignatureUnverified.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventPipedStarted} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventGitCommitSignatureUnverified} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventPipedStarted}
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified}
  */
-proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventGitCommitSignatureUnverified.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2810,19 +4213,31 @@ proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setPipedId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setProjectId(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVersion(value);
+      msg.setRepoId(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProjectId(value);
+      msg.setBranch(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitHash(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitAuthor(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
       break;
     default:
       reader.skipField();
@@ -2837,9 +4252,9 @@ proto.model.NotificationEventPipedStarted.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventPipedStarted.prototype.serializeBinary = function() {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventPipedStarted.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventGitCommitSignatureUnverified.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2847,115 +4262,189 @@ proto.model.NotificationEventPipedStarted.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventPipedStarted} message
+ * @param {!proto.model.NotificationEventGitCommitSignatureUnverified} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventPipedStarted.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventGitCommitSignatureUnverified.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getPipedId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getProjectId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getVersion();
+  f = message.getRepoId();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getProjectId();
+  f = message.getBranch();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
+  f = message.getCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getCommitAuthor();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string piped_id = 1;
  * @return {string}
  */
-proto.model.NotificationEventPipedStarted.prototype.getId = function() {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getPipedId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStarted} returns this
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
  */
-proto.model.NotificationEventPipedStarted.prototype.setId = function(value) {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setPipedId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string project_id = 2;
  * @return {string}
  */
-proto.model.NotificationEventPipedStarted.prototype.getName = function() {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getProjectId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStarted} returns this
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
  */
-proto.model.NotificationEventPipedStarted.prototype.setName = function(value) {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setProjectId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string version = 3;
+ * optional string repo_id = 3;
  * @return {string}
  */
-proto.model.NotificationEventPipedStarted.prototype.getVersion = function() {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getRepoId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStarted} returns this
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
  */
-proto.model.NotificationEventPipedStarted.prototype.setVersion = function(value) {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setRepoId = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string project_id = 4;
+ * optional string branch = 4;
  * @return {string}
  */
-proto.model.NotificationEventPipedStarted.prototype.getProjectId = function() {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getBranch = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStarted} returns this
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
  */
-proto.model.NotificationEventPipedStarted.prototype.setProjectId = function(value) {
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setBranch = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
+/**
+ * optional string commit_hash = 5;
+ * @return {string}
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string commit_author = 6;
+ * @return {string}
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getCommitAuthor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setCommitAuthor = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string reason = 7;
+ * @return {string}
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.NotificationEventGitCommitSignatureUnverified} returns this
+ */
+proto.model.NotificationEventGitCommitSignatureUnverified.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
 
 
 
@@ -2972,8 +4461,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.NotificationEventPipedStopped.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.NotificationEventPipedStopped.toObject(opt_includeInstance, this);
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventGitRepoApplicationLimitExceeded.toObject(opt_includeInstance, this);
 };
 
 
@@ -2982,16 +4471,17 @@ proto.model.NotificationEventPipedStopped.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.NotificationEventPipedStopped} msg The msg instance to transform.
+ * @param {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventPipedStopped.toObject = function(includeInstance, msg) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    version: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    projectId: jspb.Message.getFieldWithDefault(msg, 4, "")
+    pipedId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    repoId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    applications: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -3005,23 +4495,23 @@ proto.model.NotificationEventPipedStopped.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.NotificationEventPipedStopped}
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded}
  */
-proto.model.NotificationEventPipedStopped.deserializeBinary = function(bytes) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.NotificationEventPipedStopped;
-  return proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NotificationEventGitRepoApplicationLimitExceeded;
+  return proto.model.NotificationEventGitRepoApplicationLimitExceeded.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.NotificationEventPipedStopped} msg The message object to deserialize into.
+ * @param {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.NotificationEventPipedStopped}
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded}
  */
-proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3030,19 +4520,23 @@ proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setPipedId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setProjectId(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVersion(value);
+      msg.setRepoId(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProjectId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setApplications(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
       break;
     default:
       reader.skipField();
@@ -3057,9 +4551,9 @@ proto.model.NotificationEventPipedStopped.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.NotificationEventPipedStopped.prototype.serializeBinary = function() {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.NotificationEventPipedStopped.serializeBinaryToWriter(this, writer);
+  proto.model.NotificationEventGitRepoApplicationLimitExceeded.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3067,112 +4561,136 @@ proto.model.NotificationEventPipedStopped.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.NotificationEventPipedStopped} message
+ * @param {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.NotificationEventPipedStopped.serializeBinaryToWriter = function(message, writer) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getPipedId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getProjectId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getVersion();
+  f = message.getRepoId();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getProjectId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getApplications();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
 };
 
-
 /**
- * optional string id = 1;
+ * optional string piped_id = 1;
  * @return {string}
  */
-proto.model.NotificationEventPipedStopped.prototype.getId = function() {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.getPipedId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStopped} returns this
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} returns this
  */
-proto.model.NotificationEventPipedStopped.prototype.setId = function(value) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.setPipedId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string project_id = 2;
  * @return {string}
  */
-proto.model.NotificationEventPipedStopped.prototype.getName = function() {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.getProjectId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStopped} returns this
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} returns this
  */
-proto.model.NotificationEventPipedStopped.prototype.setName = function(value) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.setProjectId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string version = 3;
+ * optional string repo_id = 3;
  * @return {string}
  */
-proto.model.NotificationEventPipedStopped.prototype.getVersion = function() {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.getRepoId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.NotificationEventPipedStopped} returns this
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} returns this
  */
-proto.model.NotificationEventPipedStopped.prototype.setVersion = function(value) {
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.setRepoId = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string project_id = 4;
- * @return {string}
+ * optional int32 applications = 4;
+ * @return {number}
  */
-proto.model.NotificationEventPipedStopped.prototype.getProjectId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.getApplications = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.model.NotificationEventPipedStopped} returns this
+ * @param {number} value
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} returns this
  */
-proto.model.NotificationEventPipedStopped.prototype.setProjectId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.setApplications = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 limit = 5;
+ * @return {number}
+ */
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.NotificationEventGitRepoApplicationLimitExceeded} returns this
+ */
+proto.model.NotificationEventGitRepoApplicationLimitExceeded.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
@@ -3189,11 +4707,16 @@ proto.model.NotificationEventType = {
   EVENT_DEPLOYMENT_CANCELLED: 6,
   EVENT_DEPLOYMENT_WAIT_APPROVAL: 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED: 8,
+  EVENT_DEPLOYMENT_TRIGGER_SKIPPED_INVALID_CONFIG: 9,
+  EVENT_DEPLOYMENTS_TRIGGERED: 10,
   EVENT_APPLICATION_SYNCED: 100,
   EVENT_APPLICATION_OUT_OF_SYNC: 101,
   EVENT_APPLICATION_HEALTHY: 200,
   EVENT_PIPED_STARTED: 300,
-  EVENT_PIPED_STOPPED: 301
+  EVENT_PIPED_STOPPED: 301,
+  EVENT_GIT_REPO_PULL_FAILED: 302,
+  EVENT_GIT_COMMIT_SIGNATURE_UNVERIFIED: 303,
+  EVENT_GIT_REPO_APPLICATION_LIMIT_EXCEEDED: 304
 };
 
 /**
@@ -50,6 +50,9 @@ export class Project extends jspb.Message {
   getUpdatedAt(): number;
   setUpdatedAt(value: number): Project;
 
+  getTriggerPaused(): boolean;
+  setTriggerPaused(value: boolean): Project;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Project.AsObject;
   static toObject(includeInstance: boolean, msg: Project): Project.AsObject;
@@ -72,6 +75,7 @@ export namespace Project {
     userGroupsList: Array<ProjectUserGroup.AsObject>,
     createdAt: number,
     updatedAt: number,
+    triggerPaused: boolean,
   }
 }
 
@@ -298,7 +298,8 @@ proto.model.Project.toObject = function(includeInstance, msg) {
     userGroupsList: jspb.Message.toObjectList(msg.getUserGroupsList(),
     proto.model.ProjectUserGroup.toObject, includeInstance),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
-    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    triggerPaused: jspb.Message.getBooleanFieldWithDefault(msg, 16, false)
   };
 
   if (includeInstance) {
@@ -388,6 +389,10 @@ proto.model.Project.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setUpdatedAt(value);
       break;
+    case 16:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTriggerPaused(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -506,6 +511,13 @@ proto.model.Project.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getTriggerPaused();
+  if (f) {
+    writer.writeBool(
+      16,
+      f
+    );
+  }
 };
 
 
@@ -822,6 +834,24 @@ proto.model.Project.prototype.setUpdatedAt = function(value) {
 };
 
 
+/**
+ * optional bool trigger_paused = 11;
+ * @return {boolean}
+ */
+proto.model.Project.prototype.getTriggerPaused = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 16, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.Project} returns this
+ */
+proto.model.Project.prototype.setTriggerPaused = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 16, value);
+};
+
+
 
 
 
@@ -65,6 +65,8 @@ export const dummyApplication: Application.AsObject = {
   createdAt: createdAt.unix(),
   deleted: false,
   deploying: false,
+  lastHandledCommit: "",
+  lastHandledCommitAt: 0,
 };
 
 export const dummyApps: Record<ApplicationKind, Application.AsObject> = {
@@ -30,6 +30,7 @@ export const dummyDeployment: Deployment.AsObject = {
   completedAt: completedAt.unix(),
   summary:
     "Quick sync by deploying the new version and configuring all traffic to it because no pipeline was configured",
+  description: "",
   envId: "",
   gitPath: {
     configPath: "",
@@ -64,6 +65,7 @@ export function createDeploymentFromObject(o: Deployment.AsObject): Deployment {
   deployment.setStatus(o.status);
   deployment.setStatusReason(o.statusReason);
   deployment.setSummary(o.summary);
+  deployment.setDescription(o.description);
   deployment.setUpdatedAt(o.updatedAt);
   deployment.setVersion(o.version);
   o.gitPath && deployment.setGitPath(createGitPathFromObject(o.gitPath));
@@ -168,6 +168,12 @@ export const DeploymentDetail: FC<DeploymentDetailProps> = memo(
                   />
                   <DetailTableRow label="Piped" value={piped.name} />
                   <DetailTableRow label="Summary" value={deployment.summary} />
+                  {deployment.description && (
+                    <DetailTableRow
+                      label="Description"
+                      value={deployment.description}
+                    />
+                  )}
                 </tbody>
               </table>
             </div>
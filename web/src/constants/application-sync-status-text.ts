@@ -8,4 +8,5 @@ export const APPLICATION_SYNC_STATUS_TEXT: Record<
   [ApplicationSyncStatus.SYNCED]: "Synced",
   [ApplicationSyncStatus.DEPLOYING]: "Deploying",
   [ApplicationSyncStatus.OUT_OF_SYNC]: "Out of Sync",
+  [ApplicationSyncStatus.INVALID_CONFIG]: "Invalid Config",
 };